@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/spf13/cobra"
+	"github.com/uyuni-project/uyuni-tools/shared/api/fake"
 	"github.com/uyuni-project/uyuni-tools/shared/testutils"
 	"github.com/uyuni-project/uyuni-tools/shared/testutils/flagstests"
 	"github.com/uyuni-project/uyuni-tools/shared/types"
@@ -38,3 +39,33 @@ func TestParamsParsing(t *testing.T) {
 		t.Errorf("command failed with error: %s", err)
 	}
 }
+
+func TestRegisterDistro(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	server := fake.NewServer("admin", "secret")
+	defer server.Close()
+
+	server.Handle("POST", "kickstart/tree/create", func(_ *fake.Request) *fake.Response {
+		return fake.Success(1)
+	})
+
+	flags := flagpole{ConnectionDetails: *server.ConnectionDetails()}
+	distro := types.Distribution{
+		TreeLabel:    "SLES15SP6",
+		BasePath:     "/srv/www/distributions/SLES15SP6",
+		ChannelLabel: "sle-product-sles15-sp6-pool-x86_64",
+		InstallType:  "sles15generic",
+	}
+	if err := registerDistro(&flags.ConnectionDetails, &distro, &flags); err != nil {
+		t.Fatalf("failed to register distribution: %s", err)
+	}
+
+	calls := server.CallsTo("kickstart/tree/create")
+	testutils.AssertEquals(t, "Unexpected number of calls", 1, len(calls))
+	testutils.AssertEquals(t, "Unexpected tree label", "SLES15SP6", calls[0].Params["treeLabel"])
+	testutils.AssertEquals[interface{}](t, "Unexpected base path", distro.BasePath, calls[0].Params["basePath"])
+	testutils.AssertEquals[interface{}](t, "Unexpected channel label",
+		distro.ChannelLabel, calls[0].Params["channelLabel"],
+	)
+	testutils.AssertEquals(t, "Unexpected install type", "sles15generic", calls[0].Params["installType"])
+}
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/spf13/cobra"
+	"github.com/uyuni-project/uyuni-tools/shared/api/fake"
 	"github.com/uyuni-project/uyuni-tools/shared/testutils"
 	"github.com/uyuni-project/uyuni-tools/shared/testutils/flagstests"
 	"github.com/uyuni-project/uyuni-tools/shared/types"
@@ -41,3 +42,59 @@ func TestParamsParsing(t *testing.T) {
 		t.Errorf("command failed with error: %s", err)
 	}
 }
+
+func TestRegisterToHub(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	server := fake.NewServer("admin", "secret")
+	defer server.Close()
+
+	server.Handle("POST", "system/registerPeripheralServer", func(_ *fake.Request) *fake.Response {
+		return fake.Success(1000010000)
+	})
+	server.Handle("POST", "system/updatePeripheralServerInfo", func(_ *fake.Request) *fake.Response {
+		return fake.Success(1)
+	})
+
+	config := map[string]string{
+		"java.hostname":      "peripheral.example.com",
+		"report_db_name":     "reportdb",
+		"report_db_port":     "5432",
+		"report_db_user":     "pythia",
+		"report_db_password": "secret",
+	}
+	if err := registerToHub(config, server.ConnectionDetails()); err != nil {
+		t.Fatalf("failed to register: %s", err)
+	}
+
+	calls := server.CallsTo("system/registerPeripheralServer")
+	testutils.AssertEquals(t, "Unexpected number of register calls", 1, len(calls))
+	testutils.AssertEquals(t, "Unexpected FQDN", "peripheral.example.com", calls[0].Params["fqdn"])
+
+	calls = server.CallsTo("system/updatePeripheralServerInfo")
+	testutils.AssertEquals(t, "Unexpected number of update calls", 1, len(calls))
+	testutils.AssertEquals[interface{}](t, "Unexpected system ID", float64(1000010000), calls[0].Params["sid"])
+	testutils.AssertEquals(t, "Unexpected report DB name", "reportdb", calls[0].Params["reportDbName"])
+}
+
+func TestRegisterToHubFailure(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	server := fake.NewServer("admin", "secret")
+	defer server.Close()
+
+	server.Handle("POST", "system/registerPeripheralServer", func(_ *fake.Request) *fake.Response {
+		return fake.Failure("already registered")
+	})
+
+	config := map[string]string{
+		"java.hostname":      "peripheral.example.com",
+		"report_db_name":     "reportdb",
+		"report_db_port":     "5432",
+		"report_db_user":     "pythia",
+		"report_db_password": "secret",
+	}
+	err := registerToHub(config, server.ConnectionDetails())
+	testutils.AssertTrue(t, "Registration should have failed", err != nil)
+	testutils.AssertEquals(t, "Peripheral info should not be updated",
+		0, len(server.CallsTo("system/updatePeripheralServerInfo")),
+	)
+}
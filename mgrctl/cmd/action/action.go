@@ -0,0 +1,177 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package action
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/schedule"
+	"github.com/uyuni-project/uyuni-tools/shared/api/system"
+	apiTypes "github.com/uyuni-project/uyuni-tools/shared/api/types"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+)
+
+// Statuses of an action on a system.
+const (
+	statusInProgress = "in-progress"
+	statusCompleted  = "completed"
+	statusFailed     = "failed"
+)
+
+// systemResult is the result of an action on a system.
+type systemResult struct {
+	ServerID   int              `json:"server_id"`
+	ServerName string           `json:"server_name"`
+	Status     string           `json:"status"`
+	Timestamp  apiTypes.APITime `json:"timestamp"`
+	Message    string           `json:"message,omitempty"`
+	ReturnCode *int             `json:"return_code,omitempty"`
+	Output     string           `json:"output,omitempty"`
+}
+
+// actionResult is an action with its results on every system.
+type actionResult struct {
+	apiTypes.Action
+	Systems []systemResult `json:"systems"`
+}
+
+// NewCommand entry command for managing the scheduled actions.
+func NewCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	actionCmd := &cobra.Command{
+		Use:   "action",
+		Short: L("Manage the scheduled actions"),
+		Run: func(cmd *cobra.Command, _ []string) {
+			_ = cmd.Help()
+		},
+	}
+
+	actionCmd.AddCommand(newListCommand(globalFlags))
+	actionCmd.AddCommand(newShowCommand(globalFlags))
+	actionCmd.AddCommand(newWaitCommand(globalFlags))
+	actionCmd.AddCommand(newCancelCommand(globalFlags))
+	actionCmd.AddCommand(newRescheduleCommand(globalFlags))
+
+	return actionCmd
+}
+
+// parseIDs converts the action IDs passed as arguments.
+func parseIDs(args []string) ([]int, error) {
+	ids := []int{}
+	for _, arg := range args {
+		id, err := strconv.Atoi(arg)
+		if err != nil {
+			return nil, fmt.Errorf(L("invalid action ID: %s"), arg)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// findActions looks for the actions in the current and archived actions.
+//
+// Each list is fetched only once and the archived actions only if some actions are not current.
+func findActions(client *api.APIClient, ids []int) ([]apiTypes.Action, error) {
+	wanted := map[int]bool{}
+	for _, id := range ids {
+		wanted[id] = true
+	}
+
+	found := map[int]apiTypes.Action{}
+	for _, list := range []string{schedule.AllActions, schedule.ArchivedActions} {
+		if len(found) == len(wanted) {
+			break
+		}
+		actions, err := schedule.ListActions(client, list)
+		if err != nil {
+			return nil, err
+		}
+		for _, action := range actions {
+			if wanted[action.ID] {
+				found[action.ID] = action
+			}
+		}
+	}
+
+	actions := []apiTypes.Action{}
+	for _, id := range ids {
+		action, ok := found[id]
+		if !ok {
+			return nil, fmt.Errorf(L("no action with ID %d"), id)
+		}
+		actions = append(actions, action)
+	}
+	return actions, nil
+}
+
+// getActionResults gets the actions and their status on all their systems.
+func getActionResults(client *api.APIClient, ids []int) ([]actionResult, error) {
+	actions, err := findActions(client, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	results := []actionResult{}
+	for i := range actions {
+		result, err := getActionResult(client, &actions[i])
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, *result)
+	}
+	return results, nil
+}
+
+// getActionResult gets the status of an action on all its systems.
+//
+// The return code and output of script actions are added to the results.
+func getActionResult(client *api.APIClient, action *apiTypes.Action) (*actionResult, error) {
+	id := action.ID
+	result := actionResult{Action: *action, Systems: []systemResult{}}
+	lists := []struct {
+		list   string
+		status string
+	}{
+		{schedule.CompletedSystems, statusCompleted},
+		{schedule.FailedSystems, statusFailed},
+		{schedule.InProgressSystems, statusInProgress},
+	}
+	for _, item := range lists {
+		systems, err := schedule.ListSystems(client, id, item.list)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range systems {
+			result.Systems = append(result.Systems, systemResult{
+				ServerID:   s.ServerID,
+				ServerName: s.ServerName,
+				Status:     item.status,
+				Timestamp:  s.Timestamp,
+				Message:    s.Message,
+			})
+		}
+	}
+
+	if strings.Contains(strings.ToLower(action.Type), "script") {
+		scriptResults, err := system.GetScriptResults(client, id)
+		if err != nil {
+			return nil, err
+		}
+		for _, scriptResult := range scriptResults {
+			for i := range result.Systems {
+				if result.Systems[i].ServerID == scriptResult.ServerID {
+					returnCode := scriptResult.ReturnCode
+					result.Systems[i].ReturnCode = &returnCode
+					result.Systems[i].Output = scriptResult.Output
+				}
+			}
+		}
+	}
+	return &result, nil
+}
@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package action
+
+import (
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	ctl_utils "github.com/uyuni-project/uyuni-tools/mgrctl/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/schedule"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+type cancelFlags struct {
+	ConnectionDetails api.ConnectionDetails `mapstructure:"api"`
+}
+
+func newCancelCmd(globalFlags *types.GlobalFlags, run utils.CommandFunc[cancelFlags]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel action-id...",
+		Short: L("Cancel actions"),
+		Long:  L("Cancel actions on all the systems where they are not completed yet."),
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var flags cancelFlags
+			return utils.CommandHelper(globalFlags, cmd, args, &flags, nil, run)
+		},
+	}
+
+	api.AddAPIFlags(cmd)
+
+	return cmd
+}
+
+func newCancelCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	return newCancelCmd(globalFlags, runCancel)
+}
+
+func runCancel(_ *types.GlobalFlags, flags *cancelFlags, _ *cobra.Command, args []string) error {
+	ids, err := parseIDs(args)
+	if err != nil {
+		return err
+	}
+
+	client, err := ctl_utils.Connect(&flags.ConnectionDetails)
+	if err != nil {
+		return err
+	}
+
+	if err := schedule.CancelActions(client, ids); err != nil {
+		return err
+	}
+	log.Info().Msgf(L("Canceled %d actions"), len(ids))
+	return nil
+}
@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package action
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	ctl_utils "github.com/uyuni-project/uyuni-tools/mgrctl/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/schedule"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+type listFlags struct {
+	ConnectionDetails api.ConnectionDetails `mapstructure:"api"`
+	Status            string
+	Output            string
+}
+
+// statusLists maps the --status values to the API lists.
+var statusLists = map[string]string{
+	"all":            schedule.AllActions,
+	statusInProgress: schedule.InProgressActions,
+	statusCompleted:  schedule.CompletedActions,
+	statusFailed:     schedule.FailedActions,
+	"archived":       schedule.ArchivedActions,
+}
+
+func newListCmd(globalFlags *types.GlobalFlags, run utils.CommandFunc[listFlags]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: L("List the scheduled actions"),
+		Long: L(`List the scheduled actions.
+
+The all status doesn't include the archived actions.`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var flags listFlags
+			return utils.CommandHelper(globalFlags, cmd, args, &flags, nil, run)
+		},
+	}
+
+	cmd.Flags().String("status", "all",
+		L("only list the actions with this status: all, in-progress, completed, failed or archived"),
+	)
+	ctl_utils.AddOutputFlag(cmd)
+	api.AddAPIFlags(cmd)
+
+	return cmd
+}
+
+func newListCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	return newListCmd(globalFlags, runList)
+}
+
+func runList(_ *types.GlobalFlags, flags *listFlags, _ *cobra.Command, _ []string) error {
+	list, ok := statusLists[flags.Status]
+	if !ok {
+		return fmt.Errorf(L("invalid status: %s"), flags.Status)
+	}
+
+	client, err := ctl_utils.Connect(&flags.ConnectionDetails)
+	if err != nil {
+		return err
+	}
+
+	actions, err := schedule.ListActions(client, list)
+	if err != nil {
+		return err
+	}
+
+	return ctl_utils.PrintOutput(os.Stdout, flags.Output, actions, func() *ctl_utils.Table {
+		table := ctl_utils.Table{Headers: []string{
+			L("ID"), L("NAME"), L("TYPE"), L("SCHEDULER"), L("EARLIEST"),
+			L("COMPLETED"), L("FAILED"), L("IN PROGRESS"),
+		}}
+		for _, action := range actions {
+			table.AddRow(strconv.Itoa(action.ID), action.Name, action.Type, action.Scheduler,
+				ctl_utils.FormatTime(action.Earliest.Time), strconv.Itoa(action.CompletedSystems),
+				strconv.Itoa(action.FailedSystems), strconv.Itoa(action.InProgressSystems),
+			)
+		}
+		return &table
+	})
+}
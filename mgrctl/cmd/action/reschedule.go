@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package action
+
+import (
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	ctl_utils "github.com/uyuni-project/uyuni-tools/mgrctl/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/schedule"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+type rescheduleFlags struct {
+	ConnectionDetails api.ConnectionDetails `mapstructure:"api"`
+	All               bool
+}
+
+func newRescheduleCmd(globalFlags *types.GlobalFlags, run utils.CommandFunc[rescheduleFlags]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reschedule action-id...",
+		Short: L("Reschedule actions"),
+		Long:  L("Reschedule actions on the systems where they failed, or on all their systems with --all."),
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var flags rescheduleFlags
+			return utils.CommandHelper(globalFlags, cmd, args, &flags, nil, run)
+		},
+	}
+
+	cmd.Flags().Bool("all", false, L("reschedule on all the systems, not only the failed ones"))
+	api.AddAPIFlags(cmd)
+
+	return cmd
+}
+
+func newRescheduleCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	return newRescheduleCmd(globalFlags, runReschedule)
+}
+
+func runReschedule(_ *types.GlobalFlags, flags *rescheduleFlags, _ *cobra.Command, args []string) error {
+	ids, err := parseIDs(args)
+	if err != nil {
+		return err
+	}
+
+	client, err := ctl_utils.Connect(&flags.ConnectionDetails)
+	if err != nil {
+		return err
+	}
+
+	if err := schedule.RescheduleActions(client, ids, !flags.All); err != nil {
+		return err
+	}
+	log.Info().Msgf(L("Rescheduled %d actions"), len(ids))
+	return nil
+}
@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package action
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	ctl_utils "github.com/uyuni-project/uyuni-tools/mgrctl/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+type showFlags struct {
+	ConnectionDetails api.ConnectionDetails `mapstructure:"api"`
+	Output            string
+}
+
+func newShowCmd(globalFlags *types.GlobalFlags, run utils.CommandFunc[showFlags]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show action-id",
+		Short: L("Show the details of an action"),
+		Long:  L("Show the details of an action and its result on each of its systems."),
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var flags showFlags
+			return utils.CommandHelper(globalFlags, cmd, args, &flags, nil, run)
+		},
+	}
+
+	ctl_utils.AddOutputFlag(cmd)
+	api.AddAPIFlags(cmd)
+
+	return cmd
+}
+
+func newShowCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	return newShowCmd(globalFlags, runShow)
+}
+
+func runShow(_ *types.GlobalFlags, flags *showFlags, _ *cobra.Command, args []string) error {
+	ids, err := parseIDs(args)
+	if err != nil {
+		return err
+	}
+
+	client, err := ctl_utils.Connect(&flags.ConnectionDetails)
+	if err != nil {
+		return err
+	}
+
+	results, err := getActionResults(client, ids[:1])
+	if err != nil {
+		return err
+	}
+	result := &results[0]
+
+	if flags.Output != ctl_utils.TableOutput && flags.Output != "" {
+		// Only the table needs a custom layout, the CSV only contains the results
+		return ctl_utils.PrintOutput(os.Stdout, flags.Output, result, func() *ctl_utils.Table {
+			return resultsTable([]actionResult{*result})
+		})
+	}
+
+	table := ctl_utils.Table{}
+	table.AddRow(L("ID:"), strconv.Itoa(result.ID))
+	table.AddRow(L("Name:"), result.Name)
+	table.AddRow(L("Type:"), result.Type)
+	table.AddRow(L("Scheduler:"), result.Scheduler)
+	table.AddRow(L("Earliest:"), ctl_utils.FormatTime(result.Earliest.Time))
+	if err := ctl_utils.PrintTable(os.Stdout, &table); err != nil {
+		return err
+	}
+	fmt.Println()
+	return ctl_utils.PrintTable(os.Stdout, resultsTable([]actionResult{*result}))
+}
+
+// resultsTable creates a table with the results of the actions on each system.
+func resultsTable(results []actionResult) *ctl_utils.Table {
+	table := ctl_utils.Table{Headers: []string{
+		L("ACTION"), L("SYSTEM"), L("STATUS"), L("DATE"), L("RETURN CODE"), L("MESSAGE"),
+	}}
+	for _, result := range results {
+		for _, s := range result.Systems {
+			returnCode := ""
+			if s.ReturnCode != nil {
+				returnCode = strconv.Itoa(*s.ReturnCode)
+			}
+			table.AddRow(strconv.Itoa(result.ID), s.ServerName, s.Status, ctl_utils.FormatTime(s.Timestamp.Time),
+				returnCode, strings.ReplaceAll(s.Message, "\n", " "),
+			)
+		}
+	}
+	return &table
+}
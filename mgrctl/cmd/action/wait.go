@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package action
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	ctl_utils "github.com/uyuni-project/uyuni-tools/mgrctl/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/schedule"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+// Exit codes of the wait command.
+const (
+	exitFailed  = 1
+	exitTimeout = 2
+)
+
+type waitFlags struct {
+	ConnectionDetails api.ConnectionDetails `mapstructure:"api"`
+	Timeout           time.Duration
+	Interval          time.Duration
+	Output            string
+}
+
+func newWaitCmd(globalFlags *types.GlobalFlags, run utils.CommandFunc[waitFlags]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "wait action-id...",
+		Short: L("Wait for actions to finish"),
+		Long: L(`Wait until none of the actions is in progress on any system and print their results on each system.
+
+The return code and output of script actions are included in the results.
+
+The command exits with code 0 if the actions succeeded on all systems,
+1 if one failed on at least one system and 2 if the timeout is reached.`),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var flags waitFlags
+			return utils.CommandHelper(globalFlags, cmd, args, &flags, nil, run)
+		},
+	}
+
+	cmd.Flags().Duration("timeout", time.Hour, L("maximum time to wait for the actions. 0 waits forever"))
+	cmd.Flags().Duration("interval", 10*time.Second, L("time between two status checks"))
+	ctl_utils.AddOutputFlag(cmd)
+	api.AddAPIFlags(cmd)
+
+	return cmd
+}
+
+func newWaitCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	return newWaitCmd(globalFlags, runWait)
+}
+
+func runWait(_ *types.GlobalFlags, flags *waitFlags, _ *cobra.Command, args []string) error {
+	ids, err := parseIDs(args)
+	if err != nil {
+		return err
+	}
+
+	client, err := ctl_utils.Connect(&flags.ConnectionDetails)
+	if err != nil {
+		return err
+	}
+	return waitActions(client, ids, flags, os.Stdout)
+}
+
+func waitActions(client *api.APIClient, ids []int, flags *waitFlags, w io.Writer) error {
+	// Fail early on unknown actions
+	if _, err := findActions(client, ids); err != nil {
+		return err
+	}
+
+	waitErr := ctl_utils.Poll(flags.Timeout, flags.Interval, func() (bool, error) {
+		actions, err := schedule.ListActions(client, schedule.InProgressActions)
+		if err != nil {
+			return false, err
+		}
+		for _, action := range actions {
+			for _, id := range ids {
+				if action.ID == id {
+					log.Debug().Msgf("Action %d still in progress on %d systems", id, action.InProgressSystems)
+					return false, nil
+				}
+			}
+		}
+		return true, nil
+	})
+	var timeoutErr *ctl_utils.TimeoutError
+	if waitErr != nil && !errors.As(waitErr, &timeoutErr) {
+		return waitErr
+	}
+
+	results, err := getActionResults(client, ids)
+	if err != nil {
+		return err
+	}
+	failed := 0
+	for _, result := range results {
+		for _, s := range result.Systems {
+			if s.Status == statusFailed {
+				failed++
+			}
+		}
+	}
+
+	if err := ctl_utils.PrintOutput(w, flags.Output, results, func() *ctl_utils.Table {
+		return resultsTable(results)
+	}); err != nil {
+		return err
+	}
+
+	if waitErr != nil {
+		return &ctl_utils.ExitCodeError{Code: exitTimeout, Err: utils.Errorf(waitErr, L("actions still in progress"))}
+	}
+	if failed > 0 {
+		return &ctl_utils.ExitCodeError{Code: exitFailed, Err: fmt.Errorf(L("actions failed on %d systems"), failed)}
+	}
+	return nil
+}
@@ -0,0 +1,216 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package action
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	ctl_utils "github.com/uyuni-project/uyuni-tools/mgrctl/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared/api/fake"
+	"github.com/uyuni-project/uyuni-tools/shared/testutils"
+	"github.com/uyuni-project/uyuni-tools/shared/testutils/flagstests"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+)
+
+func TestWaitParamsParsing(t *testing.T) {
+	args := []string{"--timeout", "2h", "--interval", "1m", "--output", "json", "12", "13"}
+	args = append(args, flagstests.APIFlagsTestArgs...)
+
+	// Test function asserting that the args are properly parsed
+	tester := func(_ *types.GlobalFlags, flags *waitFlags, _ *cobra.Command, args []string) error {
+		flagstests.AssertAPIFlags(t, &flags.ConnectionDetails)
+		testutils.AssertEquals(t, "Error parsing --timeout", 2*time.Hour, flags.Timeout)
+		testutils.AssertEquals(t, "Error parsing --interval", time.Minute, flags.Interval)
+		testutils.AssertEquals(t, "Error parsing --output", "json", flags.Output)
+		testutils.AssertEquals(t, "Unexpected args", []string{"12", "13"}, args)
+		return nil
+	}
+
+	globalFlags := types.GlobalFlags{}
+	cmd := newWaitCmd(&globalFlags, tester)
+
+	testutils.AssertHasAllFlags(t, cmd, args)
+
+	cmd.SetArgs(args)
+	if err := cmd.Execute(); err != nil {
+		t.Errorf("command failed with error: %s", err)
+	}
+}
+
+// newActionServer returns a fake server where the script action 12 runs on two systems.
+//
+// The action stays in progress for doneAfter checks, then failed contains the result on the second system.
+func newActionServer(t *testing.T, doneAfter int, failed bool) *fake.Server {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	server := fake.NewServer("admin", "secret")
+
+	action := map[string]interface{}{"id": 12, "name": "Run a script", "type": "Run an arbitrary script"}
+	checks := 0
+	server.Handle("GET", "schedule/listAllActions", func(_ *fake.Request) *fake.Response {
+		return fake.Success([]interface{}{action})
+	})
+	server.Handle("GET", "schedule/listInProgressActions", func(_ *fake.Request) *fake.Response {
+		checks++
+		if checks > doneAfter {
+			return fake.Success([]interface{}{})
+		}
+		return fake.Success([]interface{}{action})
+	})
+
+	system := func(id int, name string, message string) map[string]interface{} {
+		return map[string]interface{}{"server_id": id, "server_name": name, "message": message}
+	}
+	systems := func(status string) []interface{} {
+		result := []interface{}{}
+		if checks <= doneAfter {
+			if status == "in-progress" {
+				result = append(result, system(1, "web1", ""), system(2, "web2", ""))
+			}
+			return result
+		}
+		if status == "completed" {
+			result = append(result, system(1, "web1", "done"))
+		}
+		if failed == (status == "failed") && status != "in-progress" {
+			result = append(result, system(2, "web2", "script failed"))
+		}
+		return result
+	}
+	server.Handle("GET", "schedule/listCompletedSystems", func(_ *fake.Request) *fake.Response {
+		return fake.Success(systems("completed"))
+	})
+	server.Handle("GET", "schedule/listFailedSystems", func(_ *fake.Request) *fake.Response {
+		return fake.Success(systems("failed"))
+	})
+	server.Handle("GET", "schedule/listInProgressSystems", func(_ *fake.Request) *fake.Response {
+		return fake.Success(systems("in-progress"))
+	})
+	server.Handle("GET", "system/getScriptResults", func(_ *fake.Request) *fake.Response {
+		returnCode := 0
+		if failed {
+			returnCode = 3
+		}
+		return fake.Success([]interface{}{
+			map[string]interface{}{"serverId": 1, "returnCode": 0, "output": "ok"},
+			map[string]interface{}{"serverId": 2, "returnCode": returnCode, "output": "oops"},
+		})
+	})
+	return server
+}
+
+func runWaitTest(t *testing.T, server *fake.Server, timeout time.Duration) ([]actionResult, error) {
+	client, err := ctl_utils.Connect(server.ConnectionDetails())
+	if err != nil {
+		t.Fatalf("failed to connect: %s", err)
+	}
+
+	var out bytes.Buffer
+	flags := waitFlags{Timeout: timeout, Interval: time.Millisecond, Output: ctl_utils.JSONOutput}
+	waitErr := waitActions(client, []int{12}, &flags, &out)
+
+	var results []actionResult
+	if err := json.Unmarshal(out.Bytes(), &results); err != nil {
+		t.Fatalf("failed to parse the output: %s", err)
+	}
+	return results, waitErr
+}
+
+func TestWaitActionsSucceeded(t *testing.T) {
+	server := newActionServer(t, 2, false)
+	defer server.Close()
+
+	results, err := runWaitTest(t, server, time.Minute)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	testutils.AssertEquals(t, "Unexpected number of results", 2, len(results[0].Systems))
+	for _, s := range results[0].Systems {
+		testutils.AssertEquals(t, "Unexpected status of "+s.ServerName, statusCompleted, s.Status)
+		testutils.AssertEquals(t, "Unexpected return code of "+s.ServerName, 0, *s.ReturnCode)
+	}
+	// Once to check the actions exist and once for the results
+	testutils.AssertEquals(t, "Unexpected actions list calls", 2, len(server.CallsTo("schedule/listAllActions")))
+	testutils.AssertEquals(t, "Archived actions should not be listed",
+		0, len(server.CallsTo("schedule/listArchivedActions")),
+	)
+}
+
+func TestFindActions(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	server := fake.NewServer("admin", "secret")
+	defer server.Close()
+
+	server.Handle("GET", "schedule/listAllActions", func(_ *fake.Request) *fake.Response {
+		return fake.Success([]interface{}{
+			map[string]interface{}{"id": 12, "name": "Current"},
+			map[string]interface{}{"id": 14, "name": "Other"},
+		})
+	})
+	server.Handle("GET", "schedule/listArchivedActions", func(_ *fake.Request) *fake.Response {
+		return fake.Success([]interface{}{map[string]interface{}{"id": 13, "name": "Archived"}})
+	})
+
+	client, err := ctl_utils.Connect(server.ConnectionDetails())
+	if err != nil {
+		t.Fatalf("failed to connect: %s", err)
+	}
+
+	actions, err := findActions(client, []int{13, 12})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	names := []string{}
+	for _, action := range actions {
+		names = append(names, action.Name)
+	}
+	testutils.AssertEquals(t, "Unexpected actions", []string{"Archived", "Current"}, names)
+	testutils.AssertEquals(t, "Actions listed more than once", 1, len(server.CallsTo("schedule/listAllActions")))
+	testutils.AssertEquals(t, "Archived actions listed more than once",
+		1, len(server.CallsTo("schedule/listArchivedActions")),
+	)
+
+	if _, err := findActions(client, []int{12, 15}); err == nil {
+		t.Error("unknown action should be refused")
+	}
+}
+
+func TestWaitActionsFailed(t *testing.T) {
+	server := newActionServer(t, 2, true)
+	defer server.Close()
+
+	results, err := runWaitTest(t, server, time.Minute)
+	var exitErr *ctl_utils.ExitCodeError
+	if !errors.As(err, &exitErr) {
+		t.Fatalf("expected an exit code error, got %v", err)
+	}
+	testutils.AssertEquals(t, "Unexpected exit code", exitFailed, exitErr.Code)
+
+	failed := results[0].Systems[1]
+	testutils.AssertEquals(t, "Unexpected failed system", "web2", failed.ServerName)
+	testutils.AssertEquals(t, "Unexpected status", statusFailed, failed.Status)
+	testutils.AssertEquals(t, "Unexpected message", "script failed", failed.Message)
+	testutils.AssertEquals(t, "Unexpected return code", 3, *failed.ReturnCode)
+	testutils.AssertEquals(t, "Unexpected output", "oops", failed.Output)
+}
+
+func TestWaitActionsTimeout(t *testing.T) {
+	server := newActionServer(t, 1000, false)
+	defer server.Close()
+
+	results, err := runWaitTest(t, server, 20*time.Millisecond)
+	var exitErr *ctl_utils.ExitCodeError
+	if !errors.As(err, &exitErr) {
+		t.Fatalf("expected an exit code error, got %v", err)
+	}
+	testutils.AssertEquals(t, "Unexpected exit code", exitTimeout, exitErr.Code)
+	for _, s := range results[0].Systems {
+		testutils.AssertEquals(t, "Unexpected status of "+s.ServerName, statusInProgress, s.Status)
+	}
+}
@@ -0,0 +1,30 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package activationkey
+
+import (
+	"github.com/spf13/cobra"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+)
+
+// NewCommand entry command for managing the activation keys.
+func NewCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	activationKeyCmd := &cobra.Command{
+		Use:   "activationkey",
+		Short: L("Manage the activation keys"),
+		Run: func(cmd *cobra.Command, _ []string) {
+			_ = cmd.Help()
+		},
+	}
+
+	activationKeyCmd.AddCommand(newListCommand(globalFlags))
+	activationKeyCmd.AddCommand(newCreateCommand(globalFlags))
+	activationKeyCmd.AddCommand(newDeleteCommand(globalFlags))
+	activationKeyCmd.AddCommand(newExportCommand(globalFlags))
+	activationKeyCmd.AddCommand(newImportCommand(globalFlags))
+
+	return activationKeyCmd
+}
@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package activationkey
+
+import (
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	ctl_utils "github.com/uyuni-project/uyuni-tools/mgrctl/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/activationkey"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+type createFlags struct {
+	ConnectionDetails api.ConnectionDetails `mapstructure:"api"`
+	Description       string
+	BaseChannel       string
+	UsageLimit        int
+	Entitlements      []string
+	UniversalDefault  bool
+}
+
+func newCreateCmd(globalFlags *types.GlobalFlags, run utils.CommandFunc[createFlags]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create key",
+		Short: L("Create an activation key"),
+		Long: L(`Create an activation key.
+
+The server prefixes the key with the organization ID. The created key is printed.
+
+Example:
+# mgrctl activationkey create web --baseChannel sles15-sp6-pool-x86_64 --entitlements monitoring_entitled`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var flags createFlags
+			return utils.CommandHelper(globalFlags, cmd, args, &flags, nil, run)
+		},
+	}
+
+	cmd.Flags().String("description", "", L("description of the key"))
+	cmd.Flags().String("baseChannel", "", L("label of the base channel. Defaults to the default one of the server"))
+	cmd.Flags().Int("usageLimit", 0, L("maximum number of systems registered with the key. 0 means unlimited"))
+	cmd.Flags().StringSlice("entitlements", []string{}, L("add-on entitlements of the key"))
+	cmd.Flags().Bool("universalDefault", false, L("make the key the default of the organization"))
+	api.AddAPIFlags(cmd)
+
+	return cmd
+}
+
+func newCreateCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	return newCreateCmd(globalFlags, runCreate)
+}
+
+func runCreate(_ *types.GlobalFlags, flags *createFlags, _ *cobra.Command, args []string) error {
+	client, err := ctl_utils.Connect(&flags.ConnectionDetails)
+	if err != nil {
+		return err
+	}
+
+	description := flags.Description
+	if description == "" {
+		description = args[0]
+	}
+	key, err := activationkey.Create(client, args[0], description, flags.BaseChannel, flags.UsageLimit,
+		flags.Entitlements, flags.UniversalDefault,
+	)
+	if err != nil {
+		return err
+	}
+	log.Info().Msgf(L("Created activation key %s"), key)
+	fmt.Println(key)
+	return nil
+}
@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package activationkey
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/uyuni-project/uyuni-tools/shared/testutils"
+	"github.com/uyuni-project/uyuni-tools/shared/testutils/flagstests"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+)
+
+func TestCreateParamsParsing(t *testing.T) {
+	args := []string{
+		"--description", "Web servers",
+		"--baseChannel", "sles15-sp6-pool-x86_64",
+		"--usageLimit", "10",
+		"--entitlements", "monitoring_entitled,container_build_host",
+		"--universalDefault",
+		"web",
+	}
+	args = append(args, flagstests.APIFlagsTestArgs...)
+
+	// Test function asserting that the args are properly parsed
+	tester := func(_ *types.GlobalFlags, flags *createFlags, _ *cobra.Command, args []string) error {
+		flagstests.AssertAPIFlags(t, &flags.ConnectionDetails)
+		testutils.AssertEquals(t, "Error parsing --description", "Web servers", flags.Description)
+		testutils.AssertEquals(t, "Error parsing --baseChannel", "sles15-sp6-pool-x86_64", flags.BaseChannel)
+		testutils.AssertEquals(t, "Error parsing --usageLimit", 10, flags.UsageLimit)
+		testutils.AssertEquals(t, "Error parsing --entitlements",
+			[]string{"monitoring_entitled", "container_build_host"}, flags.Entitlements,
+		)
+		testutils.AssertTrue(t, "Error parsing --universalDefault", flags.UniversalDefault)
+		testutils.AssertEquals(t, "Unexpected args", []string{"web"}, args)
+		return nil
+	}
+
+	globalFlags := types.GlobalFlags{}
+	cmd := newCreateCmd(&globalFlags, tester)
+
+	testutils.AssertHasAllFlags(t, cmd, args)
+
+	cmd.SetArgs(args)
+	if err := cmd.Execute(); err != nil {
+		t.Errorf("command failed with error: %s", err)
+	}
+}
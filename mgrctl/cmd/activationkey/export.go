@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package activationkey
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	ctl_key "github.com/uyuni-project/uyuni-tools/mgrctl/shared/activationkey"
+	ctl_utils "github.com/uyuni-project/uyuni-tools/mgrctl/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/activationkey"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+	"gopkg.in/yaml.v2"
+)
+
+type exportFlags struct {
+	ConnectionDetails api.ConnectionDetails `mapstructure:"api"`
+	File              string
+}
+
+func newExportCmd(globalFlags *types.GlobalFlags, run utils.CommandFunc[exportFlags]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export key...",
+		Short: L("Export activation keys to YAML"),
+		Long: L(`Export the full definition of activation keys to YAML.
+
+The definition contains the base and child channels, configuration channels, system groups,
+entitlements and packages of the key. Each key is a separate YAML document.
+
+Example:
+# mgrctl activationkey export 1-web --file web.yaml`),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var flags exportFlags
+			return utils.CommandHelper(globalFlags, cmd, args, &flags, nil, run)
+		},
+	}
+
+	cmd.Flags().StringP("file", "f", "", L("file to write the definitions to. Defaults to the standard output"))
+	api.AddAPIFlags(cmd)
+
+	return cmd
+}
+
+func newExportCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	return newExportCmd(globalFlags, runExport)
+}
+
+func runExport(_ *types.GlobalFlags, flags *exportFlags, _ *cobra.Command, args []string) error {
+	client, err := ctl_utils.Connect(&flags.ConnectionDetails)
+	if err != nil {
+		return err
+	}
+
+	var out io.Writer = os.Stdout
+	if flags.File != "" {
+		file, err := os.Create(flags.File)
+		if err != nil {
+			return utils.Errorf(err, L("failed to create %s"), flags.File)
+		}
+		defer file.Close()
+		out = file
+	}
+
+	if err := exportKeys(client, args, out); err != nil {
+		return err
+	}
+	if flags.File != "" {
+		log.Info().Msgf(L("Activation keys exported to %s"), flags.File)
+	}
+	return nil
+}
+
+func exportKeys(client *api.APIClient, names []string, out io.Writer) error {
+	keys, err := activationkey.ListActivationKeys(client)
+	if err != nil {
+		return err
+	}
+	groups, err := ctl_key.GetGroups(client)
+	if err != nil {
+		return err
+	}
+
+	encoder := yaml.NewEncoder(out)
+	defer encoder.Close()
+	for _, name := range names {
+		key := ctl_key.FindKey(keys, name)
+		if key == nil {
+			return fmt.Errorf(L("no activation key named %s"), name)
+		}
+		def, err := ctl_key.ExportKey(client, key, groups)
+		if err != nil {
+			return err
+		}
+		if err := encoder.Encode(def); err != nil {
+			return utils.Errorf(err, L("failed to write the definition of activation key %s"), name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package activationkey
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	ctl_key "github.com/uyuni-project/uyuni-tools/mgrctl/shared/activationkey"
+	ctl_utils "github.com/uyuni-project/uyuni-tools/mgrctl/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+	"gopkg.in/yaml.v2"
+)
+
+type importFlags struct {
+	ConnectionDetails api.ConnectionDetails `mapstructure:"api"`
+}
+
+func newImportCmd(globalFlags *types.GlobalFlags, run utils.CommandFunc[importFlags]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import file...",
+		Short: L("Import activation keys from YAML"),
+		Long: L(`Create or update activation keys from their YAML definition.
+
+The keys are updated to match the definitions: channels, groups, entitlements or packages
+missing from the definition are removed from the existing keys.
+Importing the same definition again doesn't change anything.
+
+Example:
+# mgrctl activationkey import web.yaml`),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var flags importFlags
+			return utils.CommandHelper(globalFlags, cmd, args, &flags, nil, run)
+		},
+	}
+
+	api.AddAPIFlags(cmd)
+
+	return cmd
+}
+
+func newImportCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	return newImportCmd(globalFlags, runImport)
+}
+
+func runImport(_ *types.GlobalFlags, flags *importFlags, _ *cobra.Command, args []string) error {
+	defs := []*ctl_key.KeyDefinition{}
+	for _, path := range args {
+		fileDefs, err := readDefinitions(path)
+		if err != nil {
+			return err
+		}
+		defs = append(defs, fileDefs...)
+	}
+
+	client, err := ctl_utils.Connect(&flags.ConnectionDetails)
+	if err != nil {
+		return err
+	}
+	return ctl_key.ImportKeys(client, defs)
+}
+
+// readDefinitions reads all the key definitions of a YAML file.
+func readDefinitions(path string) ([]*ctl_key.KeyDefinition, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to open %s"), path)
+	}
+	defer file.Close()
+
+	defs := []*ctl_key.KeyDefinition{}
+	decoder := yaml.NewDecoder(file)
+	decoder.SetStrict(true)
+	for {
+		var def ctl_key.KeyDefinition
+		err := decoder.Decode(&def)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, utils.Errorf(err, L("failed to parse %s"), path)
+		}
+		if def.Key == "" {
+			return nil, fmt.Errorf(L("activation key definition without key in %s"), path)
+		}
+		defs = append(defs, &def)
+	}
+	return defs, nil
+}
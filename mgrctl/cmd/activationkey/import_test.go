@@ -0,0 +1,27 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package activationkey
+
+import (
+	"path"
+	"testing"
+
+	"github.com/uyuni-project/uyuni-tools/shared/testutils"
+)
+
+func TestReadDefinitionsErrors(t *testing.T) {
+	dir := t.TempDir()
+	file := path.Join(dir, "invalid.yaml")
+	testutils.WriteFile(t, file, "key: web\nunknown: value\n")
+	_, err := readDefinitions(file)
+	testutils.AssertTrue(t, "Unknown fields should fail", err != nil)
+
+	testutils.WriteFile(t, file, "description: no key\n")
+	_, err = readDefinitions(file)
+	testutils.AssertTrue(t, "Definitions without key should fail", err != nil)
+
+	_, err = readDefinitions(path.Join(dir, "missing.yaml"))
+	testutils.AssertTrue(t, "Missing file should fail", err != nil)
+}
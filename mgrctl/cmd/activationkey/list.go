@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package activationkey
+
+import (
+	"os"
+	"sort"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	ctl_utils "github.com/uyuni-project/uyuni-tools/mgrctl/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/activationkey"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+type listFlags struct {
+	ConnectionDetails api.ConnectionDetails `mapstructure:"api"`
+	Output            string
+}
+
+func newListCmd(globalFlags *types.GlobalFlags, run utils.CommandFunc[listFlags]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: L("List the activation keys"),
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var flags listFlags
+			return utils.CommandHelper(globalFlags, cmd, args, &flags, nil, run)
+		},
+	}
+
+	ctl_utils.AddOutputFlag(cmd)
+	api.AddAPIFlags(cmd)
+
+	return cmd
+}
+
+func newListCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	return newListCmd(globalFlags, runList)
+}
+
+func runList(_ *types.GlobalFlags, flags *listFlags, _ *cobra.Command, _ []string) error {
+	client, err := ctl_utils.Connect(&flags.ConnectionDetails)
+	if err != nil {
+		return err
+	}
+
+	keys, err := activationkey.ListActivationKeys(client)
+	if err != nil {
+		return err
+	}
+	sort.SliceStable(keys, func(i, j int) bool {
+		return keys[i].Key < keys[j].Key
+	})
+
+	return ctl_utils.PrintOutput(os.Stdout, flags.Output, keys, func() *ctl_utils.Table {
+		table := ctl_utils.Table{
+			Headers: []string{L("KEY"), L("DESCRIPTION"), L("BASE CHANNEL"), L("USAGE LIMIT"), L("DISABLED")},
+		}
+		for _, key := range keys {
+			limit := L("unlimited")
+			if key.UsageLimit > 0 {
+				limit = strconv.Itoa(key.UsageLimit)
+			}
+			table.AddRow(key.Key, key.Description, key.BaseChannelLabel, limit, strconv.FormatBool(key.Disabled))
+		}
+		return &table
+	})
+}
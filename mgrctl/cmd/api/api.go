@@ -15,15 +15,17 @@ import (
 type apiFlags struct {
 	api.ConnectionDetails `mapstructure:"api"`
 	ForceLogin            bool `mapstructure:"force"`
+	XMLRPC                bool `mapstructure:"xmlrpc"`
+	Hub                   bool `mapstructure:"hub"`
 }
 
-// NewCommand generates a JSON over HTTP API helper tool command.
+// NewCommand generates a JSON over HTTP and XML-RPC API helper tool command.
 func NewCommand(globalFlags *types.GlobalFlags) *cobra.Command {
 	var flags apiFlags
 
 	apiCmd := &cobra.Command{
 		Use:   "api",
-		Short: L("JSON over HTTP API helper tool"),
+		Short: L("JSON over HTTP and XML-RPC API helper tool"),
 	}
 
 	apiGet := &cobra.Command{
@@ -32,7 +34,13 @@ func NewCommand(globalFlags *types.GlobalFlags) *cobra.Command {
 		Long: L(`Takes an API path and optional parameters and then issues GET request with them.
 
 Example:
-# mgrctl api get user/getDetails login=test`),
+# mgrctl api get user/getDetails login=test
+
+With --xmlrpc or --hub, the parameters are positional and the session key is added automatically.
+
+XML-RPC example:
+# mgrctl api get --xmlrpc system/getDetails 1000010000`),
+		ValidArgsFunction: newCompletionFunc(globalFlags),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return utils.CommandHelper(globalFlags, cmd, args, &flags, nil, runGet)
 		},
@@ -51,7 +59,14 @@ Key=Value pairs example:
 JSON example:
 # mgrctl api post user/create \
    '{"login":"test", "password":"testXX", "firstName":"F", "lastName":"L", "email":"test@localhost"}'
+
+With --xmlrpc or --hub, the parameters are positional and JSON values are converted to XML-RPC ones.
+The optional name= prefix of the parameters is removed: pass name=key=value for a key=value string.
+
+Hub XML-RPC example:
+# mgrctl api post --hub --api-server hub.example.com multicast/system/listSystems '[1000010000,1000010001]'
 `),
+		ValidArgsFunction: newCompletionFunc(globalFlags),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return utils.CommandHelper(globalFlags, cmd, args, &flags, nil, runPost)
 		},
@@ -84,6 +99,10 @@ Environment variables are also supported.`),
 	apiCmd.AddCommand(apiLogin)
 	apiCmd.AddCommand(apiLogout)
 	api.AddAPIFlags(apiCmd)
+	apiCmd.PersistentFlags().Bool("xmlrpc", false, L("Use the XML-RPC API instead of the JSON over HTTP one"))
+	apiCmd.PersistentFlags().Bool("hub", false,
+		L("Use the XML-RPC API of the Hub aggregation service. Implies --xmlrpc"),
+	)
 
 	return apiCmd
 }
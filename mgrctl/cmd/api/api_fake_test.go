@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"testing"
+
+	"github.com/uyuni-project/uyuni-tools/shared/api/fake"
+	"github.com/uyuni-project/uyuni-tools/shared/testutils"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+)
+
+func newFakeServer(t *testing.T) *fake.Server {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	server := fake.NewServer("admin", "secret")
+	if err := server.LoadFixtures("testdata/fixtures"); err != nil {
+		t.Fatalf("failed to load fixtures: %s", err)
+	}
+	return server
+}
+
+func TestRunGet(t *testing.T) {
+	server := newFakeServer(t)
+	defer server.Close()
+
+	flags := apiFlags{ConnectionDetails: *server.ConnectionDetails()}
+	if err := runGet(&types.GlobalFlags{}, &flags, nil, []string{"user/getDetails", "login=test"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	calls := server.CallsTo("user/getDetails")
+	testutils.AssertEquals(t, "Unexpected number of calls", 1, len(calls))
+	testutils.AssertEquals(t, "Unexpected login parameter", "test", calls[0].Params["login"])
+}
+
+func TestRunPost(t *testing.T) {
+	server := newFakeServer(t)
+	defer server.Close()
+
+	flags := apiFlags{ConnectionDetails: *server.ConnectionDetails()}
+	args := []string{"user/create", "login=test", "password=testXX", "email=test@localhost"}
+	if err := runPost(&types.GlobalFlags{}, &flags, nil, args); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	calls := server.CallsTo("user/create")
+	testutils.AssertEquals(t, "Unexpected number of calls", 1, len(calls))
+	testutils.AssertEquals(t, "Unexpected email parameter", "test@localhost", calls[0].Params["email"])
+}
+
+func TestRunLoginLogout(t *testing.T) {
+	server := newFakeServer(t)
+	defer server.Close()
+
+	flags := apiFlags{ConnectionDetails: *server.ConnectionDetails()}
+	cmd := NewCommand(&types.GlobalFlags{})
+	if err := runLogin(&types.GlobalFlags{}, &flags, cmd, nil); err != nil {
+		t.Fatalf("failed to login: %s", err)
+	}
+
+	// Reuse the stored session without credentials
+	sessionFlags := apiFlags{}
+	sessionFlags.Insecure = true
+	if err := runGet(&types.GlobalFlags{}, &sessionFlags, nil, []string{"user/getDetails", "login=test"}); err != nil {
+		t.Errorf("failed to use stored session: %s", err)
+	}
+
+	if err := runLogout(&types.GlobalFlags{}, &flags, nil, nil); err != nil {
+		t.Errorf("failed to logout: %s", err)
+	}
+}
@@ -0,0 +1,181 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+// apiCallsCacheTTL is the time after which the cached API calls list is refreshed.
+const apiCallsCacheTTL = 24 * time.Hour
+
+const apiCallsCacheFile = "api-calls.json"
+
+// apiCallInfo is the description of an API call as returned by api/getApiCallList.
+//
+// The parameters are only described by their types, so they are not used for completion.
+type apiCallInfo struct {
+	Name string
+}
+
+// apiCallsCache is the locally cached list of API calls.
+type apiCallsCache struct {
+	Server    string
+	Timestamp time.Time
+	Calls     []string
+}
+
+// newCompletionFunc returns the function completing the API paths.
+//
+// Only the stored session or the credentials passed as flags are used: completion never prompts.
+func newCompletionFunc(globalFlags *types.GlobalFlags) func(
+	*cobra.Command, []string, string,
+) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		var flags apiFlags
+		var completions []string
+		directive := cobra.ShellCompDirectiveNoFileComp
+
+		_ = utils.CommandHelper(globalFlags, cmd, args, &flags, nil,
+			func(_ *types.GlobalFlags, flags *apiFlags, _ *cobra.Command, args []string) error {
+				if flags.XMLRPC || flags.Hub {
+					return nil
+				}
+				calls, err := loadAPICalls(&flags.ConnectionDetails)
+				if err != nil {
+					log.Debug().Err(err).Msg("Failed to get the API calls for completion")
+					return err
+				}
+				completions, directive = completeAPIArgs(calls, args, toComplete)
+				return nil
+			},
+		)
+		return completions, directive
+	}
+}
+
+// completeAPIArgs computes the completions from the API calls list.
+//
+// Only the path is completed: the API metadata does not provide the parameter names.
+func completeAPIArgs(calls []string, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	completions := []string{}
+	if len(args) > 0 {
+		return completions, cobra.ShellCompDirectiveNoFileComp
+	}
+	for _, apiPath := range calls {
+		if strings.HasPrefix(apiPath, toComplete) {
+			completions = append(completions, apiPath)
+		}
+	}
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
+
+// loadAPICalls returns the API calls from the cache or from the server if the cache is outdated.
+func loadAPICalls(conn *api.ConnectionDetails) ([]string, error) {
+	client, err := api.Init(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	cachePath := getAPICallsCachePath()
+	if cache, err := readAPICallsCache(cachePath); err == nil &&
+		cache.Server == client.Details.Server && time.Since(cache.Timestamp) < apiCallsCacheTTL {
+		return cache.Calls, nil
+	}
+
+	if !client.Details.InSession {
+		if client.Details.User == "" || client.Details.Password == "" {
+			return nil, errors.New(L("not logged in"))
+		}
+		if err := client.Login(); err != nil {
+			return nil, err
+		}
+	}
+
+	calls, err := fetchAPICalls(client)
+	if err != nil {
+		return nil, err
+	}
+
+	cache := apiCallsCache{
+		Server:    client.Details.Server,
+		Timestamp: time.Now(),
+		Calls:     calls,
+	}
+	if err := writeAPICallsCache(cachePath, &cache); err != nil {
+		log.Debug().Err(err).Msg("Failed to write the API calls cache")
+	}
+	return calls, nil
+}
+
+// fetchAPICalls queries the server for the API namespaces and calls.
+func fetchAPICalls(client *api.APIClient) ([]string, error) {
+	namespaces, err := api.Get[map[string]string](client, "api/getApiNamespaces")
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to list the API namespaces"))
+	}
+	callList, err := api.Get[map[string]map[string]apiCallInfo](client, "api/getApiCallList")
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to list the API calls"))
+	}
+
+	calls := []string{}
+	for namespace := range namespaces.Result {
+		prefix := strings.ReplaceAll(namespace, ".", "/") + "/"
+		for _, call := range callList.Result[namespace] {
+			// Overloaded calls are listed several times
+			if !utils.Contains(calls, prefix+call.Name) {
+				calls = append(calls, prefix+call.Name)
+			}
+		}
+	}
+	sort.Strings(calls)
+	return calls, nil
+}
+
+func getAPICallsCachePath() string {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		cacheDir = utils.GetUserConfigDir()
+	}
+	return path.Join(cacheDir, "uyuni-tools", apiCallsCacheFile)
+}
+
+func readAPICallsCache(cachePath string) (*apiCallsCache, error) {
+	data, err := os.ReadFile(cachePath)
+	if err != nil {
+		return nil, err
+	}
+	var cache apiCallsCache
+	if err := json.Unmarshal(data, &cache); err != nil {
+		return nil, err
+	}
+	return &cache, nil
+}
+
+func writeAPICallsCache(cachePath string, cache *apiCallsCache) error {
+	data, err := json.Marshal(cache)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(path.Dir(cachePath), 0700); err != nil {
+		return err
+	}
+	return os.WriteFile(cachePath, data, 0600)
+}
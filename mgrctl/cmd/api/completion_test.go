@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"testing"
+
+	"github.com/uyuni-project/uyuni-tools/shared/api/fake"
+	"github.com/uyuni-project/uyuni-tools/shared/testutils"
+)
+
+func TestLoadAPICalls(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	server := newFakeServer(t)
+
+	server.Handle("GET", "api/getApiNamespaces", func(_ *fake.Request) *fake.Response {
+		return fake.Success(map[string]string{"user": "UserHandler", "system.config": "SystemConfigHandler"})
+	})
+	server.Handle("GET", "api/getApiCallList", func(_ *fake.Request) *fake.Response {
+		return fake.Success(map[string]interface{}{
+			"user": map[string]interface{}{
+				"getDetails_string_string": map[string]interface{}{
+					"name":       "getDetails",
+					"parameters": []string{"string", "string"},
+				},
+				"getDetails_string": map[string]interface{}{
+					"name":       "getDetails",
+					"parameters": []string{"string"},
+				},
+			},
+			"system.config": map[string]interface{}{
+				"addChannels_string_array_array_boolean": map[string]interface{}{
+					"name":       "addChannels",
+					"parameters": []string{"string", "array", "array", "boolean"},
+				},
+			},
+		})
+	})
+
+	calls, err := loadAPICalls(server.ConnectionDetails())
+	if err != nil {
+		t.Fatalf("failed to load API calls: %s", err)
+	}
+	expected := []string{"system/config/addChannels", "user/getDetails"}
+	testutils.AssertEquals(t, "Unexpected API calls", expected, calls)
+
+	// Second call should use the cache even if the server is gone
+	details := server.ConnectionDetails()
+	server.Close()
+	calls, err = loadAPICalls(details)
+	if err != nil {
+		t.Fatalf("failed to load cached API calls: %s", err)
+	}
+	testutils.AssertEquals(t, "Unexpected cached API calls", expected, calls)
+}
+
+func TestCompleteAPIArgs(t *testing.T) {
+	calls := []string{"system/getName", "user/create", "user/getDetails"}
+
+	completions, _ := completeAPIArgs(calls, []string{}, "user/")
+	testutils.AssertEquals(t, "Unexpected paths", []string{"user/create", "user/getDetails"}, completions)
+
+	completions, _ = completeAPIArgs(calls, []string{"user/create"}, "")
+	testutils.AssertEquals(t, "Parameters should not be completed", []string{}, completions)
+}
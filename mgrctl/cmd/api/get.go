@@ -19,6 +19,9 @@ import (
 )
 
 func runGet(_ *types.GlobalFlags, flags *apiFlags, _ *cobra.Command, args []string) error {
+	if flags.XMLRPC || flags.Hub {
+		return runXMLRPC(flags, args)
+	}
 	log.Debug().Msgf("Running GET command %s", args[0])
 	client, err := api.Init(&flags.ConnectionDetails)
 	if err == nil && (client.Details.User != "" || client.Details.InSession) {
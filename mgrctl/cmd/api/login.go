@@ -23,10 +23,18 @@ func runLogin(_ *types.GlobalFlags, flags *apiFlags, cmd *cobra.Command, _ []str
 		return errors.New(L("Refusing to overwrite existing login. Use --force to ignore this check."))
 	}
 
+	if flags.Hub {
+		return errors.New(L("Hub XML-RPC API sessions cannot be stored"))
+	}
+
 	utils.AskIfMissing(&flags.Server, cmd.Flag("api-server").Usage, 0, 0, utils.IsWellFormedFQDN)
 	utils.AskIfMissing(&flags.User, cmd.Flag("api-user").Usage, 0, 0, nil)
 	utils.AskPasswordIfMissingOnce(&flags.Password, cmd.Flag("api-password").Usage, 0, 0)
 
+	if flags.XMLRPC {
+		return loginXMLRPC(flags)
+	}
+
 	client, err := api.Init(&flags.ConnectionDetails)
 	if err != nil {
 		return err
@@ -42,6 +50,22 @@ func runLogin(_ *types.GlobalFlags, flags *apiFlags, cmd *cobra.Command, _ []str
 	return nil
 }
 
+func loginXMLRPC(flags *apiFlags) error {
+	client, err := api.InitXMLRPC(&flags.ConnectionDetails, false)
+	if err != nil {
+		return err
+	}
+	if err := client.Login(); err != nil {
+		return utils.Errorf(err, L("Failed to validate credentials."))
+	}
+	if err := api.StoreXMLRPCLoginCreds(client); err != nil {
+		return err
+	}
+
+	log.Info().Msg(L("Login credentials verified."))
+	return nil
+}
+
 func runLogout(_ *types.GlobalFlags, _ *apiFlags, _ *cobra.Command, _ []string) error {
 	log.Debug().Msg("Running logout command")
 
@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+// sessionlessMethods are the XML-RPC methods not requiring a session key.
+var sessionlessMethods = []string{
+	"api.getVersion",
+	"api.systemVersion",
+}
+
+// paramNameRegex matches a name= prefix followed by a value not starting with =.
+//
+// This keeps the base64 values with their padding untouched.
+var paramNameRegex = regexp.MustCompile(`^([a-zA-Z_][a-zA-Z0-9_]*)=[^=]`)
+
+// runXMLRPC calls an XML-RPC method named after the API path with the positional parameters.
+func runXMLRPC(flags *apiFlags, args []string) error {
+	method := strings.ReplaceAll(strings.Trim(args[0], "/"), "/", ".")
+	log.Debug().Msgf("Running XML-RPC call %s", method)
+
+	client, err := api.InitXMLRPC(&flags.ConnectionDetails, flags.Hub)
+	needsSession := !utils.Contains(sessionlessMethods, method)
+	if err == nil && needsSession {
+		err = client.Login()
+	}
+	if err != nil {
+		return utils.Errorf(err, L("unable to login to the server"))
+	}
+
+	params := []interface{}{}
+	if needsSession {
+		params = append(params, client.SessionKey)
+	}
+	for _, arg := range args[1:] {
+		param, err := parseXMLRPCParam(arg)
+		if err != nil {
+			return err
+		}
+		params = append(params, param)
+	}
+
+	res, err := client.Call(method, params...)
+	if err != nil {
+		return utils.Errorf(err, L("error in query '%s'"), method)
+	}
+
+	out, err := json.MarshalIndent(res, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Print(string(out))
+	return nil
+}
+
+// parseXMLRPCParam converts a command line argument into an XML-RPC parameter.
+//
+// The optional name= prefix is dropped as XML-RPC parameters are positional.
+// It is only recognized when name is an identifier and the value is not empty and doesn't start with =.
+// A string value looking like a name= prefix needs to be passed with a prefix: name=key=value is passed as key=value.
+// JSON values are decoded with integers kept as such, anything else is passed as a string.
+func parseXMLRPCParam(arg string) (interface{}, error) {
+	if match := paramNameRegex.FindStringSubmatch(arg); match != nil {
+		arg = strings.TrimPrefix(arg, match[1]+"=")
+	}
+
+	decoder := json.NewDecoder(bytes.NewBufferString(arg))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil || decoder.More() {
+		return arg, nil
+	}
+	return convertJSONNumbers(value)
+}
+
+func convertJSONNumbers(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i, nil
+		}
+		return v.Float64()
+	case []interface{}:
+		for i, item := range v {
+			converted, err := convertJSONNumbers(item)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = converted
+		}
+	case map[string]interface{}:
+		for key, item := range v {
+			converted, err := convertJSONNumbers(item)
+			if err != nil {
+				return nil, err
+			}
+			v[key] = converted
+		}
+	case nil:
+		return nil, errors.New(L("null parameters are not supported by XML-RPC"))
+	}
+	return value, nil
+}
@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"testing"
+
+	"github.com/uyuni-project/uyuni-tools/shared/testutils"
+)
+
+func TestParseXMLRPCParam(t *testing.T) {
+	data := []struct {
+		arg      string
+		expected interface{}
+	}{
+		{"1000010000", int64(1000010000)},
+		{"sid=1000010000", int64(1000010000)},
+		{"1.5", 1.5},
+		{"true", true},
+		{"login=test", "test"},
+		{"some text", "some text"},
+		{"a=b=c", "b=c"},
+		{"dGVzdA==", "dGVzdA=="},
+		{"dGVzdDE=", "dGVzdDE="},
+		{"data=dGVzdA==", "dGVzdA=="},
+		{"https://host/path?a=b", "https://host/path?a=b"},
+		{"[1, 2]", []interface{}{int64(1), int64(2)}},
+		{`{"name": "foo", "id": 3}`, map[string]interface{}{"name": "foo", "id": int64(3)}},
+	}
+
+	for i, testCase := range data {
+		actual, err := parseXMLRPCParam(testCase.arg)
+		if err != nil {
+			t.Errorf("Testcase %d: unexpected error: %s", i, err)
+		}
+		testutils.AssertEquals(t, "Unexpected parsed value for "+testCase.arg, testCase.expected, actual)
+	}
+
+	if _, err := parseXMLRPCParam("null"); err == nil {
+		t.Error("null parameter should be refused")
+	}
+}
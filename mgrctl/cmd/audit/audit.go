@@ -0,0 +1,26 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"github.com/spf13/cobra"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+)
+
+// NewCommand entry command for auditing the systems.
+func NewCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	auditCmd := &cobra.Command{
+		Use:   "audit",
+		Short: L("Audit the systems"),
+		Run: func(cmd *cobra.Command, _ []string) {
+			_ = cmd.Help()
+		},
+	}
+
+	auditCmd.AddCommand(newCVECommand(globalFlags))
+
+	return auditCmd
+}
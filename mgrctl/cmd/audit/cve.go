@@ -0,0 +1,181 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	ctl_utils "github.com/uyuni-project/uyuni-tools/mgrctl/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/audit"
+	"github.com/uyuni-project/uyuni-tools/shared/api/system"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+type cveFlags struct {
+	ConnectionDetails api.ConnectionDetails `mapstructure:"api"`
+	Status            []string
+	Affected          bool
+	Output            string
+}
+
+// cveSystem is the status of a system for a CVE as printed in the report.
+type cveSystem struct {
+	CVE        string   `json:"cve"`
+	SystemID   int      `json:"system_id"`
+	SystemName string   `json:"system_name"`
+	Status     string   `json:"status"`
+	Advisories []string `json:"advisories"`
+	Channels   []string `json:"channels"`
+}
+
+func newCVECmd(globalFlags *types.GlobalFlags, run utils.CommandFunc[cveFlags]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cve cve-id...",
+		Short: L("Report the systems affected by CVEs"),
+		Long: fmt.Sprintf(L(`Report the patch status of the systems for CVEs.
+
+The systems are sorted from the most critical status to the least critical one.
+The statuses are: %s.
+
+Example to get a CSV report of the affected systems:
+# mgrctl audit cve --affected -o csv CVE-2024-3094`), strings.Join(audit.PatchStatuses, ", ")),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var flags cveFlags
+			return utils.CommandHelper(globalFlags, cmd, args, &flags, nil, run)
+		},
+	}
+
+	cmd.Flags().StringSlice("status", []string{}, L("only report the systems with these statuses"))
+	cmd.Flags().Bool("affected", false, L("only report the affected systems"))
+	ctl_utils.AddOutputFlag(cmd)
+	api.AddAPIFlags(cmd)
+
+	return cmd
+}
+
+func newCVECommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	return newCVECmd(globalFlags, runCVE)
+}
+
+func runCVE(_ *types.GlobalFlags, flags *cveFlags, _ *cobra.Command, args []string) error {
+	client, err := ctl_utils.Connect(&flags.ConnectionDetails)
+	if err != nil {
+		return err
+	}
+
+	report, err := auditCVEs(client, args, flags)
+	if err != nil {
+		return err
+	}
+
+	return ctl_utils.PrintOutput(os.Stdout, flags.Output, report, func() *ctl_utils.Table {
+		table := ctl_utils.Table{
+			Headers: []string{L("CVE"), L("SYSTEM ID"), L("SYSTEM"), L("STATUS"), L("PATCHES"), L("CHANNELS")},
+		}
+		for _, s := range report {
+			table.AddRow(s.CVE, strconv.Itoa(s.SystemID), s.SystemName, s.Status,
+				strings.Join(s.Advisories, " "), strings.Join(s.Channels, " "),
+			)
+		}
+		return &table
+	})
+}
+
+// auditCVEs gets the status of the systems for the CVEs.
+func auditCVEs(client *api.APIClient, cves []string, flags *cveFlags) ([]cveSystem, error) {
+	statuses, err := getStatuses(flags)
+	if err != nil {
+		return nil, err
+	}
+
+	systems, err := system.ListSystems(client)
+	if err != nil {
+		return nil, err
+	}
+	names := map[int]string{}
+	for _, s := range systems {
+		names[s.ID] = s.Name
+	}
+
+	report := []cveSystem{}
+	for _, cve := range cves {
+		cve = strings.ToUpper(cve)
+		results, err := audit.ListSystemsByPatchStatus(client, cve)
+		if err != nil {
+			return nil, err
+		}
+		cveReport := []cveSystem{}
+		for _, result := range results {
+			if len(statuses) > 0 && !utils.Contains(statuses, result.PatchStatus) {
+				continue
+			}
+			cveReport = append(cveReport, cveSystem{
+				CVE:        cve,
+				SystemID:   result.SystemID,
+				SystemName: names[result.SystemID],
+				Status:     result.PatchStatus,
+				Advisories: nonNil(result.Advisories),
+				Channels:   nonNil(result.ChannelLabels),
+			})
+		}
+		sort.Slice(cveReport, func(i, j int) bool {
+			iRank := statusRank(cveReport[i].Status)
+			jRank := statusRank(cveReport[j].Status)
+			if iRank != jRank {
+				return iRank < jRank
+			}
+			return cveReport[i].SystemName < cveReport[j].SystemName
+		})
+		report = append(report, cveReport...)
+	}
+	return report, nil
+}
+
+// getStatuses computes the API statuses to report from the flags.
+//
+// The statuses are case insensitive and dashes can be used instead of the underscores.
+func getStatuses(flags *cveFlags) ([]string, error) {
+	statuses := []string{}
+	for _, status := range flags.Status {
+		status = strings.ToUpper(strings.ReplaceAll(status, "-", "_"))
+		if !utils.Contains(audit.PatchStatuses, status) {
+			return nil, fmt.Errorf(L("invalid patch status: %s"), status)
+		}
+		statuses = append(statuses, status)
+	}
+	if flags.Affected {
+		for _, status := range audit.PatchStatuses {
+			if strings.HasPrefix(status, "AFFECTED_") && !utils.Contains(statuses, status) {
+				statuses = append(statuses, status)
+			}
+		}
+	}
+	return statuses, nil
+}
+
+func statusRank(status string) int {
+	for i, value := range audit.PatchStatuses {
+		if value == status {
+			return i
+		}
+	}
+	return len(audit.PatchStatuses)
+}
+
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	ctl_utils "github.com/uyuni-project/uyuni-tools/mgrctl/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared/api/fake"
+	"github.com/uyuni-project/uyuni-tools/shared/testutils"
+	"github.com/uyuni-project/uyuni-tools/shared/testutils/flagstests"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+)
+
+func TestCVEParamsParsing(t *testing.T) {
+	args := []string{"--status", "patched,not-affected", "--affected", "--output", "csv", "CVE-2024-3094"}
+	args = append(args, flagstests.APIFlagsTestArgs...)
+
+	// Test function asserting that the args are properly parsed
+	tester := func(_ *types.GlobalFlags, flags *cveFlags, _ *cobra.Command, args []string) error {
+		flagstests.AssertAPIFlags(t, &flags.ConnectionDetails)
+		testutils.AssertEquals(t, "Error parsing --status", []string{"patched", "not-affected"}, flags.Status)
+		testutils.AssertTrue(t, "Error parsing --affected", flags.Affected)
+		testutils.AssertEquals(t, "Error parsing --output", "csv", flags.Output)
+		testutils.AssertEquals(t, "Unexpected args", []string{"CVE-2024-3094"}, args)
+		return nil
+	}
+
+	globalFlags := types.GlobalFlags{}
+	cmd := newCVECmd(&globalFlags, tester)
+
+	testutils.AssertHasAllFlags(t, cmd, args)
+
+	cmd.SetArgs(args)
+	if err := cmd.Execute(); err != nil {
+		t.Errorf("command failed with error: %s", err)
+	}
+}
+
+func TestAuditCVEs(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	server := fake.NewServer("admin", "secret")
+	defer server.Close()
+
+	server.Handle("GET", "system/listSystems", func(_ *fake.Request) *fake.Response {
+		return fake.Success([]interface{}{
+			map[string]interface{}{"id": 1, "name": "web1"},
+			map[string]interface{}{"id": 2, "name": "web2"},
+			map[string]interface{}{"id": 3, "name": "db1"},
+		})
+	})
+	server.Handle("GET", "audit/listSystemsByPatchStatus", func(_ *fake.Request) *fake.Response {
+		return fake.Success([]interface{}{
+			map[string]interface{}{"system_id": 1, "patch_status": "PATCHED"},
+			map[string]interface{}{
+				"system_id": 2, "patch_status": "AFFECTED_PATCH_APPLICABLE",
+				"errata_advisories": []string{"SUSE-2024-1"}, "channel_labels": []string{"updates"},
+			},
+			map[string]interface{}{"system_id": 3, "patch_status": "AFFECTED_PATCH_INAPPLICABLE"},
+		})
+	})
+
+	client, err := ctl_utils.Connect(server.ConnectionDetails())
+	if err != nil {
+		t.Fatalf("failed to connect: %s", err)
+	}
+
+	report, err := auditCVEs(client, []string{"cve-2024-3094"}, &cveFlags{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	names := []string{}
+	for _, s := range report {
+		names = append(names, s.SystemName)
+	}
+	testutils.AssertEquals(t, "Unexpected systems order", []string{"db1", "web2", "web1"}, names)
+	testutils.AssertEquals(t, "Unexpected CVE", "CVE-2024-3094", report[0].CVE)
+	testutils.AssertEquals(t, "Unexpected patches", []string{"SUSE-2024-1"}, report[1].Advisories)
+	testutils.AssertEquals[interface{}](t, "Unexpected audited CVE", "CVE-2024-3094",
+		server.CallsTo("audit/listSystemsByPatchStatus")[0].Params["cveIdentifier"],
+	)
+
+	report, err = auditCVEs(client, []string{"CVE-2024-3094"}, &cveFlags{Affected: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	testutils.AssertEquals(t, "Unexpected number of affected systems", 2, len(report))
+
+	_, err = auditCVEs(client, []string{"CVE-2024-3094"}, &cveFlags{Status: []string{"broken"}})
+	testutils.AssertTrue(t, "Invalid statuses should fail", err != nil)
+}
@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package channel
+
+import (
+	"github.com/spf13/cobra"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+)
+
+// NewCommand entry command for managing the software channels.
+func NewCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	channelCmd := &cobra.Command{
+		Use:   "channel",
+		Short: L("Manage the software channels"),
+		Run: func(cmd *cobra.Command, _ []string) {
+			_ = cmd.Help()
+		},
+	}
+
+	repoCmd := &cobra.Command{
+		Use:   "repo",
+		Short: L("Manage the repositories of the software channels"),
+		Run: func(cmd *cobra.Command, _ []string) {
+			_ = cmd.Help()
+		},
+	}
+	repoCmd.AddCommand(newRepoAddCommand(globalFlags))
+	repoCmd.AddCommand(newRepoRemoveCommand(globalFlags))
+
+	channelCmd.AddCommand(newListCommand(globalFlags))
+	channelCmd.AddCommand(newShowCommand(globalFlags))
+	channelCmd.AddCommand(newCloneCommand(globalFlags))
+	channelCmd.AddCommand(newSyncCommand(globalFlags))
+	channelCmd.AddCommand(newSyncStatusCommand(globalFlags))
+	channelCmd.AddCommand(repoCmd)
+
+	return channelCmd
+}
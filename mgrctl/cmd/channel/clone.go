@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package channel
+
+import (
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	ctl_utils "github.com/uyuni-project/uyuni-tools/mgrctl/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/channel"
+	apiTypes "github.com/uyuni-project/uyuni-tools/shared/api/types"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+type cloneFlags struct {
+	ConnectionDetails api.ConnectionDetails `mapstructure:"api"`
+	Name              string
+	Summary           string
+	Parent            string
+	Description       string
+	OriginalState     bool
+}
+
+func newCloneCmd(globalFlags *types.GlobalFlags, run utils.CommandFunc[cloneFlags]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clone channel new-label",
+		Short: L("Clone a software channel"),
+		Long: L(`Clone a software channel.
+
+The name and summary default to the new channel label.
+
+Example:
+# mgrctl channel clone sles15-sp6-updates-x86_64 prod-sles15-sp6-updates-x86_64 \
+    --parent prod-sles15-sp6-pool-x86_64`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var flags cloneFlags
+			return utils.CommandHelper(globalFlags, cmd, args, &flags, nil, run)
+		},
+	}
+
+	cmd.Flags().String("name", "", L("name of the new channel"))
+	cmd.Flags().String("summary", "", L("summary of the new channel"))
+	cmd.Flags().String("parent", "", L("label of the parent of the new channel"))
+	cmd.Flags().String("description", "", L("description of the new channel"))
+	cmd.Flags().Bool("originalState", false, L("clone the channel without its later updates"))
+	api.AddAPIFlags(cmd)
+
+	return cmd
+}
+
+func newCloneCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	return newCloneCmd(globalFlags, runClone)
+}
+
+func runClone(_ *types.GlobalFlags, flags *cloneFlags, _ *cobra.Command, args []string) error {
+	client, err := ctl_utils.Connect(&flags.ConnectionDetails)
+	if err != nil {
+		return err
+	}
+
+	details := apiTypes.ChannelCloneDetails{
+		Label:       args[1],
+		Name:        flags.Name,
+		Summary:     flags.Summary,
+		ParentLabel: flags.Parent,
+		Description: flags.Description,
+	}
+	if details.Name == "" {
+		details.Name = details.Label
+	}
+	if details.Summary == "" {
+		details.Summary = details.Name
+	}
+
+	id, err := channel.Clone(client, args[0], details, flags.OriginalState)
+	if err != nil {
+		return err
+	}
+	log.Info().Msgf(L("Cloned channel %[1]s as %[2]s with ID %[3]d"), args[0], args[1], id)
+	return nil
+}
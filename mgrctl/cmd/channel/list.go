@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package channel
+
+import (
+	"os"
+	"sort"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	ctl_utils "github.com/uyuni-project/uyuni-tools/mgrctl/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/channel"
+	apiTypes "github.com/uyuni-project/uyuni-tools/shared/api/types"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+type listFlags struct {
+	ConnectionDetails api.ConnectionDetails `mapstructure:"api"`
+	Parent            string
+	Output            string
+}
+
+func newListCmd(globalFlags *types.GlobalFlags, run utils.CommandFunc[listFlags]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: L("List the software channels"),
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var flags listFlags
+			return utils.CommandHelper(globalFlags, cmd, args, &flags, nil, run)
+		},
+	}
+
+	cmd.Flags().String("parent", "", L("only list the children of this base channel"))
+	ctl_utils.AddOutputFlag(cmd)
+	api.AddAPIFlags(cmd)
+
+	return cmd
+}
+
+func newListCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	return newListCmd(globalFlags, runList)
+}
+
+func runList(_ *types.GlobalFlags, flags *listFlags, _ *cobra.Command, _ []string) error {
+	client, err := ctl_utils.Connect(&flags.ConnectionDetails)
+	if err != nil {
+		return err
+	}
+
+	channels, err := listChannels(client, flags.Parent)
+	if err != nil {
+		return err
+	}
+
+	return ctl_utils.PrintOutput(os.Stdout, flags.Output, channels, func() *ctl_utils.Table {
+		table := ctl_utils.Table{
+			Headers: []string{L("LABEL"), L("NAME"), L("PARENT"), L("ARCH"), L("PACKAGES"), L("SYSTEMS")},
+		}
+		for _, c := range channels {
+			table.AddRow(c.Label, c.Name, c.ParentLabel, c.ArchName, strconv.Itoa(c.Packages), strconv.Itoa(c.Systems))
+		}
+		return &table
+	})
+}
+
+// listChannels returns the channels sorted with the children after their base channel.
+func listChannels(client *api.APIClient, parent string) ([]apiTypes.ChannelOverview, error) {
+	channels, err := channel.ListAllChannels(client)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []apiTypes.ChannelOverview{}
+	for _, c := range channels {
+		if parent == "" || c.ParentLabel == parent {
+			result = append(result, c)
+		}
+	}
+
+	sortKey := func(c apiTypes.ChannelOverview) string {
+		if c.ParentLabel == "" {
+			return c.Label
+		}
+		return c.ParentLabel + "/" + c.Label
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return sortKey(result[i]) < sortKey(result[j])
+	})
+	return result, nil
+}
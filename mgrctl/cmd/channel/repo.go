@@ -0,0 +1,144 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package channel
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	ctl_utils "github.com/uyuni-project/uyuni-tools/mgrctl/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/channel"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+type repoAddFlags struct {
+	ConnectionDetails api.ConnectionDetails `mapstructure:"api"`
+	URL               string                `mapstructure:"url"`
+	Type              string
+}
+
+type repoRemoveFlags struct {
+	ConnectionDetails api.ConnectionDetails `mapstructure:"api"`
+	Delete            bool
+}
+
+func newRepoAddCmd(globalFlags *types.GlobalFlags, run utils.CommandFunc[repoAddFlags]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add channel repository",
+		Short: L("Add a repository to a software channel"),
+		Long: L(`Add a repository to a software channel.
+
+The repository is created if it doesn't exist yet, in which case --url is required.
+
+Example:
+# mgrctl channel repo add custom-tools-x86_64 tools-repo --url https://download.example.com/tools/`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var flags repoAddFlags
+			return utils.CommandHelper(globalFlags, cmd, args, &flags, nil, run)
+		},
+	}
+
+	cmd.Flags().String("url", "", L("URL of the repository to create"))
+	cmd.Flags().String("type", "yum", L("type of the repository to create: yum, uln or deb"))
+	api.AddAPIFlags(cmd)
+
+	return cmd
+}
+
+func newRepoAddCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	return newRepoAddCmd(globalFlags, runRepoAdd)
+}
+
+func newRepoRemoveCmd(globalFlags *types.GlobalFlags, run utils.CommandFunc[repoRemoveFlags]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove channel repository",
+		Short: L("Remove a repository from a software channel"),
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var flags repoRemoveFlags
+			return utils.CommandHelper(globalFlags, cmd, args, &flags, nil, run)
+		},
+	}
+
+	cmd.Flags().Bool("delete", false, L("also delete the repository"))
+	api.AddAPIFlags(cmd)
+
+	return cmd
+}
+
+func newRepoRemoveCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	return newRepoRemoveCmd(globalFlags, runRepoRemove)
+}
+
+func runRepoAdd(_ *types.GlobalFlags, flags *repoAddFlags, _ *cobra.Command, args []string) error {
+	client, err := ctl_utils.Connect(&flags.ConnectionDetails)
+	if err != nil {
+		return err
+	}
+	channelLabel := args[0]
+	repoLabel := args[1]
+
+	repo, err := channel.FindUserRepo(client, repoLabel)
+	if err != nil {
+		return err
+	}
+	if repo == nil {
+		if flags.URL == "" {
+			return fmt.Errorf(L("repository %s doesn't exist and no URL is provided to create it"), repoLabel)
+		}
+		if err := channel.CreateRepo(client, repoLabel, flags.Type, flags.URL); err != nil {
+			return err
+		}
+		log.Info().Msgf(L("Created repository %s"), repoLabel)
+	} else if flags.URL != "" && repo.SourceURL != flags.URL {
+		return fmt.Errorf(L("repository %[1]s already exists with URL %[2]s"), repoLabel, repo.SourceURL)
+	}
+
+	if err := channel.AssociateRepo(client, channelLabel, repoLabel); err != nil {
+		return err
+	}
+	log.Info().Msgf(L("Added repository %[1]s to channel %[2]s"), repoLabel, channelLabel)
+	return nil
+}
+
+func runRepoRemove(_ *types.GlobalFlags, flags *repoRemoveFlags, _ *cobra.Command, args []string) error {
+	client, err := ctl_utils.Connect(&flags.ConnectionDetails)
+	if err != nil {
+		return err
+	}
+	channelLabel := args[0]
+	repoLabel := args[1]
+
+	repos, err := channel.ListChannelRepos(client, channelLabel)
+	if err != nil {
+		return err
+	}
+	found := false
+	for _, repo := range repos {
+		found = found || repo.Label == repoLabel
+	}
+	if !found {
+		return errors.New(L("the repository is not in the channel"))
+	}
+
+	if err := channel.DisassociateRepo(client, channelLabel, repoLabel); err != nil {
+		return err
+	}
+	log.Info().Msgf(L("Removed repository %[1]s from channel %[2]s"), repoLabel, channelLabel)
+
+	if flags.Delete {
+		if err := channel.RemoveRepo(client, repoLabel); err != nil {
+			return err
+		}
+		log.Info().Msgf(L("Deleted repository %s"), repoLabel)
+	}
+	return nil
+}
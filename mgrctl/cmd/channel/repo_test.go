@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package channel
+
+import (
+	"testing"
+
+	"github.com/uyuni-project/uyuni-tools/shared/api/fake"
+	"github.com/uyuni-project/uyuni-tools/shared/testutils"
+)
+
+func TestRunRepoAdd(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	server := fake.NewServer("admin", "secret")
+	defer server.Close()
+
+	server.Handle("GET", "channel/software/listUserRepos", func(_ *fake.Request) *fake.Response {
+		return fake.Success([]map[string]interface{}{
+			{"id": 1, "label": "existing", "sourceUrl": "https://example.com/existing/", "type": "yum"},
+		})
+	})
+	server.Handle("POST", "channel/software/createRepo", func(req *fake.Request) *fake.Response {
+		return fake.Success(map[string]interface{}{"id": 2, "label": req.Params["label"]})
+	})
+	server.Handle("POST", "channel/software/associateRepo", func(_ *fake.Request) *fake.Response {
+		return fake.Success(map[string]interface{}{"id": 101})
+	})
+
+	flags := repoAddFlags{ConnectionDetails: *server.ConnectionDetails(), Type: "yum"}
+	if err := runRepoAdd(nil, &flags, nil, []string{"custom", "existing"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	testutils.AssertEquals(t, "Existing repository should not be created", 0,
+		len(server.CallsTo("channel/software/createRepo")),
+	)
+
+	testutils.AssertTrue(t, "Missing URL should fail", runRepoAdd(nil, &flags, nil, []string{"custom", "new"}) != nil)
+
+	flags.URL = "https://example.com/new/"
+	if err := runRepoAdd(nil, &flags, nil, []string{"custom", "new"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	calls := server.CallsTo("channel/software/createRepo")
+	testutils.AssertEquals(t, "Unexpected number of created repositories", 1, len(calls))
+	testutils.AssertEquals[interface{}](t, "Unexpected repository URL", flags.URL, calls[0].Params["url"])
+	testutils.AssertEquals(t, "Unexpected number of associations", 2,
+		len(server.CallsTo("channel/software/associateRepo")),
+	)
+}
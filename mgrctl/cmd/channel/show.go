@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package channel
+
+import (
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	ctl_utils "github.com/uyuni-project/uyuni-tools/mgrctl/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/channel"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+type showFlags struct {
+	ConnectionDetails api.ConnectionDetails `mapstructure:"api"`
+	Output            string
+}
+
+func newShowCmd(globalFlags *types.GlobalFlags, run utils.CommandFunc[showFlags]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show channel",
+		Short: L("Show the details of a software channel"),
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var flags showFlags
+			return utils.CommandHelper(globalFlags, cmd, args, &flags, nil, run)
+		},
+	}
+
+	ctl_utils.AddOutputFlag(cmd)
+	api.AddAPIFlags(cmd)
+
+	return cmd
+}
+
+func newShowCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	return newShowCmd(globalFlags, runShow)
+}
+
+func runShow(_ *types.GlobalFlags, flags *showFlags, _ *cobra.Command, args []string) error {
+	client, err := ctl_utils.Connect(&flags.ConnectionDetails)
+	if err != nil {
+		return err
+	}
+
+	details, err := channel.GetDetails(client, args[0])
+	if err != nil {
+		return err
+	}
+
+	return ctl_utils.PrintOutput(os.Stdout, flags.Output, details, func() *ctl_utils.Table {
+		repos := []string{}
+		for _, repo := range details.ContentSources {
+			repos = append(repos, repo.Label+" ("+repo.SourceURL+")")
+		}
+
+		table := ctl_utils.Table{}
+		table.AddRow(L("ID:"), strconv.Itoa(details.ID))
+		table.AddRow(L("Label:"), details.Label)
+		table.AddRow(L("Name:"), details.Name)
+		table.AddRow(L("Summary:"), details.Summary)
+		table.AddRow(L("Parent:"), details.ParentChannelLabel)
+		table.AddRow(L("Architecture:"), details.ArchName)
+		table.AddRow(L("Checksum:"), details.ChecksumLabel)
+		table.AddRow(L("Cloned from:"), details.CloneOriginal)
+		table.AddRow(L("Last modified:"), ctl_utils.FormatTime(details.LastModified.Time))
+		table.AddRow(L("Last synced:"), ctl_utils.FormatTime(details.LastSync.Time))
+		table.AddRow(L("Repositories:"), strings.Join(repos, ", "))
+		return &table
+	})
+}
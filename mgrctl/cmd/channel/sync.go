@@ -0,0 +1,247 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package channel
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	ctl_utils "github.com/uyuni-project/uyuni-tools/mgrctl/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/channel"
+	"github.com/uyuni-project/uyuni-tools/shared/api/taskomatic"
+	apiTypes "github.com/uyuni-project/uyuni-tools/shared/api/types"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+type syncFlags struct {
+	ConnectionDetails api.ConnectionDetails `mapstructure:"api"`
+	Wait              bool
+	Timeout           time.Duration
+	Interval          time.Duration
+}
+
+type syncStatusFlags struct {
+	ConnectionDetails api.ConnectionDetails `mapstructure:"api"`
+	Output            string
+}
+
+// syncStatus is the synchronization status of a channel.
+type syncStatus struct {
+	Label        string           `json:"label"`
+	LastSync     apiTypes.APITime `json:"last_sync"`
+	Repositories []string         `json:"repositories"`
+}
+
+func newSyncCmd(globalFlags *types.GlobalFlags, run utils.CommandFunc[syncFlags]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sync channel...",
+		Short: L("Synchronize the repositories of software channels"),
+		Long: L(`Schedule the synchronization of the repositories of software channels.
+
+With --wait, the command waits until the last synchronization date of every channel has changed.
+The command fails as soon as the repository synchronization it scheduled has failed, or has finished
+while some channels still have their previous synchronization date.`),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var flags syncFlags
+			return utils.CommandHelper(globalFlags, cmd, args, &flags, nil, run)
+		},
+	}
+
+	cmd.Flags().Bool("wait", false, L("wait for the synchronization to finish"))
+	cmd.Flags().Duration("timeout", time.Hour, L("maximum time to wait for the synchronization. 0 waits forever"))
+	cmd.Flags().Duration("interval", 30*time.Second, L("time between two synchronization status checks"))
+	api.AddAPIFlags(cmd)
+
+	return cmd
+}
+
+func newSyncCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	return newSyncCmd(globalFlags, runSync)
+}
+
+func newSyncStatusCmd(globalFlags *types.GlobalFlags, run utils.CommandFunc[syncStatusFlags]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sync-status [channel]...",
+		Short: L("Show the last synchronization of software channels"),
+		Long:  L("Show the last synchronization of the given software channels or of all of them."),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var flags syncStatusFlags
+			return utils.CommandHelper(globalFlags, cmd, args, &flags, nil, run)
+		},
+	}
+
+	ctl_utils.AddOutputFlag(cmd)
+	api.AddAPIFlags(cmd)
+
+	return cmd
+}
+
+func newSyncStatusCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	return newSyncStatusCmd(globalFlags, runSyncStatus)
+}
+
+func runSync(_ *types.GlobalFlags, flags *syncFlags, _ *cobra.Command, args []string) error {
+	client, err := ctl_utils.Connect(&flags.ConnectionDetails)
+	if err != nil {
+		return err
+	}
+	return syncChannels(client, args, flags)
+}
+
+func syncChannels(client *api.APIClient, labels []string, flags *syncFlags) error {
+	// Get the previous synchronization dates first to also validate the labels
+	previous := map[string]time.Time{}
+	for _, label := range labels {
+		details, err := channel.GetDetails(client, label)
+		if err != nil {
+			return err
+		}
+		previous[label] = details.LastSync.Time
+	}
+
+	// The schedules existing before the synchronization request are not the one it creates
+	known := map[int]bool{}
+	if flags.Wait {
+		schedules, err := taskomatic.ListActiveSchedulesByBunch(client, taskomatic.RepoSyncBunch)
+		if err != nil {
+			return err
+		}
+		for _, schedule := range schedules {
+			known[schedule.ID] = true
+		}
+	}
+
+	if err := channel.SyncRepo(client, labels); err != nil {
+		return err
+	}
+	log.Info().Msgf(L("Scheduled the synchronization of %s"), strings.Join(labels, ", "))
+
+	if !flags.Wait {
+		return nil
+	}
+
+	tracked := map[int]bool{}
+	err := ctl_utils.Poll(flags.Timeout, flags.Interval, func() (bool, error) {
+		// Check the synchronization runs before the channels to not miss one finishing in between
+		finished, err := checkSyncRuns(client, known, tracked)
+		if err != nil {
+			return false, err
+		}
+		for label, lastSync := range previous {
+			details, err := channel.GetDetails(client, label)
+			if err != nil {
+				return false, err
+			}
+			if details.LastSync.After(lastSync) {
+				log.Info().Msgf(L("Channel %s synchronized"), label)
+				delete(previous, label)
+			}
+		}
+		if len(previous) > 0 && finished {
+			return false, errors.New(L("the repository synchronization finished without updating the channels"))
+		}
+		return len(previous) == 0, nil
+	})
+	if err != nil {
+		pending := []string{}
+		for label := range previous {
+			pending = append(pending, label)
+		}
+		sort.Strings(pending)
+		return utils.Errorf(err, L("channels not synchronized: %s"), strings.Join(pending, ", "))
+	}
+	return nil
+}
+
+// checkSyncRuns checks the runs of the repository synchronization schedules created after the known ones.
+//
+// The new schedules are added to tracked as the single run ones are only active until they are finished.
+// returns whether all the runs of the tracked schedules are finished and an error if one of them failed.
+func checkSyncRuns(client *api.APIClient, known map[int]bool, tracked map[int]bool) (bool, error) {
+	schedules, err := taskomatic.ListActiveSchedulesByBunch(client, taskomatic.RepoSyncBunch)
+	if err != nil {
+		return false, err
+	}
+	for _, schedule := range schedules {
+		if !known[schedule.ID] {
+			tracked[schedule.ID] = true
+		}
+	}
+
+	finished := len(tracked) > 0
+	for id := range tracked {
+		runs, err := taskomatic.ListScheduleRuns(client, id)
+		if err != nil {
+			return false, err
+		}
+		if len(runs) == 0 {
+			finished = false
+		}
+		for _, run := range runs {
+			switch run.Status {
+			case taskomatic.RunFinished:
+			case taskomatic.RunFailed, taskomatic.RunSkipped, taskomatic.RunInterrupted:
+				return false, fmt.Errorf(
+					L("the repository synchronization run %[1]d ended with status %[2]s"), run.ID, run.Status,
+				)
+			default:
+				finished = false
+			}
+		}
+	}
+	return finished, nil
+}
+
+func runSyncStatus(_ *types.GlobalFlags, flags *syncStatusFlags, _ *cobra.Command, args []string) error {
+	client, err := ctl_utils.Connect(&flags.ConnectionDetails)
+	if err != nil {
+		return err
+	}
+
+	labels := args
+	if len(labels) == 0 {
+		channels, err := listChannels(client, "")
+		if err != nil {
+			return err
+		}
+		for _, c := range channels {
+			labels = append(labels, c.Label)
+		}
+	}
+
+	statuses := []syncStatus{}
+	for _, label := range labels {
+		details, err := channel.GetDetails(client, label)
+		if err != nil {
+			return err
+		}
+		status := syncStatus{Label: label, LastSync: details.LastSync, Repositories: []string{}}
+		for _, repo := range details.ContentSources {
+			status.Repositories = append(status.Repositories, repo.Label)
+		}
+		statuses = append(statuses, status)
+	}
+
+	return ctl_utils.PrintOutput(os.Stdout, flags.Output, statuses, func() *ctl_utils.Table {
+		table := ctl_utils.Table{Headers: []string{L("LABEL"), L("LAST SYNC"), L("REPOSITORIES")}}
+		for _, status := range statuses {
+			table.AddRow(
+				status.Label, ctl_utils.FormatTime(status.LastSync.Time), strconv.Itoa(len(status.Repositories)),
+			)
+		}
+		return &table
+	})
+}
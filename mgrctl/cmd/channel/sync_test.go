@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package channel
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	ctl_utils "github.com/uyuni-project/uyuni-tools/mgrctl/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared/api/fake"
+	"github.com/uyuni-project/uyuni-tools/shared/testutils"
+	"github.com/uyuni-project/uyuni-tools/shared/testutils/flagstests"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+)
+
+func TestSyncParamsParsing(t *testing.T) {
+	args := []string{"--wait", "--timeout", "2h", "--interval", "1m", "custom-x86_64"}
+	args = append(args, flagstests.APIFlagsTestArgs...)
+
+	// Test function asserting that the args are properly parsed
+	tester := func(_ *types.GlobalFlags, flags *syncFlags, _ *cobra.Command, args []string) error {
+		flagstests.AssertAPIFlags(t, &flags.ConnectionDetails)
+		testutils.AssertTrue(t, "Error parsing --wait", flags.Wait)
+		testutils.AssertEquals(t, "Error parsing --timeout", 2*time.Hour, flags.Timeout)
+		testutils.AssertEquals(t, "Error parsing --interval", time.Minute, flags.Interval)
+		testutils.AssertEquals(t, "Unexpected args", []string{"custom-x86_64"}, args)
+		return nil
+	}
+
+	globalFlags := types.GlobalFlags{}
+	cmd := newSyncCmd(&globalFlags, tester)
+
+	testutils.AssertHasAllFlags(t, cmd, args)
+
+	cmd.SetArgs(args)
+	if err := cmd.Execute(); err != nil {
+		t.Errorf("command failed with error: %s", err)
+	}
+}
+
+// newSyncServer returns a fake server where the channels are synchronized after syncedAfter status checks.
+//
+// The recurring schedule 5 is always active. The schedule 12 created by the synchronization request
+// has a run with one status of runStatuses per check, the last one staying.
+func newSyncServer(t *testing.T, syncedAfter map[string]int, runStatuses []string) *fake.Server {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	server := fake.NewServer("admin", "secret")
+
+	checks := map[string]int{}
+	server.Handle("GET", "channel/software/getDetails", func(req *fake.Request) *fake.Response {
+		label := req.Params["channelLabel"].(string)
+		lastSync := "2025-03-01T10:00:00Z"
+		if checks[label] > syncedAfter[label] {
+			lastSync = "2025-03-14T10:00:00Z"
+		}
+		checks[label]++
+		return fake.Success(map[string]interface{}{"label": label, "yumrepo_last_sync": lastSync})
+	})
+	requested := false
+	server.Handle("POST", "channel/software/syncRepo", func(_ *fake.Request) *fake.Response {
+		requested = true
+		return fake.Success(1)
+	})
+
+	runChecks := 0
+	runStatus := func() string {
+		return runStatuses[min(runChecks, len(runStatuses)-1)]
+	}
+	server.Handle("GET", "taskomatic/listActiveSchedulesByBunch", func(_ *fake.Request) *fake.Response {
+		schedules := []map[string]interface{}{
+			{"id": 5, "job_label": "repo-sync-default", "bunch": "repo-sync-bunch"},
+		}
+		status := runStatus()
+		if requested && (status == "READY_TO_RUN" || status == "RUNNING") {
+			schedules = append(schedules,
+				map[string]interface{}{"id": 12, "job_label": "single-repo-sync-bunch-1", "bunch": "repo-sync-bunch"},
+			)
+		}
+		return fake.Success(schedules)
+	})
+	server.Handle("GET", "taskomatic/listScheduleRuns", func(req *fake.Request) *fake.Response {
+		if req.Params["scheduleId"] != "12" {
+			t.Errorf("unexpected schedule: %v", req.Params["scheduleId"])
+		}
+		status := runStatus()
+		runChecks++
+		return fake.Success([]map[string]interface{}{{"id": 120, "schedule_id": 12, "status": status}})
+	})
+	return server
+}
+
+func TestSyncChannelsWait(t *testing.T) {
+	server := newSyncServer(t, map[string]int{"pool": 1, "updates": 3},
+		[]string{"READY_TO_RUN", "RUNNING", "RUNNING", "FINISHED"},
+	)
+	defer server.Close()
+
+	client, err := ctl_utils.Connect(server.ConnectionDetails())
+	if err != nil {
+		t.Fatalf("failed to connect: %s", err)
+	}
+
+	flags := syncFlags{Wait: true, Timeout: time.Minute, Interval: time.Millisecond}
+	if err := syncChannels(client, []string{"pool", "updates"}, &flags); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	calls := server.CallsTo("channel/software/syncRepo")
+	testutils.AssertEquals(t, "Unexpected number of sync calls", 1, len(calls))
+	testutils.AssertEquals[interface{}](t, "Unexpected synchronized channels",
+		[]interface{}{"pool", "updates"}, calls[0].Params["channelLabels"],
+	)
+}
+
+func TestSyncChannelsTimeout(t *testing.T) {
+	server := newSyncServer(t, map[string]int{"pool": 1, "updates": 1000}, []string{"RUNNING"})
+	defer server.Close()
+
+	client, err := ctl_utils.Connect(server.ConnectionDetails())
+	if err != nil {
+		t.Fatalf("failed to connect: %s", err)
+	}
+
+	flags := syncFlags{Wait: true, Timeout: 50 * time.Millisecond, Interval: 10 * time.Millisecond}
+	err = syncChannels(client, []string{"pool", "updates"}, &flags)
+	if err == nil {
+		t.Fatal("expected a timeout error")
+	}
+	testutils.AssertTrue(t, "Unexpected error: "+err.Error(),
+		strings.Contains(err.Error(), "channels not synchronized: updates"),
+	)
+}
+
+func TestSyncChannelsFailed(t *testing.T) {
+	data := map[string][]string{
+		"ended with status FAILED":  {"READY_TO_RUN", "RUNNING", "FAILED"},
+		"finished without updating": {"RUNNING", "FINISHED"},
+	}
+
+	for expected, runStatuses := range data {
+		server := newSyncServer(t, map[string]int{"pool": 1, "updates": 1000}, runStatuses)
+
+		client, err := ctl_utils.Connect(server.ConnectionDetails())
+		if err != nil {
+			t.Fatalf("failed to connect: %s", err)
+		}
+
+		// The timeout is long enough to fail the test if the failure isn't detected
+		flags := syncFlags{Wait: true, Timeout: time.Hour, Interval: time.Millisecond}
+		err = syncChannels(client, []string{"pool", "updates"}, &flags)
+		server.Close()
+		if err == nil {
+			t.Fatalf("expected a synchronization error for %s", expected)
+		}
+		testutils.AssertTrue(t, "Unexpected error: "+err.Error(),
+			strings.Contains(err.Error(), "channels not synchronized: updates") &&
+				strings.Contains(err.Error(), expected),
+		)
+	}
+}
@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clm
+
+import (
+	"errors"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	ctl_utils "github.com/uyuni-project/uyuni-tools/mgrctl/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+type applyFlags struct {
+	ConnectionDetails api.ConnectionDetails `mapstructure:"api"`
+	File              string
+}
+
+func newApplyCmd(globalFlags *types.GlobalFlags, run utils.CommandFunc[applyFlags]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: L("Create or update a content lifecycle project from YAML"),
+		Long: L(`Create or update a content lifecycle project to match its YAML definition.
+
+Sources, filters and environments missing from the definition are removed from the project.
+The filters are shared by the projects of the organization and are matched by name.
+Applying the same definition again doesn't change anything.
+The changes to the sources and filters are only effective after the next build.
+
+Example of definition:
+label: sles15
+name: SLES 15 SP6
+sources:
+  - sle-product-sles15-sp6-pool-x86_64
+  - sle-product-sles15-sp6-updates-x86_64
+filters:
+  - name: no-kernel-updates
+    rule: deny
+    entityType: package
+    matcher: contains
+    field: name
+    value: kernel-default
+environments:
+  - label: dev
+  - label: test
+  - label: prod
+    name: Production`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var flags applyFlags
+			return utils.CommandHelper(globalFlags, cmd, args, &flags, nil, run)
+		},
+	}
+
+	cmd.Flags().StringP("file", "f", "", L("path to the YAML definition of the project"))
+	api.AddAPIFlags(cmd)
+
+	return cmd
+}
+
+func newApplyCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	return newApplyCmd(globalFlags, runApply)
+}
+
+func runApply(_ *types.GlobalFlags, flags *applyFlags, _ *cobra.Command, _ []string) error {
+	if flags.File == "" {
+		return errors.New(L("the project definition file is required"))
+	}
+	def, err := readProject(flags.File)
+	if err != nil {
+		return err
+	}
+
+	client, err := ctl_utils.Connect(&flags.ConnectionDetails)
+	if err != nil {
+		return err
+	}
+
+	changed, err := applyProject(client, def)
+	if err != nil {
+		return utils.Errorf(err, L("failed to apply content project %s"), def.Label)
+	}
+	if changed {
+		log.Info().Msgf(L("Content project %s updated"), def.Label)
+	} else {
+		log.Info().Msgf(L("Content project %s is up to date"), def.Label)
+	}
+	return nil
+}
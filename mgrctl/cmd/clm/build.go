@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clm
+
+import (
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	ctl_utils "github.com/uyuni-project/uyuni-tools/mgrctl/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/contentmanagement"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+type buildFlags struct {
+	ConnectionDetails api.ConnectionDetails `mapstructure:"api"`
+	Message           string
+	waitFlags         `mapstructure:",squash"`
+}
+
+func newBuildCmd(globalFlags *types.GlobalFlags, run utils.CommandFunc[buildFlags]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "build project",
+		Short: L("Build a content lifecycle project"),
+		Long: L(`Build the first environment of a content lifecycle project from its sources and filters.
+
+Example to build a project and wait for the build to finish:
+# mgrctl clm build --message "March patches" --wait sles15`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var flags buildFlags
+			return utils.CommandHelper(globalFlags, cmd, args, &flags, nil, run)
+		},
+	}
+
+	cmd.Flags().String("message", "", L("message describing the build"))
+	addWaitFlags(cmd, L("wait for the build to finish"))
+	api.AddAPIFlags(cmd)
+
+	return cmd
+}
+
+func newBuildCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	return newBuildCmd(globalFlags, runBuild)
+}
+
+func runBuild(_ *types.GlobalFlags, flags *buildFlags, _ *cobra.Command, args []string) error {
+	project := args[0]
+	client, err := ctl_utils.Connect(&flags.ConnectionDetails)
+	if err != nil {
+		return err
+	}
+
+	envs, err := contentmanagement.ListEnvironments(client, project)
+	if err != nil {
+		return err
+	}
+	if len(envs) == 0 {
+		return fmt.Errorf(L("content project %s has no environment"), project)
+	}
+
+	if err := contentmanagement.BuildProject(client, project, flags.Message); err != nil {
+		return err
+	}
+	log.Info().Msgf(L("Started the build of content project %s"), project)
+
+	if !flags.Wait {
+		return nil
+	}
+	return waitEnvironment(client, project, envs[0].Label, &flags.waitFlags)
+}
@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clm
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	ctl_utils "github.com/uyuni-project/uyuni-tools/mgrctl/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/contentmanagement"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+// waitFlags are the flags of the commands waiting for an environment to be built.
+type waitFlags struct {
+	Wait     bool
+	Timeout  time.Duration
+	Interval time.Duration
+}
+
+// NewCommand entry command for managing the content lifecycle projects.
+func NewCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	clmCmd := &cobra.Command{
+		Use:   "clm",
+		Short: L("Manage the content lifecycle projects"),
+		Run: func(cmd *cobra.Command, _ []string) {
+			_ = cmd.Help()
+		},
+	}
+
+	projectCmd := &cobra.Command{
+		Use:   "project",
+		Short: L("Manage the content lifecycle project definitions"),
+		Run: func(cmd *cobra.Command, _ []string) {
+			_ = cmd.Help()
+		},
+	}
+	projectCmd.AddCommand(newListCommand(globalFlags))
+	projectCmd.AddCommand(newApplyCommand(globalFlags))
+
+	clmCmd.AddCommand(projectCmd)
+	clmCmd.AddCommand(newBuildCommand(globalFlags))
+	clmCmd.AddCommand(newPromoteCommand(globalFlags))
+
+	return clmCmd
+}
+
+func addWaitFlags(cmd *cobra.Command, usage string) {
+	cmd.Flags().Bool("wait", false, usage)
+	cmd.Flags().Duration("timeout", time.Hour, L("maximum time to wait for the environment. 0 waits forever"))
+	cmd.Flags().Duration("interval", 30*time.Second, L("time between two environment status checks"))
+}
+
+// waitEnvironment waits until the environment is built.
+func waitEnvironment(client *api.APIClient, project string, env string, flags *waitFlags) error {
+	err := ctl_utils.Poll(flags.Timeout, flags.Interval, func() (bool, error) {
+		environment, err := contentmanagement.LookupEnvironment(client, project, env)
+		if err != nil {
+			return false, err
+		}
+		log.Debug().Msgf("Environment %s status: %s", env, environment.Status)
+		switch environment.Status {
+		case contentmanagement.StatusBuilt:
+			return true, nil
+		case contentmanagement.StatusFailed:
+			return false, fmt.Errorf(L("environment %[1]s of content project %[2]s failed to build"), env, project)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return utils.Errorf(err, L("environment %s not built"), env)
+	}
+	log.Info().Msgf(L("Environment %[1]s of content project %[2]s built"), env, project)
+	return nil
+}
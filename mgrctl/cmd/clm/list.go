@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clm
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+	ctl_utils "github.com/uyuni-project/uyuni-tools/mgrctl/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/contentmanagement"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+type listFlags struct {
+	ConnectionDetails api.ConnectionDetails `mapstructure:"api"`
+	Output            string
+}
+
+func newListCmd(globalFlags *types.GlobalFlags, run utils.CommandFunc[listFlags]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: L("List the content lifecycle projects"),
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var flags listFlags
+			return utils.CommandHelper(globalFlags, cmd, args, &flags, nil, run)
+		},
+	}
+
+	ctl_utils.AddOutputFlag(cmd)
+	api.AddAPIFlags(cmd)
+
+	return cmd
+}
+
+func newListCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	return newListCmd(globalFlags, runList)
+}
+
+func runList(_ *types.GlobalFlags, flags *listFlags, _ *cobra.Command, _ []string) error {
+	client, err := ctl_utils.Connect(&flags.ConnectionDetails)
+	if err != nil {
+		return err
+	}
+
+	projects, err := contentmanagement.ListProjects(client)
+	if err != nil {
+		return err
+	}
+
+	return ctl_utils.PrintOutput(os.Stdout, flags.Output, projects, func() *ctl_utils.Table {
+		table := ctl_utils.Table{Headers: []string{L("LABEL"), L("NAME"), L("LAST BUILD"), L("FIRST ENVIRONMENT")}}
+		for _, project := range projects {
+			table.AddRow(project.Label, project.Name, ctl_utils.FormatTime(project.LastBuildDate.Time),
+				project.FirstEnvironment,
+			)
+		}
+		return &table
+	})
+}
@@ -0,0 +1,329 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clm
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/contentmanagement"
+	apiTypes "github.com/uyuni-project/uyuni-tools/shared/api/types"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+	"gopkg.in/yaml.v2"
+)
+
+// detachedState is the state of the sources and filters to be removed at the next build.
+const detachedState = "detached"
+
+// filterDefinition is a content filter as defined in the project YAML file.
+type filterDefinition struct {
+	Name                           string `yaml:"name"`
+	Rule                           string `yaml:"rule"`
+	EntityType                     string `yaml:"entityType"`
+	apiTypes.ContentFilterCriteria `yaml:",inline"`
+}
+
+// environmentDefinition is an environment as defined in the project YAML file.
+type environmentDefinition struct {
+	Label       string `yaml:"label"`
+	Name        string `yaml:"name,omitempty"`
+	Description string `yaml:"description,omitempty"`
+}
+
+// projectDefinition is the full definition of a content lifecycle project.
+//
+// The sources are software channel labels and the environments are listed in promotion order.
+type projectDefinition struct {
+	Label        string                  `yaml:"label"`
+	Name         string                  `yaml:"name,omitempty"`
+	Description  string                  `yaml:"description,omitempty"`
+	Sources      []string                `yaml:"sources,omitempty"`
+	Filters      []filterDefinition      `yaml:"filters,omitempty"`
+	Environments []environmentDefinition `yaml:"environments,omitempty"`
+}
+
+// readProject reads and validates a project definition file.
+//
+// The names default to the labels and the filter rules to deny.
+func readProject(path string) (*projectDefinition, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to read %s"), path)
+	}
+	var def projectDefinition
+	if err := yaml.UnmarshalStrict(data, &def); err != nil {
+		return nil, utils.Errorf(err, L("failed to parse %s"), path)
+	}
+
+	if def.Label == "" {
+		return nil, fmt.Errorf(L("content project definition without label in %s"), path)
+	}
+	if def.Name == "" {
+		def.Name = def.Label
+	}
+	for i := range def.Filters {
+		filter := &def.Filters[i]
+		if filter.Name == "" || filter.EntityType == "" || filter.Matcher == "" {
+			return nil, fmt.Errorf(L("filters need at least a name, an entityType and a matcher in %s"), path)
+		}
+		if filter.Rule == "" {
+			filter.Rule = "deny"
+		}
+	}
+	for i := range def.Environments {
+		env := &def.Environments[i]
+		if env.Label == "" {
+			return nil, fmt.Errorf(L("environment definition without label in %s"), path)
+		}
+		if env.Name == "" {
+			env.Name = env.Label
+		}
+	}
+	return &def, nil
+}
+
+// applyProject creates or updates a project to match its definition.
+//
+// Sources, filters and environments missing from the definition are removed from the project.
+// returns whether the project has been changed.
+func applyProject(client *api.APIClient, def *projectDefinition) (bool, error) {
+	changed, err := applyDetails(client, def)
+	if err != nil {
+		return false, err
+	}
+
+	steps := []func(*api.APIClient, *projectDefinition) (bool, error){applySources, applyFilters, applyEnvironments}
+	for _, step := range steps {
+		stepChanged, err := step(client, def)
+		if err != nil {
+			return false, err
+		}
+		changed = changed || stepChanged
+	}
+	return changed, nil
+}
+
+func applyDetails(client *api.APIClient, def *projectDefinition) (bool, error) {
+	projects, err := contentmanagement.ListProjects(client)
+	if err != nil {
+		return false, err
+	}
+	for _, project := range projects {
+		if project.Label != def.Label {
+			continue
+		}
+		if project.Name == def.Name && project.Description == def.Description {
+			return false, nil
+		}
+		log.Info().Msgf(L("Updating content project %s"), def.Label)
+		return true, contentmanagement.UpdateProject(client, def.Label, def.Name, def.Description)
+	}
+
+	log.Info().Msgf(L("Creating content project %s"), def.Label)
+	return true, contentmanagement.CreateProject(client, def.Label, def.Name, def.Description)
+}
+
+func applySources(client *api.APIClient, def *projectDefinition) (bool, error) {
+	sources, err := contentmanagement.ListSources(client, def.Label)
+	if err != nil {
+		return false, err
+	}
+	attached := []string{}
+	for _, source := range sources {
+		if source.Type == contentmanagement.SoftwareSource && source.State != detachedState {
+			attached = append(attached, source.ChannelLabel)
+		}
+	}
+
+	changed := false
+	for _, label := range def.Sources {
+		if utils.Contains(attached, label) {
+			continue
+		}
+		log.Info().Msgf(L("Attaching source %[1]s to content project %[2]s"), label, def.Label)
+		if err := contentmanagement.AttachSource(client, def.Label, label); err != nil {
+			return false, err
+		}
+		changed = true
+	}
+	for _, label := range attached {
+		if utils.Contains(def.Sources, label) {
+			continue
+		}
+		log.Info().Msgf(L("Detaching source %[1]s from content project %[2]s"), label, def.Label)
+		if err := contentmanagement.DetachSource(client, def.Label, label); err != nil {
+			return false, err
+		}
+		changed = true
+	}
+	return changed, nil
+}
+
+// applyFilters creates or updates the filters, then attaches them to the project.
+//
+// The filters are shared by all the projects of the organization and are identified by name.
+func applyFilters(client *api.APIClient, def *projectDefinition) (bool, error) {
+	filters, err := contentmanagement.ListFilters(client)
+	if err != nil {
+		return false, err
+	}
+	existing := map[string]apiTypes.ContentFilter{}
+	for _, filter := range filters {
+		existing[filter.Name] = filter
+	}
+
+	changed := false
+	ids := []int{}
+	for _, filterDef := range def.Filters {
+		filter, found := existing[filterDef.Name]
+		wanted := apiTypes.ContentFilter{
+			ID:         filter.ID,
+			Name:       filterDef.Name,
+			EntityType: filterDef.EntityType,
+			Rule:       filterDef.Rule,
+			Criteria:   filterDef.ContentFilterCriteria,
+		}
+		switch {
+		case !found:
+			log.Info().Msgf(L("Creating content filter %s"), wanted.Name)
+			created, err := contentmanagement.CreateFilter(client, &wanted)
+			if err != nil {
+				return false, err
+			}
+			wanted.ID = created.ID
+			changed = true
+		case filter.EntityType != wanted.EntityType:
+			return false, fmt.Errorf(L("content filter %[1]s already exists for %[2]s entities"),
+				wanted.Name, filter.EntityType,
+			)
+		case filter != wanted:
+			log.Info().Msgf(L("Updating content filter %s"), wanted.Name)
+			if err := contentmanagement.UpdateFilter(client, &wanted); err != nil {
+				return false, err
+			}
+			changed = true
+		}
+		ids = append(ids, wanted.ID)
+	}
+
+	projectFilters, err := contentmanagement.ListProjectFilters(client, def.Label)
+	if err != nil {
+		return false, err
+	}
+	attached := []int{}
+	for _, projectFilter := range projectFilters {
+		if projectFilter.State != detachedState {
+			attached = append(attached, projectFilter.Filter.ID)
+		}
+	}
+
+	for i, id := range ids {
+		if containsID(attached, id) {
+			continue
+		}
+		log.Info().Msgf(L("Attaching filter %[1]s to content project %[2]s"), def.Filters[i].Name, def.Label)
+		if err := contentmanagement.AttachFilter(client, def.Label, id); err != nil {
+			return false, err
+		}
+		changed = true
+	}
+	for _, projectFilter := range projectFilters {
+		filter := projectFilter.Filter
+		if projectFilter.State == detachedState || containsID(ids, filter.ID) {
+			continue
+		}
+		log.Info().Msgf(L("Detaching filter %[1]s from content project %[2]s"), filter.Name, def.Label)
+		if err := contentmanagement.DetachFilter(client, def.Label, filter.ID); err != nil {
+			return false, err
+		}
+		changed = true
+	}
+	return changed, nil
+}
+
+// applyEnvironments creates, updates and removes environments to match the definition.
+//
+// The order of the existing environments cannot be changed.
+func applyEnvironments(client *api.APIClient, def *projectDefinition) (bool, error) {
+	envs, err := contentmanagement.ListEnvironments(client, def.Label)
+	if err != nil {
+		return false, err
+	}
+
+	wantedLabels := []string{}
+	for _, envDef := range def.Environments {
+		wantedLabels = append(wantedLabels, envDef.Label)
+	}
+	existing := map[string]apiTypes.ContentEnvironment{}
+	kept := []string{}
+	for _, env := range envs {
+		existing[env.Label] = env
+		if utils.Contains(wantedLabels, env.Label) {
+			kept = append(kept, env.Label)
+		}
+	}
+
+	// Check the order before changing anything
+	keptWanted := []string{}
+	for _, label := range wantedLabels {
+		if _, found := existing[label]; found {
+			keptWanted = append(keptWanted, label)
+		}
+	}
+	if strings.Join(kept, ",") != strings.Join(keptWanted, ",") {
+		return false, fmt.Errorf(L("the environments of content project %[1]s cannot be reordered: %[2]s"),
+			def.Label, strings.Join(kept, ", "),
+		)
+	}
+
+	changed := false
+	for _, env := range envs {
+		if utils.Contains(wantedLabels, env.Label) {
+			continue
+		}
+		log.Info().Msgf(L("Removing environment %[1]s of content project %[2]s"), env.Label, def.Label)
+		if err := contentmanagement.RemoveEnvironment(client, def.Label, env.Label); err != nil {
+			return false, err
+		}
+		changed = true
+	}
+
+	predecessor := ""
+	for _, envDef := range def.Environments {
+		env, found := existing[envDef.Label]
+		if !found {
+			log.Info().Msgf(L("Creating environment %[1]s of content project %[2]s"), envDef.Label, def.Label)
+			if err := contentmanagement.CreateEnvironment(
+				client, def.Label, predecessor, envDef.Label, envDef.Name, envDef.Description,
+			); err != nil {
+				return false, err
+			}
+			changed = true
+		} else if env.Name != envDef.Name || env.Description != envDef.Description {
+			log.Info().Msgf(L("Updating environment %[1]s of content project %[2]s"), envDef.Label, def.Label)
+			if err := contentmanagement.UpdateEnvironment(
+				client, def.Label, envDef.Label, envDef.Name, envDef.Description,
+			); err != nil {
+				return false, err
+			}
+			changed = true
+		}
+		predecessor = envDef.Label
+	}
+	return changed, nil
+}
+
+func containsID(ids []int, id int) bool {
+	for _, value := range ids {
+		if value == id {
+			return true
+		}
+	}
+	return false
+}
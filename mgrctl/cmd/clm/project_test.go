@@ -0,0 +1,222 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clm
+
+import (
+	"path"
+	"strings"
+	"testing"
+
+	ctl_utils "github.com/uyuni-project/uyuni-tools/mgrctl/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/fake"
+	apiTypes "github.com/uyuni-project/uyuni-tools/shared/api/types"
+	"github.com/uyuni-project/uyuni-tools/shared/testutils"
+)
+
+const projectDefinitionFile = `label: sles15
+name: SLES 15
+sources:
+  - pool
+  - updates
+filters:
+  - name: no-kernel
+    entityType: package
+    matcher: contains
+    field: name
+    value: kernel
+environments:
+  - label: dev
+  - label: prod
+    name: Production
+`
+
+// clmServer is a fake server storing a single content project.
+type clmServer struct {
+	*fake.Server
+	project        *apiTypes.ContentProject
+	sources        []apiTypes.ContentProjectSource
+	filters        []apiTypes.ContentFilter
+	projectFilters []apiTypes.ContentProjectFilter
+	envs           []apiTypes.ContentEnvironment
+}
+
+func toCriteria(value interface{}) apiTypes.ContentFilterCriteria {
+	criteria := value.(map[string]interface{})
+	return apiTypes.ContentFilterCriteria{
+		Matcher: criteria["matcher"].(string),
+		Field:   criteria["field"].(string),
+		Value:   criteria["value"].(string),
+	}
+}
+
+func newCLMServer(t *testing.T) *clmServer {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	s := &clmServer{Server: fake.NewServer("admin", "secret")}
+
+	s.Handle("GET", "contentmanagement/listProjects", func(_ *fake.Request) *fake.Response {
+		projects := []apiTypes.ContentProject{}
+		if s.project != nil {
+			projects = append(projects, *s.project)
+		}
+		return fake.Success(projects)
+	})
+	s.Handle("POST", "contentmanagement/createProject", func(req *fake.Request) *fake.Response {
+		s.project = &apiTypes.ContentProject{
+			Label:       req.Params["projectLabel"].(string),
+			Name:        req.Params["name"].(string),
+			Description: req.Params["description"].(string),
+		}
+		return fake.Success(s.project)
+	})
+	s.Handle("GET", "contentmanagement/listProjectSources", func(_ *fake.Request) *fake.Response {
+		return fake.Success(s.sources)
+	})
+	s.Handle("POST", "contentmanagement/attachSource", func(req *fake.Request) *fake.Response {
+		source := apiTypes.ContentProjectSource{
+			Type:         req.Params["sourceType"].(string),
+			ChannelLabel: req.Params["sourceLabel"].(string),
+			State:        "attached",
+		}
+		s.sources = append(s.sources, source)
+		return fake.Success(source)
+	})
+	s.Handle("POST", "contentmanagement/detachSource", func(req *fake.Request) *fake.Response {
+		for i := range s.sources {
+			if s.sources[i].ChannelLabel == req.Params["sourceLabel"].(string) {
+				s.sources[i].State = detachedState
+			}
+		}
+		return fake.Success(1)
+	})
+	s.Handle("GET", "contentmanagement/listFilters", func(_ *fake.Request) *fake.Response {
+		return fake.Success(s.filters)
+	})
+	s.Handle("POST", "contentmanagement/createFilter", func(req *fake.Request) *fake.Response {
+		filter := apiTypes.ContentFilter{
+			ID:         len(s.filters) + 1,
+			Name:       req.Params["name"].(string),
+			Rule:       req.Params["rule"].(string),
+			EntityType: req.Params["entityType"].(string),
+			Criteria:   toCriteria(req.Params["criteria"]),
+		}
+		s.filters = append(s.filters, filter)
+		return fake.Success(filter)
+	})
+	s.Handle("POST", "contentmanagement/updateFilter", func(req *fake.Request) *fake.Response {
+		filter := &s.filters[int(req.Params["filterId"].(float64))-1]
+		filter.Rule = req.Params["rule"].(string)
+		filter.Criteria = toCriteria(req.Params["criteria"])
+		return fake.Success(filter)
+	})
+	s.Handle("GET", "contentmanagement/listProjectFilters", func(_ *fake.Request) *fake.Response {
+		return fake.Success(s.projectFilters)
+	})
+	s.Handle("POST", "contentmanagement/attachFilter", func(req *fake.Request) *fake.Response {
+		filter := s.filters[int(req.Params["filterId"].(float64))-1]
+		s.projectFilters = append(s.projectFilters, apiTypes.ContentProjectFilter{Filter: filter, State: "attached"})
+		return fake.Success(filter)
+	})
+	s.Handle("GET", "contentmanagement/listProjectEnvironments", func(_ *fake.Request) *fake.Response {
+		return fake.Success(s.envs)
+	})
+	s.Handle("POST", "contentmanagement/createEnvironment", func(req *fake.Request) *fake.Response {
+		env := apiTypes.ContentEnvironment{
+			Label:       req.Params["envLabel"].(string),
+			Name:        req.Params["name"].(string),
+			Description: req.Params["description"].(string),
+		}
+		position := 0
+		for i := range s.envs {
+			if s.envs[i].Label == req.Params["predecessorLabel"].(string) {
+				position = i + 1
+			}
+		}
+		s.envs = append(s.envs[:position], append([]apiTypes.ContentEnvironment{env}, s.envs[position:]...)...)
+		return fake.Success(env)
+	})
+	s.Handle("POST", "contentmanagement/removeEnvironment", func(req *fake.Request) *fake.Response {
+		envs := []apiTypes.ContentEnvironment{}
+		for _, env := range s.envs {
+			if env.Label != req.Params["envLabel"].(string) {
+				envs = append(envs, env)
+			}
+		}
+		s.envs = envs
+		return fake.Success(1)
+	})
+	return s
+}
+
+func connectAndRead(t *testing.T, server *clmServer, definition string) (*api.APIClient, *projectDefinition) {
+	client, err := ctl_utils.Connect(server.ConnectionDetails())
+	if err != nil {
+		t.Fatalf("failed to connect: %s", err)
+	}
+
+	file := path.Join(t.TempDir(), "project.yaml")
+	testutils.WriteFile(t, file, definition)
+	def, err := readProject(file)
+	if err != nil {
+		t.Fatalf("failed to read the definition: %s", err)
+	}
+	return client, def
+}
+
+func TestApplyProject(t *testing.T) {
+	server := newCLMServer(t)
+	defer server.Close()
+
+	client, def := connectAndRead(t, server, projectDefinitionFile)
+	changed, err := applyProject(client, def)
+	if err != nil {
+		t.Fatalf("failed to apply: %s", err)
+	}
+	testutils.AssertTrue(t, "The project should have been created", changed)
+	testutils.AssertEquals(t, "Unexpected project name", "SLES 15", server.project.Name)
+	testutils.AssertEquals(t, "Unexpected number of sources", 2, len(server.sources))
+	testutils.AssertEquals(t, "Unexpected default rule", "deny", server.filters[0].Rule)
+	testutils.AssertEquals(t, "Unexpected attached filter", "no-kernel", server.projectFilters[0].Filter.Name)
+	testutils.AssertEquals(t, "Unexpected environments", 2, len(server.envs))
+	testutils.AssertEquals(t, "Unexpected first environment", "dev", server.envs[0].Label)
+	testutils.AssertEquals(t, "Unexpected default environment name", "dev", server.envs[0].Name)
+	testutils.AssertEquals(t, "Unexpected second environment", "Production", server.envs[1].Name)
+
+	// Applying again must not change anything
+	changed, err = applyProject(client, def)
+	if err != nil {
+		t.Fatalf("failed to apply again: %s", err)
+	}
+	testutils.AssertTrue(t, "The project should be up to date", !changed)
+
+	// Update the filter, drop a source and insert an environment
+	updated := strings.Replace(projectDefinitionFile, "  - updates\n", "", 1)
+	updated = strings.Replace(updated, "value: kernel", "value: kernel-default", 1)
+	updated = strings.Replace(updated, "  - label: prod\n", "  - label: test\n  - label: prod\n", 1)
+	_, def = connectAndRead(t, server, updated)
+	if _, err := applyProject(client, def); err != nil {
+		t.Fatalf("failed to apply the update: %s", err)
+	}
+	testutils.AssertEquals(t, "Unexpected source state", detachedState, server.sources[1].State)
+	testutils.AssertEquals(t, "Unexpected filter value", "kernel-default", server.filters[0].Criteria.Value)
+	testutils.AssertEquals(t, "Unexpected inserted environment", "test", server.envs[1].Label)
+	testutils.AssertEquals(t, "Unexpected number of environments", 3, len(server.envs))
+}
+
+func TestApplyProjectReorder(t *testing.T) {
+	server := newCLMServer(t)
+	defer server.Close()
+
+	client, def := connectAndRead(t, server, projectDefinitionFile)
+	if _, err := applyProject(client, def); err != nil {
+		t.Fatalf("failed to apply: %s", err)
+	}
+
+	reordered := strings.Replace(projectDefinitionFile, "  - label: dev\n", "", 1) + "  - label: dev\n"
+	_, def = connectAndRead(t, server, reordered)
+	_, err := applyProject(client, def)
+	testutils.AssertTrue(t, "Reordering environments should fail", err != nil)
+	testutils.AssertEquals(t, "No environment should be removed", 2, len(server.envs))
+}
@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clm
+
+import (
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	ctl_utils "github.com/uyuni-project/uyuni-tools/mgrctl/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/contentmanagement"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+type promoteFlags struct {
+	ConnectionDetails api.ConnectionDetails `mapstructure:"api"`
+	waitFlags         `mapstructure:",squash"`
+}
+
+func newPromoteCmd(globalFlags *types.GlobalFlags, run utils.CommandFunc[promoteFlags]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "promote project environment",
+		Short: L("Promote an environment of a content lifecycle project"),
+		Long: L(`Promote the content of an environment to the next environment of the project.
+
+Example to promote the dev environment to the next one and wait for it to be built:
+# mgrctl clm promote --wait sles15 dev`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var flags promoteFlags
+			return utils.CommandHelper(globalFlags, cmd, args, &flags, nil, run)
+		},
+	}
+
+	addWaitFlags(cmd, L("wait for the next environment to be built"))
+	api.AddAPIFlags(cmd)
+
+	return cmd
+}
+
+func newPromoteCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	return newPromoteCmd(globalFlags, runPromote)
+}
+
+func runPromote(_ *types.GlobalFlags, flags *promoteFlags, _ *cobra.Command, args []string) error {
+	client, err := ctl_utils.Connect(&flags.ConnectionDetails)
+	if err != nil {
+		return err
+	}
+	return promote(client, args[0], args[1], flags)
+}
+
+func promote(client *api.APIClient, project string, env string, flags *promoteFlags) error {
+	environment, err := contentmanagement.LookupEnvironment(client, project, env)
+	if err != nil {
+		return err
+	}
+	if environment.NextEnvironmentLabel == "" {
+		return fmt.Errorf(L("environment %[1]s is the last one of content project %[2]s"), env, project)
+	}
+
+	if err := contentmanagement.PromoteProject(client, project, env); err != nil {
+		return err
+	}
+	log.Info().Msgf(L("Started the promotion of environment %[1]s to %[2]s"), env, environment.NextEnvironmentLabel)
+
+	if !flags.Wait {
+		return nil
+	}
+	return waitEnvironment(client, project, environment.NextEnvironmentLabel, &flags.waitFlags)
+}
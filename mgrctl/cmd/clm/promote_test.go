@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clm
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	ctl_utils "github.com/uyuni-project/uyuni-tools/mgrctl/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared/api/fake"
+	"github.com/uyuni-project/uyuni-tools/shared/testutils"
+	"github.com/uyuni-project/uyuni-tools/shared/testutils/flagstests"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+)
+
+func TestPromoteParamsParsing(t *testing.T) {
+	args := []string{"--wait", "--timeout", "2h", "--interval", "1m", "sles15", "dev"}
+	args = append(args, flagstests.APIFlagsTestArgs...)
+
+	// Test function asserting that the args are properly parsed
+	tester := func(_ *types.GlobalFlags, flags *promoteFlags, _ *cobra.Command, args []string) error {
+		flagstests.AssertAPIFlags(t, &flags.ConnectionDetails)
+		testutils.AssertTrue(t, "Error parsing --wait", flags.Wait)
+		testutils.AssertEquals(t, "Error parsing --timeout", 2*time.Hour, flags.Timeout)
+		testutils.AssertEquals(t, "Error parsing --interval", time.Minute, flags.Interval)
+		testutils.AssertEquals(t, "Unexpected args", []string{"sles15", "dev"}, args)
+		return nil
+	}
+
+	globalFlags := types.GlobalFlags{}
+	cmd := newPromoteCmd(&globalFlags, tester)
+
+	testutils.AssertHasAllFlags(t, cmd, args)
+
+	cmd.SetArgs(args)
+	if err := cmd.Execute(); err != nil {
+		t.Errorf("command failed with error: %s", err)
+	}
+}
+
+// newPromoteServer returns a fake server where the prod environment gets the final status after two checks.
+func newPromoteServer(t *testing.T, status string) *fake.Server {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	server := fake.NewServer("admin", "secret")
+
+	checks := 0
+	server.Handle("GET", "contentmanagement/lookupEnvironment", func(req *fake.Request) *fake.Response {
+		if req.Params["envLabel"] == "dev" {
+			return fake.Success(map[string]interface{}{"label": "dev", "nextEnvironmentLabel": "prod"})
+		}
+		checks++
+		envStatus := "building"
+		if checks > 2 {
+			envStatus = status
+		}
+		return fake.Success(map[string]interface{}{"label": "prod", "status": envStatus})
+	})
+	server.Handle("POST", "contentmanagement/promoteProject", func(_ *fake.Request) *fake.Response {
+		return fake.Success(1)
+	})
+	return server
+}
+
+func TestPromoteWait(t *testing.T) {
+	server := newPromoteServer(t, "built")
+	defer server.Close()
+
+	client, err := ctl_utils.Connect(server.ConnectionDetails())
+	if err != nil {
+		t.Fatalf("failed to connect: %s", err)
+	}
+
+	flags := promoteFlags{waitFlags: waitFlags{Wait: true, Timeout: time.Minute, Interval: time.Millisecond}}
+	if err := promote(client, "sles15", "dev", &flags); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	calls := server.CallsTo("contentmanagement/promoteProject")
+	testutils.AssertEquals(t, "Unexpected number of promote calls", 1, len(calls))
+	testutils.AssertEquals[interface{}](t, "Unexpected promoted environment", "dev", calls[0].Params["envLabel"])
+}
+
+func TestPromoteWaitFailed(t *testing.T) {
+	server := newPromoteServer(t, "failed")
+	defer server.Close()
+
+	client, err := ctl_utils.Connect(server.ConnectionDetails())
+	if err != nil {
+		t.Fatalf("failed to connect: %s", err)
+	}
+
+	flags := promoteFlags{waitFlags: waitFlags{Wait: true, Timeout: time.Minute, Interval: time.Millisecond}}
+	err = promote(client, "sles15", "dev", &flags)
+	if err == nil {
+		t.Fatal("expected a build failure")
+	}
+	testutils.AssertTrue(t, "Unexpected error: "+err.Error(), strings.Contains(err.Error(), "failed to build"))
+}
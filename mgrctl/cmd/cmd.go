@@ -11,11 +11,22 @@ import (
 
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
+	"github.com/uyuni-project/uyuni-tools/mgrctl/cmd/action"
+	"github.com/uyuni-project/uyuni-tools/mgrctl/cmd/activationkey"
 	"github.com/uyuni-project/uyuni-tools/mgrctl/cmd/api"
+	"github.com/uyuni-project/uyuni-tools/mgrctl/cmd/audit"
+	"github.com/uyuni-project/uyuni-tools/mgrctl/cmd/channel"
+	"github.com/uyuni-project/uyuni-tools/mgrctl/cmd/clm"
+	"github.com/uyuni-project/uyuni-tools/mgrctl/cmd/configchannel"
 	"github.com/uyuni-project/uyuni-tools/mgrctl/cmd/cp"
+	"github.com/uyuni-project/uyuni-tools/mgrctl/cmd/errata"
 	"github.com/uyuni-project/uyuni-tools/mgrctl/cmd/exec"
+	"github.com/uyuni-project/uyuni-tools/mgrctl/cmd/org"
 	"github.com/uyuni-project/uyuni-tools/mgrctl/cmd/proxy"
+	"github.com/uyuni-project/uyuni-tools/mgrctl/cmd/setup"
+	"github.com/uyuni-project/uyuni-tools/mgrctl/cmd/system"
 	"github.com/uyuni-project/uyuni-tools/mgrctl/cmd/term"
+	"github.com/uyuni-project/uyuni-tools/mgrctl/cmd/user"
 	"github.com/uyuni-project/uyuni-tools/shared/completion"
 	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
 	"github.com/uyuni-project/uyuni-tools/shared/types"
@@ -43,7 +54,9 @@ func NewUyunictlCommand() *cobra.Command {
 
 	rootCmd.PersistentPreRun = func(cmd *cobra.Command, _ []string) {
 		// do not log if running the completion cmd as the output is redirect to create a file to source
-		if cmd.Name() != "completion" {
+		// nor when computing the completions as the output is parsed by the shell
+		if cmd.Name() != "completion" && cmd.Name() != cobra.ShellCompRequestCmd &&
+			cmd.Name() != cobra.ShellCompNoDescRequestCmd {
 			utils.LogInit((cmd.Name() != "exec" && cmd.Name() != "term") || globalFlags.LogLevel == "trace")
 			utils.SetLogLevel(globalFlags.LogLevel)
 			log.Info().Msgf(L("Starting %s"), strings.Join(os.Args, " "))
@@ -57,6 +70,17 @@ func NewUyunictlCommand() *cobra.Command {
 	rootCmd.AddCommand(cp.NewCommand(globalFlags))
 	rootCmd.AddCommand(completion.NewCommand(globalFlags))
 	rootCmd.AddCommand(proxy.NewCommand(globalFlags))
+	rootCmd.AddCommand(system.NewCommand(globalFlags))
+	rootCmd.AddCommand(channel.NewCommand(globalFlags))
+	rootCmd.AddCommand(activationkey.NewCommand(globalFlags))
+	rootCmd.AddCommand(org.NewCommand(globalFlags))
+	rootCmd.AddCommand(user.NewCommand(globalFlags))
+	rootCmd.AddCommand(action.NewCommand(globalFlags))
+	rootCmd.AddCommand(clm.NewCommand(globalFlags))
+	rootCmd.AddCommand(audit.NewCommand(globalFlags))
+	rootCmd.AddCommand(errata.NewCommand(globalFlags))
+	rootCmd.AddCommand(configchannel.NewCommand(globalFlags))
+	rootCmd.AddCommand(setup.NewCommand(globalFlags))
 
 	rootCmd.AddCommand(utils.GetConfigHelpCommand())
 
@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package configchannel
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/configchannel"
+	apiTypes "github.com/uyuni-project/uyuni-tools/shared/api/types"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+)
+
+// NewCommand entry command for managing the configuration channels.
+func NewCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	configChannelCmd := &cobra.Command{
+		Use:   "configchannel",
+		Short: L("Manage the configuration channels"),
+		Run: func(cmd *cobra.Command, _ []string) {
+			_ = cmd.Help()
+		},
+	}
+
+	configChannelCmd.AddCommand(newListCommand(globalFlags))
+	configChannelCmd.AddCommand(newCreateCommand(globalFlags))
+	configChannelCmd.AddCommand(newPushCommand(globalFlags))
+	configChannelCmd.AddCommand(newPullCommand(globalFlags))
+	configChannelCmd.AddCommand(newDiffCommand(globalFlags))
+
+	return configChannelCmd
+}
+
+// findChannel returns the configuration channel with the label.
+func findChannel(client *api.APIClient, label string) (*apiTypes.ConfigChannel, error) {
+	channels, err := configchannel.ListGlobals(client)
+	if err != nil {
+		return nil, err
+	}
+	for _, channel := range channels {
+		if channel.Label == label {
+			return &channel, nil
+		}
+	}
+	return nil, fmt.Errorf(L("no configuration channel with label %s"), label)
+}
+
+// getChanges computes the changes between a local directory and a configuration channel.
+//
+// The state channels cannot contain directories: the local ones are ignored.
+func getChanges(client *api.APIClient, label string, dir string) ([]change, error) {
+	channel, err := findChannel(client, label)
+	if err != nil {
+		return nil, err
+	}
+	local, err := readLocalTree(dir)
+	if err != nil {
+		return nil, err
+	}
+	if channel.Type == configchannel.StateChannel {
+		for entryPath, item := range local {
+			if item.Type == configchannel.DirectoryType {
+				delete(local, entryPath)
+			}
+		}
+	}
+	remote, err := readChannelTree(client, label)
+	if err != nil {
+		return nil, err
+	}
+	return compareTrees(local, remote), nil
+}
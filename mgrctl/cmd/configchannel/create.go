@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package configchannel
+
+import (
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	ctl_utils "github.com/uyuni-project/uyuni-tools/mgrctl/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/configchannel"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+type createFlags struct {
+	ConnectionDetails api.ConnectionDetails `mapstructure:"api"`
+	Name              string
+	Description       string
+	Type              string
+}
+
+func newCreateCmd(globalFlags *types.GlobalFlags, run utils.CommandFunc[createFlags]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create label",
+		Short: L("Create a configuration channel"),
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var flags createFlags
+			return utils.CommandHelper(globalFlags, cmd, args, &flags, nil, run)
+		},
+	}
+
+	cmd.Flags().String("name", "", L("name of the channel. Defaults to the label"))
+	cmd.Flags().String("description", "", L("description of the channel. Defaults to the name"))
+	cmd.Flags().String("type", configchannel.NormalChannel,
+		fmt.Sprintf(L("type of the channel, %[1]s for files or %[2]s for Salt states"),
+			configchannel.NormalChannel, configchannel.StateChannel,
+		),
+	)
+	api.AddAPIFlags(cmd)
+
+	return cmd
+}
+
+func newCreateCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	return newCreateCmd(globalFlags, runCreate)
+}
+
+func runCreate(_ *types.GlobalFlags, flags *createFlags, _ *cobra.Command, args []string) error {
+	if flags.Type != configchannel.NormalChannel && flags.Type != configchannel.StateChannel {
+		return fmt.Errorf(L("invalid configuration channel type: %s"), flags.Type)
+	}
+	label := args[0]
+	name := flags.Name
+	if name == "" {
+		name = label
+	}
+	description := flags.Description
+	if description == "" {
+		description = name
+	}
+
+	client, err := ctl_utils.Connect(&flags.ConnectionDetails)
+	if err != nil {
+		return err
+	}
+
+	if err := configchannel.Create(client, label, name, description, flags.Type); err != nil {
+		return err
+	}
+	log.Info().Msgf(L("Configuration channel %s created"), label)
+	return nil
+}
@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package configchannel
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	ctl_utils "github.com/uyuni-project/uyuni-tools/mgrctl/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+type diffFlags struct {
+	ConnectionDetails api.ConnectionDetails `mapstructure:"api"`
+}
+
+func newDiffCmd(globalFlags *types.GlobalFlags, run utils.CommandFunc[diffFlags]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff label directory",
+		Short: L("Compare a local directory with a configuration channel"),
+		Long: L(`Compare a local directory with the latest revision of a configuration channel.
+
+One line is printed per difference with the path and a status:
+  A: only in the local directory
+  M: modified
+  D: only in the channel
+
+Only the contents of the files and the targets of the symbolic links are compared.
+The command exits with code 1 if there are differences.`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var flags diffFlags
+			return utils.CommandHelper(globalFlags, cmd, args, &flags, nil, run)
+		},
+	}
+
+	api.AddAPIFlags(cmd)
+
+	return cmd
+}
+
+func newDiffCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	return newDiffCmd(globalFlags, runDiff)
+}
+
+func runDiff(_ *types.GlobalFlags, flags *diffFlags, _ *cobra.Command, args []string) error {
+	client, err := ctl_utils.Connect(&flags.ConnectionDetails)
+	if err != nil {
+		return err
+	}
+
+	changes, err := getChanges(client, args[0], args[1])
+	if err != nil {
+		return err
+	}
+	return printChanges(os.Stdout, changes)
+}
+
+// printChanges writes the changes and returns an error with exit code 1 if there are some.
+func printChanges(w io.Writer, changes []change) error {
+	for _, c := range changes {
+		if _, err := fmt.Fprintf(w, "%s %s\n", c.Status, c.Entry.Path); err != nil {
+			return err
+		}
+	}
+	if len(changes) > 0 {
+		return &ctl_utils.ExitCodeError{Code: 1, Err: fmt.Errorf(L("%d differences found"), len(changes))}
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package configchannel
+
+import (
+	"github.com/spf13/cobra"
+	ctl_utils "github.com/uyuni-project/uyuni-tools/mgrctl/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+func newPullCmd(globalFlags *types.GlobalFlags, run utils.CommandFunc[syncFlags]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pull label directory",
+		Short: L("Download a configuration channel to a local directory"),
+		Long: L(`Write the latest revision of the files, directories and symbolic links of a configuration channel
+to a local directory.
+
+The directory is created if needed. The .git folders are ignored.
+
+Example:
+# mgrctl configchannel pull --delete webconfig ./webconfig`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var flags syncFlags
+			return utils.CommandHelper(globalFlags, cmd, args, &flags, nil, run)
+		},
+	}
+
+	cmd.Flags().Bool("delete", false, L("remove the local files missing in the channel"))
+	api.AddAPIFlags(cmd)
+
+	return cmd
+}
+
+func newPullCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	return newPullCmd(globalFlags, runPull)
+}
+
+func runPull(_ *types.GlobalFlags, flags *syncFlags, _ *cobra.Command, args []string) error {
+	client, err := ctl_utils.Connect(&flags.ConnectionDetails)
+	if err != nil {
+		return err
+	}
+
+	changes, err := getChanges(client, args[0], args[1])
+	if err != nil {
+		return err
+	}
+	if err := pullChanges(args[1], changes, flags.Delete); err != nil {
+		return err
+	}
+	logChanges(changes, flags.Delete, added)
+	return nil
+}
@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package configchannel
+
+import (
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	ctl_utils "github.com/uyuni-project/uyuni-tools/mgrctl/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+type syncFlags struct {
+	ConnectionDetails api.ConnectionDetails `mapstructure:"api"`
+	Delete            bool
+}
+
+func newPushCmd(globalFlags *types.GlobalFlags, run utils.CommandFunc[syncFlags]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "push label directory",
+		Short: L("Upload a local directory to a configuration channel"),
+		Long: L(`Upload the files, directories and symbolic links of a local directory to a configuration channel.
+
+The paths in the channel are the paths relative to the directory, like /etc/motd or /init.sls.
+Only the changed files are uploaded as new revisions. The .git folders are ignored.
+The permissions of the local files are used, the owner and group are always root.
+
+Example:
+# mgrctl configchannel push --delete webconfig ./webconfig`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var flags syncFlags
+			return utils.CommandHelper(globalFlags, cmd, args, &flags, nil, run)
+		},
+	}
+
+	cmd.Flags().Bool("delete", false, L("remove the channel files missing in the local directory"))
+	api.AddAPIFlags(cmd)
+
+	return cmd
+}
+
+func newPushCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	return newPushCmd(globalFlags, runPush)
+}
+
+func runPush(_ *types.GlobalFlags, flags *syncFlags, _ *cobra.Command, args []string) error {
+	client, err := ctl_utils.Connect(&flags.ConnectionDetails)
+	if err != nil {
+		return err
+	}
+
+	changes, err := getChanges(client, args[0], args[1])
+	if err != nil {
+		return err
+	}
+	if err := pushChanges(client, args[0], changes, flags.Delete); err != nil {
+		return err
+	}
+	logChanges(changes, flags.Delete, deleted)
+	return nil
+}
+
+// logChanges logs the applied changes.
+//
+// The changes with the removed status are only logged if remove is true.
+func logChanges(changes []change, remove bool, removed string) {
+	applied := 0
+	for _, c := range changes {
+		if c.Status == removed && !remove {
+			continue
+		}
+		log.Info().Msgf("%s %s", c.Status, c.Entry.Path)
+		applied++
+	}
+	log.Info().Msgf(L("%d changes applied"), applied)
+}
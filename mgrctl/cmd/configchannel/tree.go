@@ -0,0 +1,297 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package configchannel
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/configchannel"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+// Statuses of the changes between the local and the channel trees, as seen from the local tree.
+const (
+	added    = "A"
+	modified = "M"
+	deleted  = "D"
+)
+
+// entry is a file, directory or symlink of a configuration tree.
+type entry struct {
+	Type     string
+	Path     string
+	Contents []byte
+	Mode     fs.FileMode
+	Target   string
+}
+
+// change is a difference between the local and the channel trees.
+//
+// Channel is only set for the modified changes.
+type change struct {
+	Status  string
+	Entry   *entry
+	Channel *entry
+}
+
+// readLocalTree reads the entries of a local directory.
+//
+// The paths of the entries are relative to the directory and start with a slash like in the channels.
+// The .git folders are ignored.
+func readLocalTree(root string) (map[string]*entry, error) {
+	entries := map[string]*entry{}
+	err := filepath.WalkDir(root, func(localPath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if localPath == root {
+			return nil
+		}
+		if d.IsDir() && d.Name() == ".git" {
+			return filepath.SkipDir
+		}
+		rel, err := filepath.Rel(root, localPath)
+		if err != nil {
+			return err
+		}
+		item := entry{Path: "/" + filepath.ToSlash(rel)}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		item.Mode = info.Mode().Perm()
+
+		switch {
+		case d.IsDir():
+			item.Type = configchannel.DirectoryType
+		case info.Mode()&fs.ModeSymlink != 0:
+			item.Type = configchannel.SymlinkType
+			if item.Target, err = os.Readlink(localPath); err != nil {
+				return err
+			}
+		default:
+			item.Type = configchannel.FileType
+			if item.Contents, err = os.ReadFile(localPath); err != nil {
+				return err
+			}
+		}
+		entries[item.Path] = &item
+		return nil
+	})
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to read %s"), root)
+	}
+	return entries, nil
+}
+
+// readChannelTree gets the latest revision of all the entries of a configuration channel.
+func readChannelTree(client *api.APIClient, label string) (map[string]*entry, error) {
+	files, err := configchannel.ListFiles(client, label)
+	if err != nil {
+		return nil, err
+	}
+	entries := map[string]*entry{}
+	if len(files) == 0 {
+		return entries, nil
+	}
+
+	paths := []string{}
+	for _, file := range files {
+		paths = append(paths, file.Path)
+	}
+	infos, err := configchannel.LookupFileInfo(client, label, paths)
+	if err != nil {
+		return nil, err
+	}
+	for i := range infos {
+		info := &infos[i]
+		item := entry{Type: info.Type, Path: info.Path, Target: info.TargetPath}
+		// The permissions are returned as octal digits in a decimal number
+		if mode, err := strconv.ParseUint(strconv.Itoa(info.Permissions), 8, 32); err == nil {
+			item.Mode = fs.FileMode(mode)
+		}
+		if info.Type == configchannel.FileType {
+			if item.Contents, err = configchannel.GetContents(info); err != nil {
+				return nil, err
+			}
+		}
+		entries[item.Path] = &item
+	}
+	return entries, nil
+}
+
+// compareTrees computes the changes from the channel tree to the local one, sorted by path.
+//
+// Only the contents of the files and the targets of the symlinks are compared, not the permissions.
+// For added and modified changes the entry is the local one, for the deleted ones it is the channel one.
+func compareTrees(local map[string]*entry, channel map[string]*entry) []change {
+	changes := []change{}
+	for entryPath, localEntry := range local {
+		channelEntry, found := channel[entryPath]
+		switch {
+		case !found:
+			changes = append(changes, change{Status: added, Entry: localEntry})
+		case localEntry.Type != channelEntry.Type ||
+			!bytes.Equal(localEntry.Contents, channelEntry.Contents) ||
+			localEntry.Target != channelEntry.Target:
+			changes = append(changes, change{Status: modified, Entry: localEntry, Channel: channelEntry})
+		}
+	}
+	for entryPath, channelEntry := range channel {
+		if _, found := local[entryPath]; !found {
+			changes = append(changes, change{Status: deleted, Entry: channelEntry})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Entry.Path < changes[j].Entry.Path })
+	return changes
+}
+
+// pushChanges applies the local changes to the channel.
+//
+// The channel entries missing locally are only removed if remove is true.
+func pushChanges(client *api.APIClient, label string, changes []change, remove bool) error {
+	toDelete := []string{}
+	for _, c := range changes {
+		var err error
+		switch {
+		case c.Status == deleted:
+			toDelete = append(toDelete, c.Entry.Path)
+		case c.Entry.Type == configchannel.DirectoryType:
+			err = configchannel.CreateOrUpdateDirectory(client, label, c.Entry.Path, int(c.Entry.Mode))
+		case c.Entry.Type == configchannel.SymlinkType:
+			err = configchannel.CreateOrUpdateSymlink(client, label, c.Entry.Path, c.Entry.Target)
+		default:
+			err = configchannel.CreateOrUpdateFile(client, label, c.Entry.Path, c.Entry.Contents, int(c.Entry.Mode))
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if remove && len(toDelete) > 0 {
+		// Remove the directories after their content
+		sort.Sort(sort.Reverse(sort.StringSlice(toDelete)))
+		return configchannel.DeleteFiles(client, label, toDelete)
+	}
+	return nil
+}
+
+// pullChanges writes the channel entries in the local directory.
+//
+// The local entries missing in the channel are only removed if remove is true.
+// The entries are never written outside of the directory or through a symlink.
+func pullChanges(root string, changes []change, remove bool) error {
+	toDelete := []string{}
+	for _, c := range changes {
+		localPath, err := localEntryPath(root, c.Entry.Path)
+		if err != nil {
+			return err
+		}
+		if c.Status == added {
+			// The entry only exists locally
+			toDelete = append(toDelete, localPath)
+			continue
+		}
+		item := c.Entry
+		if c.Status == modified {
+			item = c.Channel
+			// The type may have changed
+			if err := os.RemoveAll(localPath); err != nil {
+				return utils.Errorf(err, L("failed to remove %s"), localPath)
+			}
+		}
+		// A symlink pulled before could point anywhere
+		if err := checkNoSymlink(root, localPath); err != nil {
+			return err
+		}
+		if err := writeEntry(localPath, item); err != nil {
+			return err
+		}
+	}
+
+	if remove {
+		// Remove the files before their directory
+		sort.Sort(sort.Reverse(sort.StringSlice(toDelete)))
+		for _, localPath := range toDelete {
+			if err := os.RemoveAll(localPath); err != nil {
+				return utils.Errorf(err, L("failed to remove %s"), localPath)
+			}
+		}
+	}
+	return nil
+}
+
+// localEntryPath computes the local path of a channel entry.
+//
+// Paths resolving outside of root are rejected.
+func localEntryPath(root string, entryPath string) (string, error) {
+	localPath := filepath.Join(root, filepath.FromSlash(entryPath))
+	rel, err := filepath.Rel(root, localPath)
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf(L("entry %[1]s is outside of %[2]s"), entryPath, root)
+	}
+	return localPath, nil
+}
+
+// checkNoSymlink fails if localPath or one of its parents below root is a symlink.
+func checkNoSymlink(root string, localPath string) error {
+	rel, err := filepath.Rel(root, localPath)
+	if err != nil {
+		return err
+	}
+	current := root
+	for _, part := range strings.Split(rel, string(filepath.Separator)) {
+		current = filepath.Join(current, part)
+		info, err := os.Lstat(current)
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		if err != nil {
+			return utils.Errorf(err, L("failed to read %s"), current)
+		}
+		if info.Mode()&fs.ModeSymlink != 0 {
+			return fmt.Errorf(L("refusing to write %[1]s through the %[2]s symlink"), localPath, current)
+		}
+	}
+	return nil
+}
+
+func writeEntry(localPath string, item *entry) error {
+	if err := os.MkdirAll(filepath.Dir(localPath), 0755); err != nil {
+		return utils.Errorf(err, L("failed to create the parent directory of %s"), localPath)
+	}
+	mode := item.Mode
+	var err error
+	switch item.Type {
+	case configchannel.DirectoryType:
+		if mode == 0 {
+			mode = 0755
+		}
+		err = os.MkdirAll(localPath, mode)
+	case configchannel.SymlinkType:
+		err = os.Symlink(item.Target, localPath)
+	case configchannel.FileType:
+		if mode == 0 {
+			mode = 0644
+		}
+		err = os.WriteFile(localPath, item.Contents, mode)
+	default:
+		err = fmt.Errorf(L("unsupported type: %s"), item.Type)
+	}
+	if err != nil {
+		return utils.Errorf(err, L("failed to write %s"), localPath)
+	}
+	return nil
+}
@@ -0,0 +1,196 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package configchannel
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"os"
+	"path"
+	"strconv"
+	"testing"
+
+	ctl_utils "github.com/uyuni-project/uyuni-tools/mgrctl/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared/api/fake"
+	apiTypes "github.com/uyuni-project/uyuni-tools/shared/api/types"
+	"github.com/uyuni-project/uyuni-tools/shared/testutils"
+)
+
+// newConfigServer returns a fake server with an empty webconfig configuration channel.
+func newConfigServer(t *testing.T) (*fake.Server, map[string]*apiTypes.ConfigFileInfo) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	server := fake.NewServer("admin", "secret")
+	files := map[string]*apiTypes.ConfigFileInfo{}
+
+	server.Handle("GET", "configchannel/listGlobals", func(_ *fake.Request) *fake.Response {
+		return fake.Success([]apiTypes.ConfigChannel{{ID: 1, Label: "webconfig", Type: "normal"}})
+	})
+	server.Handle("GET", "configchannel/listFiles", func(_ *fake.Request) *fake.Response {
+		result := []apiTypes.ConfigFile{}
+		for _, file := range files {
+			result = append(result, apiTypes.ConfigFile{Type: file.Type, Path: file.Path})
+		}
+		return fake.Success(result)
+	})
+	server.Handle("GET", "configchannel/lookupFileInfo", func(req *fake.Request) *fake.Response {
+		paths, ok := req.Params["paths"].([]string)
+		if !ok {
+			paths = []string{req.Params["paths"].(string)}
+		}
+		result := []apiTypes.ConfigFileInfo{}
+		for _, filePath := range paths {
+			result = append(result, *files[filePath])
+		}
+		return fake.Success(result)
+	})
+	server.Handle("POST", "configchannel/createOrUpdatePath", func(req *fake.Request) *fake.Response {
+		info := req.Params["pathInfo"].(map[string]interface{})
+		file := apiTypes.ConfigFileInfo{Path: req.Params["path"].(string), Type: "directory"}
+		file.Permissions, _ = strconv.Atoi(info["permissions"].(string))
+		if !req.Params["isDir"].(bool) {
+			file.Type = "file"
+			file.Contents = info["contents"].(string)
+			file.Base64 = info["contents_enc64"].(bool)
+		}
+		files[file.Path] = &file
+		return fake.Success(file)
+	})
+	server.Handle("POST", "configchannel/createOrUpdateSymlink", func(req *fake.Request) *fake.Response {
+		info := req.Params["pathInfo"].(map[string]interface{})
+		file := apiTypes.ConfigFileInfo{
+			Path: req.Params["path"].(string), Type: "symlink", TargetPath: info["target_path"].(string),
+		}
+		files[file.Path] = &file
+		return fake.Success(file)
+	})
+	server.Handle("POST", "configchannel/deleteFiles", func(req *fake.Request) *fake.Response {
+		for _, filePath := range req.Params["paths"].([]interface{}) {
+			delete(files, filePath.(string))
+		}
+		return fake.Success(1)
+	})
+	return server, files
+}
+
+func TestPushPullDiff(t *testing.T) {
+	server, files := newConfigServer(t)
+	defer server.Close()
+
+	client, err := ctl_utils.Connect(server.ConnectionDetails())
+	if err != nil {
+		t.Fatalf("failed to connect: %s", err)
+	}
+
+	local := t.TempDir()
+	for _, dir := range []string{"etc", ".git"} {
+		if err := os.Mkdir(path.Join(local, dir), 0755); err != nil {
+			t.Fatalf("failed to create a directory: %s", err)
+		}
+	}
+	testutils.WriteFile(t, path.Join(local, "etc", "motd"), "Welcome\n")
+	if err := os.Chmod(path.Join(local, "etc", "motd"), 0600); err != nil {
+		t.Fatalf("failed to change the permissions: %s", err)
+	}
+	if err := os.Symlink("motd", path.Join(local, "etc", "issue")); err != nil {
+		t.Fatalf("failed to create a symlink: %s", err)
+	}
+	testutils.WriteFile(t, path.Join(local, ".git", "HEAD"), "ref: refs/heads/main\n")
+
+	changes, err := getChanges(client, "webconfig", local)
+	if err != nil {
+		t.Fatalf("failed to compare: %s", err)
+	}
+	if err := pushChanges(client, "webconfig", changes, false); err != nil {
+		t.Fatalf("failed to push: %s", err)
+	}
+	testutils.AssertEquals(t, "Unexpected number of channel files", 3, len(files))
+	testutils.AssertEquals(t, "Unexpected permissions", 600, files["/etc/motd"].Permissions)
+	testutils.AssertEquals(t, "Unexpected symlink target", "motd", files["/etc/issue"].TargetPath)
+
+	// Nothing to change after the push
+	changes, err = getChanges(client, "webconfig", local)
+	if err != nil {
+		t.Fatalf("failed to compare: %s", err)
+	}
+	testutils.AssertEquals(t, "Unexpected changes after push", 0, len(changes))
+
+	// Pull the channel in another directory
+	pulled := path.Join(t.TempDir(), "webconfig")
+	changes, err = getChanges(client, "webconfig", t.TempDir())
+	if err != nil {
+		t.Fatalf("failed to compare: %s", err)
+	}
+	if err := pullChanges(pulled, changes, false); err != nil {
+		t.Fatalf("failed to pull: %s", err)
+	}
+	content, err := os.ReadFile(path.Join(pulled, "etc", "motd"))
+	if err != nil {
+		t.Fatalf("failed to read the pulled file: %s", err)
+	}
+	testutils.AssertEquals(t, "Unexpected pulled content", "Welcome\n", string(content))
+	info, err := os.Stat(path.Join(pulled, "etc", "motd"))
+	if err != nil {
+		t.Fatalf("failed to stat the pulled file: %s", err)
+	}
+	testutils.AssertEquals(t, "Unexpected pulled permissions", os.FileMode(0600), info.Mode().Perm())
+
+	// Change the channel and check the differences
+	files["/etc/motd"].Contents = base64.StdEncoding.EncodeToString([]byte("Changed\n"))
+	files["/etc/hosts"] = &apiTypes.ConfigFileInfo{Path: "/etc/hosts", Type: "file", Contents: "127.0.0.1"}
+	testutils.WriteFile(t, path.Join(local, "etc", "fstab"), "")
+	changes, err = getChanges(client, "webconfig", local)
+	if err != nil {
+		t.Fatalf("failed to compare: %s", err)
+	}
+	var out bytes.Buffer
+	err = printChanges(&out, changes)
+	testutils.AssertEquals(t, "Unexpected differences", "A /etc/fstab\nD /etc/hosts\nM /etc/motd\n", out.String())
+	var exitErr *ctl_utils.ExitCodeError
+	testutils.AssertTrue(t, "Differences should exit with code 1", errors.As(err, &exitErr) && exitErr.Code == 1)
+
+	// Pulling the modified file gets the channel version
+	changes, err = getChanges(client, "webconfig", pulled)
+	if err != nil {
+		t.Fatalf("failed to compare: %s", err)
+	}
+	if err := pullChanges(pulled, changes, false); err != nil {
+		t.Fatalf("failed to pull: %s", err)
+	}
+	testutils.AssertEquals(t, "Unexpected pulled modified content", "Changed\n",
+		testutils.ReadFile(t, path.Join(pulled, "etc", "motd")),
+	)
+
+	// Push with delete
+	if err := pushChanges(client, "webconfig", changes, true); err != nil {
+		t.Fatalf("failed to push: %s", err)
+	}
+	_, found := files["/etc/hosts"]
+	testutils.AssertTrue(t, "Missing local files should be removed", !found)
+}
+
+func TestPullOutsideRoot(t *testing.T) {
+	root := path.Join(t.TempDir(), "pulled")
+	outside := path.Join(path.Dir(root), "outside")
+
+	escaping := []change{{Status: deleted, Entry: &entry{Type: "file", Path: "/../outside", Contents: []byte("x")}}}
+	err := pullChanges(root, escaping, false)
+	testutils.AssertTrue(t, "Entries outside of the directory should fail", err != nil)
+	_, err = os.Stat(outside)
+	testutils.AssertTrue(t, "No file should be written outside of the directory", errors.Is(err, os.ErrNotExist))
+
+	// A symlink pulled first must not be written through
+	if err := os.Mkdir(outside, 0755); err != nil {
+		t.Fatalf("failed to create a directory: %s", err)
+	}
+	throughLink := []change{
+		{Status: deleted, Entry: &entry{Type: "symlink", Path: "/etc", Target: outside}},
+		{Status: deleted, Entry: &entry{Type: "file", Path: "/etc/motd", Contents: []byte("x")}},
+	}
+	err = pullChanges(root, throughLink, false)
+	testutils.AssertTrue(t, "Writing through a symlink should fail", err != nil)
+	_, err = os.Stat(path.Join(outside, "motd"))
+	testutils.AssertTrue(t, "No file should be written through the symlink", errors.Is(err, os.ErrNotExist))
+}
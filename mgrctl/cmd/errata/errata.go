@@ -0,0 +1,26 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package errata
+
+import (
+	"github.com/spf13/cobra"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+)
+
+// NewCommand entry command for the patches reports.
+func NewCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	errataCmd := &cobra.Command{
+		Use:   "errata",
+		Short: L("Report on the patches"),
+		Run: func(cmd *cobra.Command, _ []string) {
+			_ = cmd.Help()
+		},
+	}
+
+	errataCmd.AddCommand(newReportCommand(globalFlags))
+
+	return errataCmd
+}
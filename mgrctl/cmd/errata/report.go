@@ -0,0 +1,167 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package errata
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	ctl_utils "github.com/uyuni-project/uyuni-tools/mgrctl/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/errata"
+	"github.com/uyuni-project/uyuni-tools/shared/api/system"
+	"github.com/uyuni-project/uyuni-tools/shared/api/systemgroup"
+	apiTypes "github.com/uyuni-project/uyuni-tools/shared/api/types"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+type reportFlags struct {
+	ConnectionDetails api.ConnectionDetails `mapstructure:"api"`
+	Group             string
+	Type              string
+	CVEs              bool `mapstructure:"cves"`
+	Output            string
+}
+
+// missingPatch is a patch relevant to a system as printed in the report.
+type missingPatch struct {
+	SystemID   int              `json:"system_id"`
+	SystemName string           `json:"system_name"`
+	Advisory   string           `json:"advisory"`
+	Type       string           `json:"type"`
+	Synopsis   string           `json:"synopsis"`
+	IssueDate  apiTypes.APITime `json:"issue_date"`
+	CVEs       []string         `json:"cves,omitempty"`
+}
+
+func newReportCmd(globalFlags *types.GlobalFlags, run utils.CommandFunc[reportFlags]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "report",
+		Short: L("Report the patches missing on the systems"),
+		Long: L(`Report the relevant patches not installed on the systems, one line per system and patch.
+
+Example to get a CSV report of the security patches missing on the web group with their CVEs:
+# mgrctl errata report --group web --type security --cves -o csv`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var flags reportFlags
+			return utils.CommandHelper(globalFlags, cmd, args, &flags, nil, run)
+		},
+	}
+
+	cmd.Flags().String("group", "", L("only report the systems of this group"))
+	cmd.Flags().String("type", "", L("only report the patches of this type: security, bugfix or enhancement"))
+	cmd.Flags().Bool("cves", false, L("add the CVEs fixed by the patches to the report"))
+	ctl_utils.AddOutputFlag(cmd)
+	api.AddAPIFlags(cmd)
+
+	return cmd
+}
+
+func newReportCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	return newReportCmd(globalFlags, runReport)
+}
+
+func runReport(_ *types.GlobalFlags, flags *reportFlags, _ *cobra.Command, _ []string) error {
+	client, err := ctl_utils.Connect(&flags.ConnectionDetails)
+	if err != nil {
+		return err
+	}
+
+	report, err := getReport(client, flags)
+	if err != nil {
+		return err
+	}
+
+	return ctl_utils.PrintOutput(os.Stdout, flags.Output, report, func() *ctl_utils.Table {
+		table := ctl_utils.Table{
+			Headers: []string{L("SYSTEM ID"), L("SYSTEM"), L("ADVISORY"), L("TYPE"), L("ISSUED"), L("SYNOPSIS")},
+		}
+		if flags.CVEs {
+			table.Headers = append(table.Headers, L("CVES"))
+		}
+		for _, patch := range report {
+			row := []string{
+				strconv.Itoa(patch.SystemID), patch.SystemName, patch.Advisory, patch.Type,
+				ctl_utils.FormatTime(patch.IssueDate.Time), patch.Synopsis,
+			}
+			if flags.CVEs {
+				row = append(row, strings.Join(patch.CVEs, " "))
+			}
+			table.AddRow(row...)
+		}
+		return &table
+	})
+}
+
+// typeKeywords maps the --type values to a word of the advisory types.
+var typeKeywords = map[string]string{
+	"security":    "security",
+	"bugfix":      "bug",
+	"enhancement": "enhancement",
+}
+
+// getReport lists the relevant patches of the systems sorted by system name and advisory.
+func getReport(client *api.APIClient, flags *reportFlags) ([]missingPatch, error) {
+	keyword := ""
+	if flags.Type != "" {
+		var ok bool
+		if keyword, ok = typeKeywords[strings.ToLower(flags.Type)]; !ok {
+			return nil, fmt.Errorf(L("invalid patch type: %s"), flags.Type)
+		}
+	}
+
+	var systems []apiTypes.SystemOverview
+	var err error
+	if flags.Group != "" {
+		systems, err = systemgroup.ListSystemsMinimal(client, flags.Group)
+	} else {
+		systems, err = system.ListSystems(client)
+	}
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(systems, func(i, j int) bool { return systems[i].Name < systems[j].Name })
+
+	cves := map[string][]string{}
+	report := []missingPatch{}
+	for _, s := range systems {
+		patches, err := system.GetRelevantErrata(client, s.ID)
+		if err != nil {
+			return nil, err
+		}
+		sort.Slice(patches, func(i, j int) bool { return patches[i].Advisory < patches[j].Advisory })
+		for _, patch := range patches {
+			if !strings.Contains(strings.ToLower(patch.Type), keyword) {
+				continue
+			}
+			missing := missingPatch{
+				SystemID:   s.ID,
+				SystemName: s.Name,
+				Advisory:   patch.Advisory,
+				Type:       patch.Type,
+				Synopsis:   patch.Synopsis,
+				IssueDate:  patch.IssueDate,
+			}
+			if flags.CVEs {
+				// The same patches are usually relevant to many systems
+				if _, found := cves[patch.Advisory]; !found {
+					if cves[patch.Advisory], err = errata.ListCves(client, patch.Advisory); err != nil {
+						return nil, err
+					}
+				}
+				missing.CVEs = cves[patch.Advisory]
+			}
+			report = append(report, missing)
+		}
+	}
+	return report, nil
+}
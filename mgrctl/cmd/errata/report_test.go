@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package errata
+
+import (
+	"testing"
+
+	ctl_utils "github.com/uyuni-project/uyuni-tools/mgrctl/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared/api/fake"
+	"github.com/uyuni-project/uyuni-tools/shared/testutils"
+)
+
+func TestGetReport(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	server := fake.NewServer("admin", "secret")
+	defer server.Close()
+
+	server.Handle("GET", "systemgroup/listSystemsMinimal", func(_ *fake.Request) *fake.Response {
+		return fake.Success([]interface{}{
+			map[string]interface{}{"id": 2, "name": "web2"},
+			map[string]interface{}{"id": 1, "name": "web1"},
+		})
+	})
+	server.Handle("GET", "system/getRelevantErrata", func(_ *fake.Request) *fake.Response {
+		return fake.Success([]interface{}{
+			map[string]interface{}{"advisory_name": "SUSE-2", "advisory_type": "Bug Fix Advisory"},
+			map[string]interface{}{"advisory_name": "SUSE-1", "advisory_type": "Security Advisory"},
+		})
+	})
+	server.Handle("GET", "errata/listCves", func(_ *fake.Request) *fake.Response {
+		return fake.Success([]string{"CVE-2024-3094"})
+	})
+
+	client, err := ctl_utils.Connect(server.ConnectionDetails())
+	if err != nil {
+		t.Fatalf("failed to connect: %s", err)
+	}
+
+	report, err := getReport(client, &reportFlags{Group: "web", Type: "security", CVEs: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	testutils.AssertEquals(t, "Unexpected number of missing patches", 2, len(report))
+	testutils.AssertEquals(t, "Unexpected first system", "web1", report[0].SystemName)
+	testutils.AssertEquals(t, "Unexpected advisory", "SUSE-1", report[0].Advisory)
+	testutils.AssertEquals(t, "Unexpected CVEs", []string{"CVE-2024-3094"}, report[1].CVEs)
+	testutils.AssertEquals(t, "The CVEs should be fetched once per patch", 1, len(server.CallsTo("errata/listCves")))
+	testutils.AssertEquals[interface{}](t, "Unexpected group", "web",
+		server.CallsTo("systemgroup/listSystemsMinimal")[0].Params["systemGroupName"],
+	)
+
+	report, err = getReport(client, &reportFlags{Group: "web"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	testutils.AssertEquals(t, "Unexpected number of patches without type filter", 4, len(report))
+
+	_, err = getReport(client, &reportFlags{Type: "feature"})
+	testutils.AssertTrue(t, "Invalid types should fail", err != nil)
+}
@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package org
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	ctl_utils "github.com/uyuni-project/uyuni-tools/mgrctl/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/org"
+	apiTypes "github.com/uyuni-project/uyuni-tools/shared/api/types"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+// orgDefinition describes an organization to create with its administrator.
+type orgDefinition struct {
+	Name          string `yaml:"name"`
+	apiTypes.User `yaml:",inline"`
+	Pam           bool `yaml:"pam,omitempty"`
+}
+
+type createFlags struct {
+	ConnectionDetails api.ConnectionDetails `mapstructure:"api"`
+	Admin             apiTypes.User
+	Pam               bool
+	File              string
+}
+
+func newCreateCmd(globalFlags *types.GlobalFlags, run utils.CommandFunc[createFlags]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create [name]",
+		Short: L("Create organizations"),
+		Long: L(`Create an organization with its administrator or several ones from a file.
+
+The file is either a YAML list or a CSV file with a header line.
+The fields are name, login, password, firstName, lastName, email and pam.
+Existing organizations are skipped.
+
+YAML example:
+- name: Team A
+  login: teama-admin
+  password: secret
+  firstName: Ada
+  lastName: Admin
+  email: teama@example.com
+
+CSV example:
+name,login,password,firstName,lastName,email
+Team A,teama-admin,secret,Ada,Admin,teama@example.com`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var flags createFlags
+			return utils.CommandHelper(globalFlags, cmd, args, &flags, nil, run)
+		},
+	}
+
+	cmd.Flags().String("admin-login", "", L("Administrator user name"))
+	cmd.Flags().String("admin-password", "", L("Administrator password"))
+	cmd.Flags().String("admin-firstName", "", L("First name of the administrator"))
+	cmd.Flags().String("admin-lastName", "", L("Last name of the administrator"))
+	cmd.Flags().String("admin-email", "", L("Email of the administrator"))
+	cmd.Flags().Bool("pam", false, L("Use PAM authentication for the administrator"))
+	cmd.Flags().StringP("file", "f", "", L("YAML or CSV file describing the organizations to create"))
+	api.AddAPIFlags(cmd)
+
+	return cmd
+}
+
+func newCreateCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	return newCreateCmd(globalFlags, runCreate)
+}
+
+func runCreate(_ *types.GlobalFlags, flags *createFlags, _ *cobra.Command, args []string) error {
+	var defs []orgDefinition
+	if flags.File != "" {
+		if len(args) > 0 {
+			return errors.New(L("the organization name and --file cannot be used together"))
+		}
+		var err error
+		if defs, err = ctl_utils.ReadRecords[orgDefinition](flags.File); err != nil {
+			return err
+		}
+	} else {
+		if len(args) == 0 {
+			return errors.New(L("either the organization name or --file is required"))
+		}
+		def := orgDefinition{Name: args[0], User: flags.Admin, Pam: flags.Pam}
+		if !def.Pam {
+			utils.AskPasswordIfMissing(&def.Password, L("Administrator password"), 5, 48)
+		}
+		defs = append(defs, def)
+	}
+
+	for _, def := range defs {
+		if def.Name == "" || def.Login == "" {
+			return errors.New(L("the organization name and administrator login are required"))
+		}
+		if def.Password == "" && !def.Pam {
+			return fmt.Errorf(L("missing password for the administrator of organization %s"), def.Name)
+		}
+	}
+
+	client, err := ctl_utils.Connect(&flags.ConnectionDetails)
+	if err != nil {
+		return err
+	}
+	return createOrgs(client, defs)
+}
+
+func createOrgs(client *api.APIClient, defs []orgDefinition) error {
+	existing, err := org.ListOrgs(client)
+	if err != nil {
+		return err
+	}
+	names := map[string]bool{}
+	for _, o := range existing {
+		names[o.Name] = true
+	}
+
+	for _, def := range defs {
+		if names[def.Name] {
+			log.Info().Msgf(L("Organization %s already exists, skipping"), def.Name)
+			continue
+		}
+		created, err := org.Create(client, def.Name, &def.User, def.Pam)
+		if err != nil {
+			return err
+		}
+		names[def.Name] = true
+		log.Info().Msgf(L("Created organization %[1]s with ID %[2]d"), created.Name, created.ID)
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package org
+
+import (
+	"path"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/uyuni-project/uyuni-tools/shared/api/fake"
+	"github.com/uyuni-project/uyuni-tools/shared/testutils"
+	"github.com/uyuni-project/uyuni-tools/shared/testutils/flagstests"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+)
+
+func TestCreateParamsParsing(t *testing.T) {
+	args := []string{
+		"--admin-login", "teama-admin",
+		"--admin-password", "secret",
+		"--admin-firstName", "Ada",
+		"--admin-lastName", "Admin",
+		"--admin-email", "teama@example.com",
+		"--pam",
+		"--file", "orgs.yaml",
+		"Team A",
+	}
+	args = append(args, flagstests.APIFlagsTestArgs...)
+
+	// Test function asserting that the args are properly parsed
+	tester := func(_ *types.GlobalFlags, flags *createFlags, _ *cobra.Command, args []string) error {
+		flagstests.AssertAPIFlags(t, &flags.ConnectionDetails)
+		testutils.AssertEquals(t, "Error parsing --admin-login", "teama-admin", flags.Admin.Login)
+		testutils.AssertEquals(t, "Error parsing --admin-password", "secret", flags.Admin.Password)
+		testutils.AssertEquals(t, "Error parsing --admin-firstName", "Ada", flags.Admin.FirstName)
+		testutils.AssertEquals(t, "Error parsing --admin-lastName", "Admin", flags.Admin.LastName)
+		testutils.AssertEquals(t, "Error parsing --admin-email", "teama@example.com", flags.Admin.Email)
+		testutils.AssertTrue(t, "Error parsing --pam", flags.Pam)
+		testutils.AssertEquals(t, "Error parsing --file", "orgs.yaml", flags.File)
+		testutils.AssertEquals(t, "Unexpected args", []string{"Team A"}, args)
+		return nil
+	}
+
+	globalFlags := types.GlobalFlags{}
+	cmd := newCreateCmd(&globalFlags, tester)
+
+	testutils.AssertHasAllFlags(t, cmd, args)
+
+	cmd.SetArgs(args)
+	if err := cmd.Execute(); err != nil {
+		t.Errorf("command failed with error: %s", err)
+	}
+}
+
+func TestRunCreateFromFile(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	server := fake.NewServer("admin", "secret")
+	defer server.Close()
+
+	server.Handle("GET", "org/listOrgs", func(_ *fake.Request) *fake.Response {
+		return fake.Success([]map[string]interface{}{{"id": 1, "name": "Default", "active_users": 2}})
+	})
+	server.Handle("POST", "org/create", func(req *fake.Request) *fake.Response {
+		return fake.Success(map[string]interface{}{"id": 2, "name": req.Params["orgName"]})
+	})
+
+	file := path.Join(t.TempDir(), "orgs.yaml")
+	testutils.WriteFile(t, file, `- name: Default
+  login: admin
+  password: secret
+- name: Team A
+  login: teama-admin
+  password: secret
+  firstName: Ada
+  lastName: Admin
+  email: teama@example.com
+`)
+
+	flags := createFlags{ConnectionDetails: *server.ConnectionDetails(), File: file}
+	if err := runCreate(nil, &flags, nil, []string{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	calls := server.CallsTo("org/create")
+	testutils.AssertEquals(t, "Existing organizations should be skipped", 1, len(calls))
+	testutils.AssertEquals[interface{}](t, "Unexpected organization", "Team A", calls[0].Params["orgName"])
+	testutils.AssertEquals[interface{}](t, "Unexpected admin login", "teama-admin", calls[0].Params["adminLogin"])
+	testutils.AssertEquals[interface{}](t, "Unexpected admin email", "teama@example.com", calls[0].Params["email"])
+}
@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package org
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	ctl_utils "github.com/uyuni-project/uyuni-tools/mgrctl/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/org"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+type deleteFlags struct {
+	ConnectionDetails api.ConnectionDetails `mapstructure:"api"`
+	Force             bool
+}
+
+func newDeleteCmd(globalFlags *types.GlobalFlags, run utils.CommandFunc[deleteFlags]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete name...",
+		Short: L("Delete organizations"),
+		Long:  L("Delete organizations with all their users, systems and content."),
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var flags deleteFlags
+			return utils.CommandHelper(globalFlags, cmd, args, &flags, nil, run)
+		},
+	}
+
+	cmd.Flags().BoolP("force", "f", false, L("do not ask for confirmation"))
+	api.AddAPIFlags(cmd)
+
+	return cmd
+}
+
+func newDeleteCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	return newDeleteCmd(globalFlags, runDelete)
+}
+
+func runDelete(_ *types.GlobalFlags, flags *deleteFlags, _ *cobra.Command, args []string) error {
+	client, err := ctl_utils.Connect(&flags.ConnectionDetails)
+	if err != nil {
+		return err
+	}
+
+	ids := []int{}
+	for _, name := range args {
+		details, err := org.GetDetails(client, name)
+		if err != nil {
+			return err
+		}
+		ids = append(ids, details.ID)
+	}
+
+	if !flags.Force {
+		confirmed, err := utils.YesNo(fmt.Sprintf(L("Delete organizations %s and all their content"),
+			strings.Join(args, ", ")),
+		)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return errors.New(L("deletion cancelled"))
+		}
+	}
+
+	for i, id := range ids {
+		if err := org.Delete(client, id); err != nil {
+			return err
+		}
+		log.Info().Msgf(L("Deleted organization %s"), args[i])
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package org
+
+import (
+	"github.com/spf13/cobra"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+)
+
+// NewCommand entry command for managing the organizations.
+func NewCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	orgCmd := &cobra.Command{
+		Use:   "org",
+		Short: L("Manage the organizations"),
+		Run: func(cmd *cobra.Command, _ []string) {
+			_ = cmd.Help()
+		},
+	}
+
+	orgCmd.AddCommand(newListCommand(globalFlags))
+	orgCmd.AddCommand(newCreateCommand(globalFlags))
+	orgCmd.AddCommand(newDeleteCommand(globalFlags))
+	orgCmd.AddCommand(newTrustCommand(globalFlags))
+
+	return orgCmd
+}
@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package org
+
+import (
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	ctl_utils "github.com/uyuni-project/uyuni-tools/mgrctl/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/org"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+type trustFlags struct {
+	ConnectionDetails api.ConnectionDetails `mapstructure:"api"`
+	Remove            bool
+}
+
+func newTrustCmd(globalFlags *types.GlobalFlags, run utils.CommandFunc[trustFlags]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trust name trusted-name...",
+		Short: L("Manage the trusts between organizations"),
+		Long: L(`Add or remove trusts between an organization and other ones.
+
+Trusted organizations can share content like software channels.`),
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var flags trustFlags
+			return utils.CommandHelper(globalFlags, cmd, args, &flags, nil, run)
+		},
+	}
+
+	cmd.Flags().Bool("remove", false, L("remove the trusts instead of adding them"))
+	api.AddAPIFlags(cmd)
+
+	return cmd
+}
+
+func newTrustCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	return newTrustCmd(globalFlags, runTrust)
+}
+
+func runTrust(_ *types.GlobalFlags, flags *trustFlags, _ *cobra.Command, args []string) error {
+	client, err := ctl_utils.Connect(&flags.ConnectionDetails)
+	if err != nil {
+		return err
+	}
+
+	details, err := org.GetDetails(client, args[0])
+	if err != nil {
+		return err
+	}
+	trusts, err := org.ListTrusts(client, details.ID)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range args[1:] {
+		other, err := org.GetDetails(client, name)
+		if err != nil {
+			return err
+		}
+		trusted := false
+		for _, trust := range trusts {
+			trusted = trusted || trust.OrgID == other.ID && trust.TrustEnabled
+		}
+
+		if flags.Remove && trusted {
+			if err := org.RemoveTrust(client, details.ID, other.ID); err != nil {
+				return err
+			}
+			log.Info().Msgf(L("Removed trust between %[1]s and %[2]s"), args[0], name)
+		} else if !flags.Remove && !trusted {
+			if err := org.AddTrust(client, details.ID, other.ID); err != nil {
+				return err
+			}
+			log.Info().Msgf(L("Added trust between %[1]s and %[2]s"), args[0], name)
+		}
+	}
+	return nil
+}
@@ -28,6 +28,7 @@ const (
 	proxyCrt       = "ssl-proxy-cert"
 	proxyKey       = "ssl-proxy-key"
 	sslEmail       = "ssl-email"
+	batchFile      = "from"
 )
 
 type proxyFlags struct {
@@ -56,21 +57,17 @@ type proxyCreateConfigFlags struct {
 	Proxy             proxyFlags
 	Output            string
 	SSL               proxyConfigSSLFlags
-}
-
-// proxyCreateConfigRequiredFields is a set of required fields for validation.
-var proxyCreateConfigRequiredFields = []string{
-	proxyName,
-	server,
-	email,
-	caCrt,
+	From              string
 }
 
 func newCmd(globalFlags *types.GlobalFlags, run utils.CommandFunc[proxyCreateConfigFlags]) *cobra.Command {
 	createConfigCmd := &cobra.Command{
 		Use:   "config",
 		Short: L("Create a proxy configuration file"),
-		Long:  L("Create a proxy configuration file"),
+		Long: L(`Create a proxy configuration file
+
+With --from, the configuration files of all the proxies defined in a YAML or CSV file are created at once.
+The flags are used as default values for the proxies and --output is the folder to write the files to.`),
 		Example: `  Create a proxy configuration file providing certificates providing only required parameters
 
     $ mgrctl proxy create config --proxy-name="proxy.example.com" --proxy-parent="server.example.com" \
@@ -119,6 +116,23 @@ func newCmd(globalFlags *types.GlobalFlags, run utils.CommandFunc[proxyCreateCon
 		--ssl-country="DE" --ssl-state="Bayern" --ssl-city="Nuernberg" --ssl-org="orgExample" --ssl-ou="orgUnitExample" \
 		--ssl-email="sslEmail@example.com" -o="proxy-config"
 
+  Create the configuration files of several proxies with generated certificates
+
+    $ mgrctl proxy create config --from proxies.yaml --proxy-parent="server.example.com" \
+		--proxy-email="admin@org.com" --ssl-ca-cert="ca.pem" --ssl-ca-key="caKey.pem" -o="proxies"
+
+  with proxies.yaml containing the proxies and their specific values:
+
+    - name: proxy1.example.com
+      cnames: [proxy1.example.org]
+    - name: proxy2.example.com
+      maxCache: 204800
+      output: proxy2-config
+
+  The other keys are sshPort, parent, email, sslEmail and, for certificates signed by a third party CA,
+  cert, key and intermediate. CSV files need a header line with the same names and separate the
+  list values with semicolons.
+
   Note that passing the CA password using --ssl-ca-password is not secure, use --config config.yaml with config.yaml
   containing the following as this will not persist in the shell history. Alternativaly the password can be defined in
   an UYUNI_SSL_CA_PASSWORD environment variable.
@@ -135,10 +149,11 @@ func newCmd(globalFlags *types.GlobalFlags, run utils.CommandFunc[proxyCreateCon
 
 	addFlags(createConfigCmd)
 
-	// validations
-	utils.MarkMandatoryFlags(createConfigCmd, proxyCreateConfigRequiredFields[:])
-	createConfigCmd.MarkFlagsOneRequired(proxyCrt, caKey)
+	// validations, the required values are checked in checkProxyFlags since they can come from the --from file
 	createConfigCmd.MarkFlagsMutuallyExclusive(proxyCrt, caKey)
+	createConfigCmd.MarkFlagsMutuallyExclusive(batchFile, proxyName)
+	createConfigCmd.MarkFlagsMutuallyExclusive(batchFile, proxyCrt)
+	createConfigCmd.MarkFlagsMutuallyExclusive(batchFile, proxyKey)
 
 	return createConfigCmd
 }
@@ -151,6 +166,7 @@ func NewConfigCommand(globalFlags *types.GlobalFlags) *cobra.Command {
 
 func addFlags(cmd *cobra.Command) {
 	cmd.Flags().StringP(output, "o", "", L("Filename to write the configuration to (without extension)."))
+	cmd.Flags().String(batchFile, "", L("YAML or CSV file defining the proxies to create the configuration files for."))
 
 	// Common flags in command scope
 	cmd.Flags().String(proxyName, "", L("Unique DNS-resolvable FQDN of this proxy."))
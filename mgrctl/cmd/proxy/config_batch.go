@@ -0,0 +1,212 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package proxy
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	ctl_utils "github.com/uyuni-project/uyuni-tools/mgrctl/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/proxy"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+	"gopkg.in/yaml.v2"
+)
+
+// proxyDefinition is a proxy defined in the --from file.
+//
+// The empty values default to the command line flags.
+// The certificate, key and intermediate CAs are only needed for certificates signed by a third party CA.
+type proxyDefinition struct {
+	Name         string   `yaml:"name"`
+	Port         int      `yaml:"sshPort,omitempty"`
+	Parent       string   `yaml:"parent,omitempty"`
+	MaxCache     int      `yaml:"maxCache,omitempty"`
+	Email        string   `yaml:"email,omitempty"`
+	Output       string   `yaml:"output,omitempty"`
+	Cnames       []string `yaml:"cnames,omitempty"`
+	SSLEmail     string   `yaml:"sslEmail,omitempty"`
+	Cert         string   `yaml:"cert,omitempty"`
+	Key          string   `yaml:"key,omitempty"`
+	Intermediate []string `yaml:"intermediate,omitempty"`
+}
+
+// proxyFlags merges the proxy definition with the default values of the flags.
+func (def *proxyDefinition) proxyFlags(defaults *proxyCreateConfigFlags) *proxyCreateConfigFlags {
+	flags := *defaults
+	flags.Proxy.Name = def.Name
+	if def.Port != 0 {
+		flags.Proxy.Port = def.Port
+	}
+	if def.Parent != "" {
+		flags.Proxy.Parent = def.Parent
+	}
+	if def.MaxCache != 0 {
+		flags.Proxy.MaxCache = def.MaxCache
+	}
+	if def.Email != "" {
+		flags.Proxy.Email = def.Email
+	}
+
+	// The output flag is the folder to write the files to
+	output := def.Output
+	if output == "" {
+		output = strings.TrimSuffix(GetFilename("", def.Name), ".tar.gz")
+	}
+	flags.Output = path.Join(defaults.Output, output)
+
+	flags.SSL.Cnames = def.Cnames
+	if def.SSLEmail != "" {
+		flags.SSL.Email = def.SSLEmail
+	}
+	flags.SSL.Proxy = types.SSLPair{Cert: def.Cert, Key: def.Key}
+	if len(def.Intermediate) > 0 {
+		flags.SSL.Ca.Intermediate = def.Intermediate
+	}
+	return &flags
+}
+
+// proxyBundle is a configuration file created for a proxy.
+type proxyBundle struct {
+	Proxy  string
+	File   string
+	Expiry time.Time
+}
+
+// proxyCreateConfigBatch creates the configuration files of all the proxies of the --from file.
+//
+// All the configurations are requested in the same API session and the CA password is only asked once.
+// The summary of the created files is written to out.
+func proxyCreateConfigBatch(
+	flags *proxyCreateConfigFlags,
+	apiInit func(*api.ConnectionDetails) (*api.APIClient, error),
+	proxyConfig func(client *api.APIClient, request proxy.ProxyConfigRequest) (*[]int8, error),
+	proxyConfigGenerate func(client *api.APIClient, request proxy.ProxyConfigGenerateRequest) (*[]int8, error),
+	out io.Writer,
+) error {
+	defs, err := ctl_utils.ReadRecords[proxyDefinition](flags.From)
+	if err != nil {
+		return err
+	}
+	if len(defs) == 0 {
+		return fmt.Errorf(L("no proxy defined in %s"), flags.From)
+	}
+
+	// Check all the definitions before doing anything
+	defaults := *flags
+	generate := false
+	names := []string{}
+	for _, def := range defs {
+		if def.Name == "" {
+			return fmt.Errorf(L("proxy definition without name in %s"), flags.From)
+		}
+		if utils.Contains(names, def.Name) {
+			return fmt.Errorf(L("proxy %[1]s is defined more than once in %[2]s"), def.Name, flags.From)
+		}
+		names = append(names, def.Name)
+		if err := checkProxyFlags(def.proxyFlags(&defaults)); err != nil {
+			return utils.Errorf(err, L("invalid definition of proxy %s"), def.Name)
+		}
+		generate = generate || def.Cert == ""
+	}
+
+	client, err := apiInit(&flags.ConnectionDetails)
+	if err == nil {
+		err = client.Login()
+	}
+	if err != nil {
+		return utils.Errorf(err, L("failed to connect to the server"))
+	}
+
+	if defaults.Output != "" {
+		if err := os.MkdirAll(defaults.Output, 0755); err != nil {
+			return utils.Errorf(err, L("failed to create folder %s"), defaults.Output)
+		}
+	}
+	caCertificate := string(utils.ReadFile(flags.SSL.Ca.Cert))
+	if generate && defaults.SSL.Ca.Password == "" {
+		utils.AskPasswordIfMissingOnce(&defaults.SSL.Ca.Password, L("Please enter SSL CA password"), 0, 0)
+	}
+
+	bundles := []proxyBundle{}
+	for _, def := range defs {
+		filename, err := writeProxyConfig(client, def.proxyFlags(&defaults), caCertificate,
+			proxyConfig, proxyConfigGenerate,
+		)
+		if err != nil {
+			err = utils.Errorf(err, L("failed to create the configuration of proxy %s"), def.Name)
+			if len(bundles) > 0 {
+				_ = printBundles(out, bundles)
+			}
+			return err
+		}
+
+		expiry, err := bundleCertificateExpiry(filename)
+		if err != nil {
+			log.Warn().Err(err).Msgf(L("cannot read the certificate expiry date from %s"), filename)
+		}
+		bundles = append(bundles, proxyBundle{Proxy: def.Name, File: filename, Expiry: expiry})
+	}
+	return printBundles(out, bundles)
+}
+
+func printBundles(out io.Writer, bundles []proxyBundle) error {
+	table := ctl_utils.Table{Headers: []string{L("PROXY"), L("FILE"), L("CERTIFICATE EXPIRY")}}
+	for _, bundle := range bundles {
+		table.AddRow(bundle.Proxy, bundle.File, ctl_utils.FormatTime(bundle.Expiry))
+	}
+	return ctl_utils.PrintTable(out, &table)
+}
+
+// bundleCertificateExpiry reads the expiry date of the proxy certificate in a configuration file.
+func bundleCertificateExpiry(bundle string) (time.Time, error) {
+	tmpDir, cleaner, err := utils.TempDir()
+	if err != nil {
+		return time.Time{}, err
+	}
+	defer cleaner()
+
+	if err := utils.ExtractTarGz(bundle, tmpDir); err != nil {
+		return time.Time{}, utils.Errorf(err, L("failed to extract %s"), bundle)
+	}
+	data, err := os.ReadFile(path.Join(tmpDir, "httpd.yaml"))
+	if err != nil {
+		return time.Time{}, err
+	}
+	var httpdConfig struct {
+		Httpd struct {
+			ServerCrt string `yaml:"server_crt"`
+		}
+	}
+	if err := yaml.Unmarshal(data, &httpdConfig); err != nil {
+		return time.Time{}, utils.Errorf(err, L("failed to parse httpd.yaml"))
+	}
+
+	// The certificate is base64 encoded to be used in a kubernetes secret
+	content := []byte(httpdConfig.Httpd.ServerCrt)
+	if decoded, err := base64.StdEncoding.DecodeString(httpdConfig.Httpd.ServerCrt); err == nil {
+		content = decoded
+	}
+	block, _ := pem.Decode(content)
+	if block == nil {
+		return time.Time{}, errors.New(L("no certificate found in httpd.yaml"))
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return cert.NotAfter, nil
+}
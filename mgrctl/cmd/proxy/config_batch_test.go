@@ -0,0 +1,148 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package proxy
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	proxyApi "github.com/uyuni-project/uyuni-tools/shared/api/proxy"
+	"github.com/uyuni-project/uyuni-tools/shared/testutils"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+const proxiesFile = `- name: pxy1.test.com
+  cnames: [pxy1.test.org]
+- name: pxy2.test.com
+  maxCache: 2048
+  output: second
+`
+
+// newTestBundle returns a configuration file with a certificate expiring at notAfter.
+func newTestBundle(t *testing.T, notAfter time.Time) []int8 {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %s", err)
+	}
+	template := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "pxy.test.com"},
+		NotBefore:    notAfter.Add(-time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %s", err)
+	}
+	cert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+
+	dir := t.TempDir()
+	httpdPath := path.Join(dir, "httpd.yaml")
+	testutils.WriteFile(t, httpdPath, "httpd:\n  server_crt: "+base64.StdEncoding.EncodeToString(cert)+"\n")
+	tarballPath := path.Join(dir, "config.tar.gz")
+	tarball, err := utils.NewTarGz(tarballPath)
+	if err != nil {
+		t.Fatalf("failed to create tarball: %s", err)
+	}
+	if err := tarball.AddFile(httpdPath, "httpd.yaml"); err != nil {
+		t.Fatalf("failed to add file to tarball: %s", err)
+	}
+	tarball.Close()
+
+	data, err := os.ReadFile(tarballPath)
+	if err != nil {
+		t.Fatalf("failed to read tarball: %s", err)
+	}
+	result := make([]int8, len(data))
+	for i, b := range data {
+		result[i] = int8(b)
+	}
+	return result
+}
+
+func TestProxyCreateConfigBatch(t *testing.T) {
+	testDir := t.TempDir()
+	testFiles := setupTestFiles(t, testDir)
+	fromPath := path.Join(testDir, "proxies.yaml")
+	testutils.WriteFile(t, fromPath, proxiesFile)
+
+	flags := &proxyCreateConfigFlags{
+		ConnectionDetails: connectionDetails,
+		Proxy:             proxyFlags{Port: 8022, Parent: "uyuni.test.com", MaxCache: 102400, Email: "admin@test.com"},
+		Output:            path.Join(testDir, "out"),
+		From:              fromPath,
+		SSL: proxyConfigSSLFlags{
+			Ca: caFlags{
+				SSLPair:  types.SSLPair{Cert: testFiles.CaCrtFilePath, Key: testFiles.CaKeyFilePath},
+				Password: dummyCaPasswordContents,
+			},
+		},
+	}
+
+	expiry := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	bundle := newTestBundle(t, expiry)
+	requests := []proxyApi.ProxyConfigGenerateRequest{}
+	mockConfigGenerate := func(_ *api.APIClient, request proxyApi.ProxyConfigGenerateRequest) (*[]int8, error) {
+		requests = append(requests, request)
+		return &bundle, nil
+	}
+
+	var out bytes.Buffer
+	if err := proxyCreateConfigBatch(flags, mockSuccessfulLoginAPICall(), nil, mockConfigGenerate, &out); err != nil {
+		t.Fatalf("failed to create the configurations: %s", err)
+	}
+
+	testutils.AssertEquals(t, "Unexpected number of requests", 2, len(requests))
+	testutils.AssertEquals(t, "Unexpected first proxy", "pxy1.test.com", requests[0].ProxyName)
+	testutils.AssertEquals(t, "Unexpected default parent", "uyuni.test.com", requests[0].Server)
+	testutils.AssertEquals(t, "Unexpected cnames", []string{"pxy1.test.org"}, requests[0].Cnames)
+	testutils.AssertEquals(t, "Unexpected CA password", dummyCaPasswordContents, requests[1].CaPassword)
+	testutils.AssertEquals(t, "Unexpected max cache", 2048, requests[1].MaxCache)
+	testutils.AssertEquals(t, "Unexpected default max cache", 102400, requests[0].MaxCache)
+
+	for _, file := range []string{"pxy1-config.tar.gz", "second.tar.gz"} {
+		testutils.AssertTrue(t, "Missing configuration file "+file, utils.FileExists(path.Join(testDir, "out", file)))
+	}
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	testutils.AssertEquals(t, "Unexpected number of summary lines", 3, len(lines))
+	testutils.AssertTrue(t, "Missing expiry date in "+lines[2],
+		strings.Contains(lines[2], expiry.Local().Format("2006-01-02")),
+	)
+}
+
+func TestProxyCreateConfigBatchInvalid(t *testing.T) {
+	testDir := t.TempDir()
+	fromPath := path.Join(testDir, "proxies.csv")
+	testutils.WriteFile(t, fromPath, "name,parent\npxy1.test.com,uyuni.test.com\npxy1.test.com,uyuni.test.com\n")
+
+	flags := &proxyCreateConfigFlags{
+		ConnectionDetails: connectionDetails,
+		Proxy:             proxyFlags{Email: "admin@test.com"},
+		From:              fromPath,
+		SSL:               proxyConfigSSLFlags{Ca: caFlags{SSLPair: types.SSLPair{Cert: "ca.pem", Key: "ca.key"}}},
+	}
+	err := proxyCreateConfigBatch(flags, mockSuccessfulLoginAPICall(), nil, nil, &bytes.Buffer{})
+	testutils.AssertTrue(t, "Duplicate proxies should fail",
+		err != nil && strings.Contains(err.Error(), "more than once"),
+	)
+
+	testutils.WriteFile(t, fromPath, "name\npxy1.test.com\n")
+	err = proxyCreateConfigBatch(flags, mockSuccessfulLoginAPICall(), nil, nil, &bytes.Buffer{})
+	testutils.AssertTrue(t, "Missing parent should fail", err != nil && strings.Contains(err.Error(), server))
+}
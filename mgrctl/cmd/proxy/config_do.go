@@ -6,6 +6,10 @@ package proxy
 
 import (
 	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
 
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
@@ -22,9 +26,38 @@ func proxyCreateConfigInit(
 	_ *cobra.Command,
 	_ []string,
 ) error {
+	if flags.From != "" {
+		return proxyCreateConfigBatch(flags, api.Init, proxy.ContainerConfig, proxy.ContainerConfigGenerate, os.Stdout)
+	}
+	if err := checkProxyFlags(flags); err != nil {
+		return err
+	}
 	return proxyCreateConfig(flags, api.Init, proxy.ContainerConfig, proxy.ContainerConfigGenerate)
 }
 
+// checkProxyFlags checks that the values needed to create the configuration of a proxy are set.
+func checkProxyFlags(flags *proxyCreateConfigFlags) error {
+	missing := []string{}
+	for name, value := range map[string]string{
+		proxyName: flags.Proxy.Name,
+		server:    flags.Proxy.Parent,
+		email:     flags.Proxy.Email,
+		caCrt:     flags.SSL.Ca.Cert,
+	} {
+		if value == "" {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return fmt.Errorf(L("missing required values: %s"), strings.Join(missing, ", "))
+	}
+	if flags.SSL.Proxy.Cert == "" && flags.SSL.Ca.Key == "" {
+		return fmt.Errorf(L("one of %[1]s or %[2]s is required"), proxyCrt, caKey)
+	}
+	return nil
+}
+
 // proxyCreateConfig command handler.
 func proxyCreateConfig(
 	flags *proxyCreateConfigFlags,
@@ -44,8 +77,23 @@ func proxyCreateConfig(
 	// handle CA certificate path
 	caCertificate := string(utils.ReadFile(flags.SSL.Ca.Cert))
 
+	_, err = writeProxyConfig(client, flags, caCertificate, proxyConfig, proxyConfigGenerate)
+	return err
+}
+
+// writeProxyConfig requests the configuration of a proxy and saves it.
+//
+// Returns the path to the configuration file.
+func writeProxyConfig(
+	client *api.APIClient,
+	flags *proxyCreateConfigFlags,
+	caCertificate string,
+	proxyConfig func(client *api.APIClient, request proxy.ProxyConfigRequest) (*[]int8, error),
+	proxyConfigGenerate func(client *api.APIClient, request proxy.ProxyConfigGenerateRequest) (*[]int8, error),
+) (string, error) {
 	// Check if ProxyCrt is provided to decide which configuration to run
 	var data *[]int8
+	var err error
 	if flags.SSL.Proxy.Cert != "" {
 		data, err = handleProxyConfig(client, flags, caCertificate, proxyConfig)
 	} else {
@@ -53,16 +101,16 @@ func proxyCreateConfig(
 	}
 
 	if err != nil {
-		return utils.Errorf(err, L("failed to execute proxy configuration api request"))
+		return "", utils.Errorf(err, L("failed to execute proxy configuration api request"))
 	}
 
 	filename := GetFilename(flags.Output, flags.Proxy.Name)
 	if err := utils.SaveBinaryData(filename, *data); err != nil {
-		return utils.Errorf(err, L("error saving binary data: %v"), err)
+		return "", utils.Errorf(err, L("error saving binary data: %v"), err)
 	}
 	log.Info().Msgf(L("Proxy configuration file saved as %s"), filename)
 
-	return nil
+	return filename, nil
 }
 
 // Helper function to handle proxy configuration.
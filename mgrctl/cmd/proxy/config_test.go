@@ -53,6 +53,7 @@ func TestParamsParsingGeneratedCert(t *testing.T) {
 		"--ssl-proxy-cert",
 		"--ssl-proxy-key",
 		"--ssl-ca-intermediate",
+		"--from",
 	}
 
 	// Test function asserting that the args are properly parsed
@@ -97,6 +98,7 @@ func TestParamsParsingProvidedCert(t *testing.T) {
 		"--ssl-city",
 		"--ssl-org",
 		"--ssl-ou",
+		"--from",
 	}
 
 	// Test function asserting that the args are properly parsed
@@ -122,3 +124,38 @@ func TestParamsParsingProvidedCert(t *testing.T) {
 		t.Errorf("command failed with error: %s", err)
 	}
 }
+
+func TestParamsParsingBatch(t *testing.T) {
+	args := []string{
+		"--from", "proxies.yaml",
+		"--proxy-parent", "uyuni.test.com",
+		"--proxy-email", "admin@proxy.test.com",
+		"--output", "path/to/proxies",
+		"--ssl-ca-cert", "path/to/ca.crt",
+		"--ssl-ca-key", "path/to/ca.key",
+	}
+	args = append(args, flagstests.APIFlagsTestArgs...)
+
+	// Test function asserting that the args are properly parsed
+	tester := func(_ *types.GlobalFlags, flags *proxyCreateConfigFlags, _ *cobra.Command, _ []string) error {
+		flagstests.AssertAPIFlags(t, &flags.ConnectionDetails)
+		testutils.AssertEquals(t, "Error parsing --from", "proxies.yaml", flags.From)
+		testutils.AssertEquals(t, "Unexpected proxy parent", "uyuni.test.com", flags.Proxy.Parent)
+		testutils.AssertEquals(t, "Unexpected output path", "path/to/proxies", flags.Output)
+		testutils.AssertEquals(t, "Unexpected SSL CA key path", "path/to/ca.key", flags.SSL.Ca.Key)
+		return nil
+	}
+
+	globalFlags := types.GlobalFlags{}
+	cmd := newCmd(&globalFlags, tester)
+
+	cmd.SetArgs(args)
+	if err := cmd.Execute(); err != nil {
+		t.Errorf("command failed with error: %s", err)
+	}
+
+	// The proxy name comes from the file
+	cmd = newCmd(&globalFlags, tester)
+	cmd.SetArgs(append(args, "--proxy-name", "pxy1.test.com"))
+	testutils.AssertTrue(t, "--from and --proxy-name should be exclusive", cmd.Execute() != nil)
+}
@@ -0,0 +1,159 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package proxy
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	ctl_utils "github.com/uyuni-project/uyuni-tools/mgrctl/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/proxy"
+	"github.com/uyuni-project/uyuni-tools/shared/api/schedule"
+	"github.com/uyuni-project/uyuni-tools/shared/api/system"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+type deleteFlags struct {
+	ConnectionDetails api.ConnectionDetails `mapstructure:"api"`
+	Reassign          struct {
+		To string
+	}
+	Timeout  time.Duration
+	Interval time.Duration
+	Force    bool
+}
+
+func newDeleteCmd(globalFlags *types.GlobalFlags, run utils.CommandFunc[deleteFlags]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete proxy",
+		Short: L("Delete a registered proxy"),
+		Long: L(`Delete a registered proxy given its ID or profile name.
+
+A proxy still serving systems is only deleted if its clients are moved to another proxy
+with --reassign-to. The proxy is deleted once all the clients are connected to the new proxy.`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var flags deleteFlags
+			return utils.CommandHelper(globalFlags, cmd, args, &flags, nil, run)
+		},
+	}
+
+	cmd.Flags().String("reassign-to", "", L("ID or profile name of the proxy to move the clients to"))
+	cmd.Flags().Duration("timeout", 30*time.Minute, L("maximum time to wait for the clients to be moved"))
+	cmd.Flags().Duration("interval", 10*time.Second, L("time between two checks of the clients move"))
+	cmd.Flags().BoolP("force", "f", false, L("do not ask for confirmation"))
+	api.AddAPIFlags(cmd)
+
+	return cmd
+}
+
+func newDeleteCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	return newDeleteCmd(globalFlags, runDelete)
+}
+
+func runDelete(_ *types.GlobalFlags, flags *deleteFlags, _ *cobra.Command, args []string) error {
+	client, err := ctl_utils.Connect(&flags.ConnectionDetails)
+	if err != nil {
+		return err
+	}
+
+	if !flags.Force {
+		confirmed, err := utils.YesNo(fmt.Sprintf(L("Delete proxy %s"), args[0]))
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return errors.New(L("deletion cancelled"))
+		}
+	}
+	return deleteProxy(client, args[0], flags)
+}
+
+func deleteProxy(client *api.APIClient, name string, flags *deleteFlags) error {
+	proxies, err := proxy.ListProxies(client)
+	if err != nil {
+		return err
+	}
+	registered, err := lookupProxy(proxies, name)
+	if err != nil {
+		return err
+	}
+
+	clients, err := proxy.ListProxyClients(client, registered.ID)
+	if err != nil {
+		return err
+	}
+	if len(clients) > 0 {
+		if flags.Reassign.To == "" {
+			return fmt.Errorf(L("proxy %[1]s still serves %[2]d systems, move them with --reassign-to"),
+				registered.Name, len(clients),
+			)
+		}
+		target, err := lookupProxy(proxies, flags.Reassign.To)
+		if err != nil {
+			return err
+		}
+		if target.ID == registered.ID {
+			return errors.New(L("cannot reassign the clients to the deleted proxy"))
+		}
+		if err := reassignClients(client, clients, target.ID, flags); err != nil {
+			return utils.Errorf(err, L("failed to move the clients of proxy %[1]s to %[2]s"),
+				registered.Name, target.Name,
+			)
+		}
+		log.Info().Msgf(L("Moved %[1]d systems to proxy %[2]s"), len(clients), target.Name)
+	}
+
+	if err := system.DeleteSystems(client, []int{registered.ID}, system.FailOnCleanupErr); err != nil {
+		return err
+	}
+	log.Info().Msgf(L("Deleted proxy %s"), registered.Name)
+	return nil
+}
+
+// reassignClients schedules the change of proxy of the clients and waits for the actions to succeed.
+func reassignClients(client *api.APIClient, clients []int, proxyID int, flags *deleteFlags) error {
+	actionIDs, err := system.ChangeProxy(client, clients, proxyID)
+	if err != nil {
+		return err
+	}
+
+	err = ctl_utils.Poll(flags.Timeout, flags.Interval, func() (bool, error) {
+		actions, err := schedule.ListActions(client, schedule.InProgressActions)
+		if err != nil {
+			return false, err
+		}
+		for _, action := range actions {
+			for _, id := range actionIDs {
+				if action.ID == id {
+					return false, nil
+				}
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, id := range actionIDs {
+		systems, err := schedule.ListSystems(client, id, schedule.FailedSystems)
+		if err != nil {
+			return err
+		}
+		failed += len(systems)
+	}
+	if failed > 0 {
+		return fmt.Errorf(L("the change of proxy failed on %d systems"), failed)
+	}
+	return nil
+}
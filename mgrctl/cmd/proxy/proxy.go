@@ -15,8 +15,8 @@ import (
 func NewCommand(globalFlags *types.GlobalFlags) *cobra.Command {
 	var cmd = &cobra.Command{
 		Use:   "proxy",
-		Short: L("Manage proxy configurations"),
-		Long:  L("Manage proxy configurations"),
+		Short: L("Manage proxy configurations and registered proxies"),
+		Long:  L("Manage proxy configurations and registered proxies"),
 		Run: func(cmd *cobra.Command, _ []string) {
 			_ = cmd.Help()
 		},
@@ -34,5 +34,8 @@ func NewCommand(globalFlags *types.GlobalFlags) *cobra.Command {
 	createCmd.AddCommand(NewConfigCommand(globalFlags))
 
 	cmd.AddCommand(createCmd)
+	cmd.AddCommand(newListCommand(globalFlags))
+	cmd.AddCommand(newShowCommand(globalFlags))
+	cmd.AddCommand(newDeleteCommand(globalFlags))
 	return cmd
 }
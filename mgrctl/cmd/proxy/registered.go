@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package proxy
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/proxy"
+	"github.com/uyuni-project/uyuni-tools/shared/api/system"
+	apiTypes "github.com/uyuni-project/uyuni-tools/shared/api/types"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+)
+
+// proxyInfo is a registered proxy with the systems connected through it.
+type proxyInfo struct {
+	apiTypes.SystemOverview
+	Clients []apiTypes.SystemOverview `json:"clients"`
+}
+
+// lookupProxy finds a registered proxy given its ID or profile name.
+func lookupProxy(proxies []apiTypes.SystemOverview, name string) (*apiTypes.SystemOverview, error) {
+	id, err := strconv.Atoi(name)
+	for i, candidate := range proxies {
+		if (err == nil && candidate.ID == id) || candidate.Name == name {
+			return &proxies[i], nil
+		}
+	}
+	return nil, fmt.Errorf(L("no registered proxy %s"), name)
+}
+
+// getProxyInfos gets the clients of the proxies, sorted by name.
+func getProxyInfos(client *api.APIClient, proxies []apiTypes.SystemOverview) ([]proxyInfo, error) {
+	systems, err := system.ListSystems(client)
+	if err != nil {
+		return nil, err
+	}
+	systemsByID := map[int]apiTypes.SystemOverview{}
+	for _, s := range systems {
+		systemsByID[s.ID] = s
+	}
+
+	infos := []proxyInfo{}
+	for _, p := range proxies {
+		ids, err := proxy.ListProxyClients(client, p.ID)
+		if err != nil {
+			return nil, err
+		}
+		info := proxyInfo{SystemOverview: p, Clients: []apiTypes.SystemOverview{}}
+		for _, id := range ids {
+			clientSystem, found := systemsByID[id]
+			if !found {
+				// Systems from another organization are not visible
+				clientSystem = apiTypes.SystemOverview{ID: id}
+			}
+			info.Clients = append(info.Clients, clientSystem)
+		}
+		sort.SliceStable(info.Clients, func(i, j int) bool { return info.Clients[i].Name < info.Clients[j].Name })
+		infos = append(infos, info)
+	}
+	sort.SliceStable(infos, func(i, j int) bool { return infos[i].Name < infos[j].Name })
+	return infos, nil
+}
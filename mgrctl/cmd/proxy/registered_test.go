@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package proxy
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	ctl_utils "github.com/uyuni-project/uyuni-tools/mgrctl/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared/api/fake"
+	"github.com/uyuni-project/uyuni-tools/shared/api/proxy"
+	"github.com/uyuni-project/uyuni-tools/shared/testutils"
+	"github.com/uyuni-project/uyuni-tools/shared/testutils/flagstests"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+)
+
+func TestDeleteParamsParsing(t *testing.T) {
+	args := []string{"--reassign-to", "pxy2", "--timeout", "1h", "--interval", "1m", "--force", "pxy1"}
+	args = append(args, flagstests.APIFlagsTestArgs...)
+
+	// Test function asserting that the args are properly parsed
+	tester := func(_ *types.GlobalFlags, flags *deleteFlags, _ *cobra.Command, args []string) error {
+		flagstests.AssertAPIFlags(t, &flags.ConnectionDetails)
+		testutils.AssertEquals(t, "Error parsing --reassign-to", "pxy2", flags.Reassign.To)
+		testutils.AssertEquals(t, "Error parsing --timeout", time.Hour, flags.Timeout)
+		testutils.AssertEquals(t, "Error parsing --interval", time.Minute, flags.Interval)
+		testutils.AssertTrue(t, "Error parsing --force", flags.Force)
+		testutils.AssertEquals(t, "Unexpected args", []string{"pxy1"}, args)
+		return nil
+	}
+
+	globalFlags := types.GlobalFlags{}
+	cmd := newDeleteCmd(&globalFlags, tester)
+
+	testutils.AssertHasAllFlags(t, cmd, args)
+
+	cmd.SetArgs(args)
+	if err := cmd.Execute(); err != nil {
+		t.Errorf("command failed with error: %s", err)
+	}
+}
+
+// newProxyServer returns a fake server with two proxies, the first one serving two systems.
+func newProxyServer(t *testing.T) *fake.Server {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	server := fake.NewServer("admin", "secret")
+
+	server.Handle("GET", "proxy/listProxies", func(_ *fake.Request) *fake.Response {
+		return fake.Success([]map[string]interface{}{
+			{"id": 1000010002, "name": "pxy2.example.com"},
+			{"id": 1000010001, "name": "pxy1.example.com"},
+		})
+	})
+	server.Handle("GET", "proxy/listProxyClients", func(req *fake.Request) *fake.Response {
+		if req.Params["proxyId"] == "1000010001" {
+			return fake.Success([]int{1000010011, 1000010010})
+		}
+		return fake.Success([]int{})
+	})
+	server.Handle("GET", "system/listSystems", func(_ *fake.Request) *fake.Response {
+		return fake.Success([]map[string]interface{}{
+			{"id": 1000010010, "name": "web1"},
+			{"id": 1000010011, "name": "web2"},
+		})
+	})
+	server.Handle("POST", "system/changeProxy", func(_ *fake.Request) *fake.Response {
+		return fake.Success([]int{42})
+	})
+	server.Handle("GET", "schedule/listInProgressActions", func(_ *fake.Request) *fake.Response {
+		return fake.Success([]map[string]interface{}{})
+	})
+	server.Handle("GET", "schedule/listFailedSystems", func(_ *fake.Request) *fake.Response {
+		return fake.Success([]map[string]interface{}{})
+	})
+	server.Handle("POST", "system/deleteSystems", func(_ *fake.Request) *fake.Response {
+		return fake.Success(1)
+	})
+	return server
+}
+
+func TestGetProxyInfos(t *testing.T) {
+	server := newProxyServer(t)
+	defer server.Close()
+
+	client, err := ctl_utils.Connect(server.ConnectionDetails())
+	if err != nil {
+		t.Fatalf("failed to connect: %s", err)
+	}
+
+	proxies, err := proxy.ListProxies(client)
+	if err != nil {
+		t.Fatalf("failed to list the proxies: %s", err)
+	}
+	infos, err := getProxyInfos(client, proxies)
+	if err != nil {
+		t.Fatalf("failed to get the proxies: %s", err)
+	}
+	testutils.AssertEquals(t, "Unexpected first proxy", "pxy1.example.com", infos[0].Name)
+	testutils.AssertEquals(t, "Unexpected number of clients", 2, len(infos[0].Clients))
+	testutils.AssertEquals(t, "Unexpected first client", "web1", infos[0].Clients[0].Name)
+	testutils.AssertEquals(t, "Unexpected clients of the second proxy", 0, len(infos[1].Clients))
+}
+
+func TestDeleteProxy(t *testing.T) {
+	server := newProxyServer(t)
+	defer server.Close()
+
+	client, err := ctl_utils.Connect(server.ConnectionDetails())
+	if err != nil {
+		t.Fatalf("failed to connect: %s", err)
+	}
+
+	flags := deleteFlags{Timeout: time.Minute, Interval: time.Millisecond}
+	err = deleteProxy(client, "pxy1.example.com", &flags)
+	testutils.AssertTrue(t, "Deleting a proxy with clients should fail", err != nil &&
+		strings.Contains(err.Error(), "--reassign-to"),
+	)
+
+	flags.Reassign.To = "pxy1.example.com"
+	err = deleteProxy(client, "1000010001", &flags)
+	testutils.AssertTrue(t, "Reassigning to the deleted proxy should fail", err != nil)
+	testutils.AssertEquals(t, "No system should be deleted", 0, len(server.CallsTo("system/deleteSystems")))
+
+	flags.Reassign.To = "1000010002"
+	if err := deleteProxy(client, "pxy1.example.com", &flags); err != nil {
+		t.Fatalf("failed to delete the proxy: %s", err)
+	}
+	changeCalls := server.CallsTo("system/changeProxy")
+	testutils.AssertEquals(t, "Unexpected number of proxy changes", 1, len(changeCalls))
+	testutils.AssertEquals[interface{}](t, "Unexpected new proxy", 1000010002.0, changeCalls[0].Params["proxyId"])
+	deleteCalls := server.CallsTo("system/deleteSystems")
+	testutils.AssertEquals(t, "Unexpected number of deletions", 1, len(deleteCalls))
+	testutils.AssertEquals[interface{}](t, "Unexpected deleted proxy", []interface{}{1000010001.0},
+		deleteCalls[0].Params["sids"],
+	)
+}
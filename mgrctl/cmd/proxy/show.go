@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package proxy
+
+import (
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	ctl_utils "github.com/uyuni-project/uyuni-tools/mgrctl/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/proxy"
+	apiTypes "github.com/uyuni-project/uyuni-tools/shared/api/types"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+type showFlags struct {
+	ConnectionDetails api.ConnectionDetails `mapstructure:"api"`
+	Output            string
+}
+
+func newShowCmd(globalFlags *types.GlobalFlags, run utils.CommandFunc[showFlags]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show proxy",
+		Short: L("Show a registered proxy and its clients"),
+		Long:  L("Show a registered proxy given its ID or profile name and the systems connected through it."),
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var flags showFlags
+			return utils.CommandHelper(globalFlags, cmd, args, &flags, nil, run)
+		},
+	}
+
+	ctl_utils.AddOutputFlag(cmd)
+	api.AddAPIFlags(cmd)
+
+	return cmd
+}
+
+func newShowCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	return newShowCmd(globalFlags, runShow)
+}
+
+func runShow(_ *types.GlobalFlags, flags *showFlags, _ *cobra.Command, args []string) error {
+	client, err := ctl_utils.Connect(&flags.ConnectionDetails)
+	if err != nil {
+		return err
+	}
+
+	proxies, err := proxy.ListProxies(client)
+	if err != nil {
+		return err
+	}
+	registered, err := lookupProxy(proxies, args[0])
+	if err != nil {
+		return err
+	}
+	infos, err := getProxyInfos(client, []apiTypes.SystemOverview{*registered})
+	if err != nil {
+		return err
+	}
+	info := infos[0]
+
+	return ctl_utils.PrintOutput(os.Stdout, flags.Output, info, func() *ctl_utils.Table {
+		clients := []string{}
+		for _, c := range info.Clients {
+			clients = append(clients, c.Name)
+		}
+		table := ctl_utils.Table{}
+		table.AddRow(L("ID:"), strconv.Itoa(info.ID))
+		table.AddRow(L("Name:"), info.Name)
+		table.AddRow(L("Last check-in:"), ctl_utils.FormatTime(info.LastCheckin.Time))
+		table.AddRow(L("Clients:"), strings.Join(clients, ", "))
+		return &table
+	})
+}
@@ -0,0 +1,170 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package setup
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	ctl_utils "github.com/uyuni-project/uyuni-tools/mgrctl/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+	"gopkg.in/yaml.v2"
+)
+
+type applyFlags struct {
+	ConnectionDetails api.ConnectionDetails `mapstructure:"api"`
+	File              string
+	Plan              bool
+	Installer         struct {
+		Config string
+	}
+}
+
+// installerConfig holds the first organization administrator credentials from the mgradm configuration file.
+type installerConfig struct {
+	Admin struct {
+		Login    string `yaml:"login"`
+		Password string `yaml:"password"`
+	} `yaml:"admin"`
+}
+
+func newApplyCmd(globalFlags *types.GlobalFlags, run utils.CommandFunc[applyFlags]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: L("Converge the server to a day one specification"),
+		Long: L(`Converge the server to a day one specification:
+SCC credentials, vendor channels, system groups, users and activation keys.
+
+The missing objects are created and the existing ones updated. Applying the same
+specification again doesn't change anything. Use --plan to only list the changes.
+
+The products are refreshed from SCC when some of the vendor channels are not known
+by the server yet. The vendor channels are added with their mandatory children.
+The existing users only get the missing roles and system groups. The activation keys
+have the same format than the ones imported by mgrctl activationkey import.
+
+The administrator credentials from the mgradm install configuration file can be
+used to connect with --installer-config. Like in mgradm, the login defaults to admin.
+
+Example of specification:
+scc:
+  - user: SCC_USER
+    password: SCC_PASSWORD
+    primary: true
+channels:
+  - sle-product-sles15-sp6-pool-x86_64
+groups:
+  - name: web
+    description: Web servers
+users:
+  - login: jdoe
+    password: secret
+    firstName: Jane
+    lastName: Doe
+    email: jdoe@example.com
+    roles: [system_group_admin]
+    groups: [web]
+activationKeys:
+  - key: web
+    baseChannel: sle-product-sles15-sp6-pool-x86_64
+    groups: [web]`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var flags applyFlags
+			return utils.CommandHelper(globalFlags, cmd, args, &flags, nil, run)
+		},
+	}
+
+	cmd.Flags().StringP("file", "f", "", L("path to the YAML day one specification"))
+	cmd.Flags().Bool("plan", false, L("only list the changes to apply"))
+	cmd.Flags().String("installer-config", "",
+		L("mgradm install configuration file to read the administrator credentials from"),
+	)
+	api.AddAPIFlags(cmd)
+
+	return cmd
+}
+
+func newApplyCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	return newApplyCmd(globalFlags, runApply)
+}
+
+func runApply(_ *types.GlobalFlags, flags *applyFlags, _ *cobra.Command, _ []string) error {
+	if flags.File == "" {
+		return errors.New(L("the day one specification file is required"))
+	}
+	s, err := readSpec(flags.File)
+	if err != nil {
+		return err
+	}
+
+	if flags.Installer.Config != "" {
+		if err := loadInstallerCredentials(flags.Installer.Config, &flags.ConnectionDetails); err != nil {
+			return err
+		}
+	}
+
+	client, err := ctl_utils.Connect(&flags.ConnectionDetails)
+	if err != nil {
+		return err
+	}
+	return apply(client, s, flags.Plan, os.Stdout)
+}
+
+// loadInstallerCredentials sets the API user and password from the mgradm configuration file if not provided.
+//
+// Like mgradm, the login defaults to admin.
+func loadInstallerCredentials(path string, conn *api.ConnectionDetails) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return utils.Errorf(err, L("failed to read %s"), path)
+	}
+	var config installerConfig
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return utils.Errorf(err, L("failed to parse %s"), path)
+	}
+	if config.Admin.Password == "" {
+		return fmt.Errorf(L("no administrator credentials in %s"), path)
+	}
+	if config.Admin.Login == "" {
+		config.Admin.Login = "admin"
+	}
+	if conn.User == "" {
+		conn.User = config.Admin.Login
+		conn.Password = config.Admin.Password
+	}
+	return nil
+}
+
+func apply(client *api.APIClient, s *spec, plan bool, w io.Writer) error {
+	descriptions, err := applySpec(client, s, plan)
+	if err != nil {
+		return err
+	}
+	if !plan {
+		if len(descriptions) == 0 {
+			log.Info().Msg(L("The server is up to date"))
+		}
+		return nil
+	}
+
+	if len(descriptions) == 0 {
+		_, err = fmt.Fprintln(w, L("No change"))
+		return err
+	}
+	for _, description := range descriptions {
+		if _, err := fmt.Fprintln(w, description); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,201 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package setup
+
+import (
+	"bytes"
+	"path"
+	"testing"
+
+	"github.com/spf13/cobra"
+	ctl_utils "github.com/uyuni-project/uyuni-tools/mgrctl/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/fake"
+	apiTypes "github.com/uyuni-project/uyuni-tools/shared/api/types"
+	"github.com/uyuni-project/uyuni-tools/shared/testutils"
+	"github.com/uyuni-project/uyuni-tools/shared/testutils/flagstests"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+)
+
+const dayOneSpec = `scc:
+  - user: UC123
+    password: secret
+    primary: true
+channels:
+  - sles15-sp6-pool-x86_64
+groups:
+  - name: web
+users:
+  - login: jdoe
+    password: secret
+    roles: [system_group_admin]
+    groups: [web]
+`
+
+func TestApplyParamsParsing(t *testing.T) {
+	args := []string{"--file", "dayone.yaml", "--plan", "--installer-config", "mgradm.yaml"}
+	args = append(args, flagstests.APIFlagsTestArgs...)
+
+	// Test function asserting that the args are properly parsed
+	tester := func(_ *types.GlobalFlags, flags *applyFlags, _ *cobra.Command, _ []string) error {
+		flagstests.AssertAPIFlags(t, &flags.ConnectionDetails)
+		testutils.AssertEquals(t, "Error parsing --file", "dayone.yaml", flags.File)
+		testutils.AssertTrue(t, "Error parsing --plan", flags.Plan)
+		testutils.AssertEquals(t, "Error parsing --installer-config", "mgradm.yaml", flags.Installer.Config)
+		return nil
+	}
+
+	globalFlags := types.GlobalFlags{}
+	cmd := newApplyCmd(&globalFlags, tester)
+
+	testutils.AssertHasAllFlags(t, cmd, args)
+
+	cmd.SetArgs(args)
+	if err := cmd.Execute(); err != nil {
+		t.Errorf("command failed with error: %s", err)
+	}
+}
+
+func TestLoadInstallerCredentials(t *testing.T) {
+	file := path.Join(t.TempDir(), "mgradm.yaml")
+	testutils.WriteFile(t, file, "admin:\n  login: admin\n  password: adminpass\norganization: Example\n")
+
+	conn := api.ConnectionDetails{}
+	if err := loadInstallerCredentials(file, &conn); err != nil {
+		t.Fatalf("failed to load the credentials: %s", err)
+	}
+	testutils.AssertEquals(t, "Unexpected user", "admin", conn.User)
+	testutils.AssertEquals(t, "Unexpected password", "adminpass", conn.Password)
+
+	conn = api.ConnectionDetails{User: "other", Password: "otherpass"}
+	if err := loadInstallerCredentials(file, &conn); err != nil {
+		t.Fatalf("failed to load the credentials: %s", err)
+	}
+	testutils.AssertEquals(t, "The provided user should be kept", "other", conn.User)
+
+	// mgradm defaults the login to admin
+	testutils.WriteFile(t, file, "admin:\n  password: adminpass\n")
+	conn = api.ConnectionDetails{}
+	if err := loadInstallerCredentials(file, &conn); err != nil {
+		t.Fatalf("failed to load the credentials without login: %s", err)
+	}
+	testutils.AssertEquals(t, "Unexpected default user", "admin", conn.User)
+
+	testutils.WriteFile(t, file, "organization: Example\n")
+	err := loadInstallerCredentials(file, &api.ConnectionDetails{})
+	testutils.AssertTrue(t, "Missing password should fail", err != nil)
+}
+
+// newSetupServer returns a fake server with an empty SCC configuration, no group and no user.
+func newSetupServer(t *testing.T) *fake.Server {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	server := fake.NewServer("admin", "secret")
+
+	credentials := []apiTypes.ContentSyncCredentials{}
+	channels := []apiTypes.ContentSyncChannel{}
+	groups := []apiTypes.SystemGroup{}
+	users := []apiTypes.UserOverview{}
+	roles := []string{}
+
+	server.Handle("GET", "sync/content/listCredentials", func(_ *fake.Request) *fake.Response {
+		return fake.Success(credentials)
+	})
+	server.Handle("POST", "sync/content/addCredentials", func(req *fake.Request) *fake.Response {
+		credentials = append(credentials, apiTypes.ContentSyncCredentials{User: req.Params["username"].(string)})
+		return fake.Success(1)
+	})
+	for _, method := range []string{
+		"synchronizeChannelFamilies", "synchronizeProducts", "synchronizeRepositories", "synchronizeSubscriptions",
+	} {
+		server.Handle("POST", "sync/content/"+method, func(_ *fake.Request) *fake.Response {
+			channels = []apiTypes.ContentSyncChannel{{Label: "sles15-sp6-pool-x86_64", Status: "available"}}
+			return fake.Success(1)
+		})
+	}
+	server.Handle("GET", "sync/content/listChannels", func(_ *fake.Request) *fake.Response {
+		return fake.Success(channels)
+	})
+	server.Handle("POST", "sync/content/addChannels", func(req *fake.Request) *fake.Response {
+		channels[0].Status = "installed"
+		return fake.Success([]string{req.Params["channelLabel"].(string)})
+	})
+	server.Handle("GET", "systemgroup/listAllGroups", func(_ *fake.Request) *fake.Response {
+		return fake.Success(groups)
+	})
+	server.Handle("POST", "systemgroup/create", func(req *fake.Request) *fake.Response {
+		group := apiTypes.SystemGroup{
+			ID: len(groups) + 1, Name: req.Params["name"].(string), Description: req.Params["description"].(string),
+		}
+		groups = append(groups, group)
+		return fake.Success(group)
+	})
+	server.Handle("GET", "user/listUsers", func(_ *fake.Request) *fake.Response {
+		return fake.Success(users)
+	})
+	server.Handle("POST", "user/create", func(req *fake.Request) *fake.Response {
+		users = append(users, apiTypes.UserOverview{ID: len(users) + 1, Login: req.Params["login"].(string)})
+		return fake.Success(1)
+	})
+	server.Handle("GET", "user/listRoles", func(_ *fake.Request) *fake.Response {
+		return fake.Success(roles)
+	})
+	server.Handle("POST", "user/addRole", func(req *fake.Request) *fake.Response {
+		roles = append(roles, req.Params["role"].(string))
+		return fake.Success(1)
+	})
+	server.Handle("GET", "user/listAssignedSystemGroups", func(_ *fake.Request) *fake.Response {
+		return fake.Success(groups)
+	})
+	server.Handle("POST", "user/addAssignedSystemGroups", func(_ *fake.Request) *fake.Response {
+		return fake.Success(1)
+	})
+	return server
+}
+
+func TestApplySpec(t *testing.T) {
+	server := newSetupServer(t)
+	defer server.Close()
+
+	client, err := ctl_utils.Connect(server.ConnectionDetails())
+	if err != nil {
+		t.Fatalf("failed to connect: %s", err)
+	}
+	file := path.Join(t.TempDir(), "dayone.yaml")
+	testutils.WriteFile(t, file, dayOneSpec)
+	s, err := readSpec(file)
+	if err != nil {
+		t.Fatalf("failed to read the specification: %s", err)
+	}
+
+	var out bytes.Buffer
+	if err := apply(client, s, true, &out); err != nil {
+		t.Fatalf("failed to plan: %s", err)
+	}
+	expected := `Add SCC credentials UC123
+Refresh the products from SCC
+Add vendor channel sles15-sp6-pool-x86_64
+Create system group web
+Create user jdoe
+`
+	testutils.AssertEquals(t, "Unexpected plan", expected, out.String())
+	testutils.AssertEquals(t, "The plan should not change anything", 0, len(server.CallsTo("user/create")))
+
+	if err := apply(client, s, false, &out); err != nil {
+		t.Fatalf("failed to apply: %s", err)
+	}
+	testutils.AssertEquals(t, "Unexpected number of added channels", 1, len(server.CallsTo("sync/content/addChannels")))
+	testutils.AssertEquals(t, "Unexpected number of created users", 1, len(server.CallsTo("user/create")))
+	groupCalls := server.CallsTo("systemgroup/create")
+	testutils.AssertEquals[interface{}](t, "Unexpected default group description", "web",
+		groupCalls[0].Params["description"],
+	)
+
+	// Applying again must not change anything
+	out.Reset()
+	if err := apply(client, s, true, &out); err != nil {
+		t.Fatalf("failed to plan again: %s", err)
+	}
+	testutils.AssertEquals(t, "Unexpected plan after apply", "No change\n", out.String())
+}
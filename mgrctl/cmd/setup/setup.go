@@ -0,0 +1,26 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package setup
+
+import (
+	"github.com/spf13/cobra"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+)
+
+// NewCommand entry command for the initial setup of the server.
+func NewCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	setupCmd := &cobra.Command{
+		Use:   "setup",
+		Short: L("Set up a newly installed server"),
+		Run: func(cmd *cobra.Command, _ []string) {
+			_ = cmd.Help()
+		},
+	}
+
+	setupCmd.AddCommand(newApplyCommand(globalFlags))
+
+	return setupCmd
+}
@@ -0,0 +1,346 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package setup
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/uyuni-project/uyuni-tools/mgrctl/shared/activationkey"
+	ctl_user "github.com/uyuni-project/uyuni-tools/mgrctl/shared/user"
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/contentsync"
+	"github.com/uyuni-project/uyuni-tools/shared/api/systemgroup"
+	"github.com/uyuni-project/uyuni-tools/shared/api/user"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+	"gopkg.in/yaml.v2"
+)
+
+// sccCredentials are SCC organization credentials as defined in the specification.
+type sccCredentials struct {
+	User     string `yaml:"user"`
+	Password string `yaml:"password"`
+	Primary  bool   `yaml:"primary,omitempty"`
+}
+
+// groupDefinition is a system group as defined in the specification.
+type groupDefinition struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description,omitempty"`
+}
+
+// spec is the day one state of a server.
+//
+// The channels are the labels of vendor channels to add with their mandatory children.
+type spec struct {
+	SCC            []sccCredentials               `yaml:"scc,omitempty"`
+	Channels       []string                       `yaml:"channels,omitempty"`
+	Groups         []groupDefinition              `yaml:"groups,omitempty"`
+	Users          []ctl_user.UserDefinition      `yaml:"users,omitempty"`
+	ActivationKeys []*activationkey.KeyDefinition `yaml:"activationKeys,omitempty"`
+}
+
+// change is a modification needed to converge the server to the specification.
+type change struct {
+	Description string
+	apply       func(client *api.APIClient) error
+}
+
+// step computes the changes of one section of the specification.
+//
+// The sections are applied in order since the later ones may depend on the objects of the previous ones.
+type step func(client *api.APIClient, s *spec) ([]change, error)
+
+var steps = []step{planCredentials, planProducts, planChannels, planGroups, planUsers, planActivationKeys}
+
+// readSpec reads and validates a specification file.
+//
+// The group descriptions default to their names.
+func readSpec(path string) (*spec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to read %s"), path)
+	}
+	var s spec
+	if err := yaml.UnmarshalStrict(data, &s); err != nil {
+		return nil, utils.Errorf(err, L("failed to parse %s"), path)
+	}
+
+	for _, credentials := range s.SCC {
+		if credentials.User == "" || credentials.Password == "" {
+			return nil, fmt.Errorf(L("SCC credentials need a user and a password in %s"), path)
+		}
+	}
+	for i := range s.Groups {
+		group := &s.Groups[i]
+		if group.Name == "" {
+			return nil, fmt.Errorf(L("system group definition without name in %s"), path)
+		}
+		if group.Description == "" {
+			group.Description = group.Name
+		}
+	}
+	for _, def := range s.Users {
+		if def.Login == "" {
+			return nil, fmt.Errorf(L("user definition without login in %s"), path)
+		}
+		if def.Password == "" && !def.Pam {
+			return nil, fmt.Errorf(L("missing password for user %[1]s in %[2]s"), def.Login, path)
+		}
+	}
+	for _, def := range s.ActivationKeys {
+		if def.Key == "" {
+			return nil, fmt.Errorf(L("activation key definition without key in %s"), path)
+		}
+	}
+	return &s, nil
+}
+
+// planCredentials adds the missing SCC credentials.
+//
+// The passwords of the existing credentials are not compared since the API doesn't return them.
+func planCredentials(client *api.APIClient, s *spec) ([]change, error) {
+	if len(s.SCC) == 0 {
+		return nil, nil
+	}
+	existing, err := contentsync.ListCredentials(client)
+	if err != nil {
+		return nil, err
+	}
+	users := []string{}
+	for _, credentials := range existing {
+		users = append(users, credentials.User)
+	}
+
+	changes := []change{}
+	for _, credentials := range s.SCC {
+		if utils.Contains(users, credentials.User) {
+			continue
+		}
+		credentials := credentials
+		changes = append(changes, change{
+			Description: fmt.Sprintf(L("Add SCC credentials %s"), credentials.User),
+			apply: func(client *api.APIClient) error {
+				return contentsync.AddCredentials(client, credentials.User, credentials.Password, credentials.Primary)
+			},
+		})
+	}
+	return changes, nil
+}
+
+// planProducts refreshes the products from SCC if some of the channels are not known by the server yet.
+func planProducts(client *api.APIClient, s *spec) ([]change, error) {
+	if len(s.Channels) == 0 {
+		return nil, nil
+	}
+	channels, err := contentsync.ListChannels(client)
+	if err != nil {
+		return nil, err
+	}
+	known := []string{}
+	for _, channel := range channels {
+		known = append(known, channel.Label)
+	}
+	for _, label := range s.Channels {
+		if !utils.Contains(known, label) {
+			return []change{{Description: L("Refresh the products from SCC"), apply: contentsync.Refresh}}, nil
+		}
+	}
+	return nil, nil
+}
+
+func planChannels(client *api.APIClient, s *spec) ([]change, error) {
+	if len(s.Channels) == 0 {
+		return nil, nil
+	}
+	channels, err := contentsync.ListChannels(client)
+	if err != nil {
+		return nil, err
+	}
+	installed := []string{}
+	for _, channel := range channels {
+		if strings.EqualFold(channel.Status, contentsync.InstalledChannel) {
+			installed = append(installed, channel.Label)
+		}
+	}
+
+	changes := []change{}
+	for _, label := range s.Channels {
+		if utils.Contains(installed, label) {
+			continue
+		}
+		label := label
+		changes = append(changes, change{
+			Description: fmt.Sprintf(L("Add vendor channel %s"), label),
+			apply: func(client *api.APIClient) error {
+				_, err := contentsync.AddChannels(client, label)
+				return err
+			},
+		})
+	}
+	return changes, nil
+}
+
+func planGroups(client *api.APIClient, s *spec) ([]change, error) {
+	if len(s.Groups) == 0 {
+		return nil, nil
+	}
+	groups, err := systemgroup.ListAllGroups(client)
+	if err != nil {
+		return nil, err
+	}
+	descriptions := map[string]string{}
+	for _, group := range groups {
+		descriptions[group.Name] = group.Description
+	}
+
+	changes := []change{}
+	for _, group := range s.Groups {
+		group := group
+		description, found := descriptions[group.Name]
+		switch {
+		case !found:
+			changes = append(changes, change{
+				Description: fmt.Sprintf(L("Create system group %s"), group.Name),
+				apply: func(client *api.APIClient) error {
+					return systemgroup.Create(client, group.Name, group.Description)
+				},
+			})
+		case description != group.Description:
+			changes = append(changes, change{
+				Description: fmt.Sprintf(L("Update the description of system group %s"), group.Name),
+				apply: func(client *api.APIClient) error {
+					return systemgroup.Update(client, group.Name, group.Description)
+				},
+			})
+		}
+	}
+	return changes, nil
+}
+
+// planUsers creates the missing users and adds the missing roles and system groups to the existing ones.
+//
+// The roles and groups not in the specification are kept and the passwords are not changed.
+func planUsers(client *api.APIClient, s *spec) ([]change, error) {
+	if len(s.Users) == 0 {
+		return nil, nil
+	}
+	existing, err := user.ListUsers(client)
+	if err != nil {
+		return nil, err
+	}
+	logins := []string{}
+	for _, u := range existing {
+		logins = append(logins, u.Login)
+	}
+
+	changes := []change{}
+	for _, def := range s.Users {
+		def := def
+		if !utils.Contains(logins, def.Login) {
+			changes = append(changes, change{
+				Description: fmt.Sprintf(L("Create user %s"), def.Login),
+				apply: func(client *api.APIClient) error {
+					return ctl_user.CreateUser(client, &def)
+				},
+			})
+			continue
+		}
+
+		roles, err := user.ListRoles(client, def.Login)
+		if err != nil {
+			return nil, err
+		}
+		groups, err := user.ListAssignedSystemGroups(client, def.Login)
+		if err != nil {
+			return nil, err
+		}
+		groupNames := []string{}
+		for _, group := range groups {
+			groupNames = append(groupNames, group.Name)
+		}
+		missingRoles := missing(roles, def.Roles)
+		missingGroups := missing(groupNames, def.Groups)
+		if len(missingRoles)+len(missingGroups) > 0 {
+			changes = append(changes, change{
+				Description: fmt.Sprintf(L("Add roles and system groups to user %s"), def.Login),
+				apply: func(client *api.APIClient) error {
+					return ctl_user.AddRolesAndGroups(client, def.Login, missingRoles, missingGroups)
+				},
+			})
+		}
+	}
+	return changes, nil
+}
+
+// missing returns the wanted values not in the current ones.
+func missing(current []string, wanted []string) []string {
+	result := []string{}
+	for _, value := range wanted {
+		if !utils.Contains(current, value) {
+			result = append(result, value)
+		}
+	}
+	return result
+}
+
+func planActivationKeys(client *api.APIClient, s *spec) ([]change, error) {
+	if len(s.ActivationKeys) == 0 {
+		return nil, nil
+	}
+	created, updated, err := activationkey.PlanKeys(client, s.ActivationKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := []change{}
+	for _, def := range s.ActivationKeys {
+		var description string
+		switch {
+		case utils.Contains(created, def.Key):
+			description = fmt.Sprintf(L("Create activation key %s"), def.Key)
+		case utils.Contains(updated, def.Key):
+			description = fmt.Sprintf(L("Update activation key %s"), def.Key)
+		default:
+			continue
+		}
+		def := def
+		changes = append(changes, change{
+			Description: description,
+			apply: func(client *api.APIClient) error {
+				return activationkey.ImportKeys(client, []*activationkey.KeyDefinition{def})
+			},
+		})
+	}
+	return changes, nil
+}
+
+// applySpec converges the server to the specification, one section after the other.
+//
+// If plan is true, the changes are only computed from the current state of the server.
+// returns the description of the changes.
+func applySpec(client *api.APIClient, s *spec, plan bool) ([]string, error) {
+	descriptions := []string{}
+	for _, planStep := range steps {
+		changes, err := planStep(client, s)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range changes {
+			descriptions = append(descriptions, c.Description)
+			if plan {
+				continue
+			}
+			log.Info().Msg(c.Description)
+			if err := c.apply(client); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return descriptions, nil
+}
@@ -0,0 +1,371 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package system
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	ctl_utils "github.com/uyuni-project/uyuni-tools/mgrctl/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/system"
+	apiTypes "github.com/uyuni-project/uyuni-tools/shared/api/types"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+	"gopkg.in/yaml.v2"
+)
+
+// Statuses of the bootstrapped hosts.
+const (
+	bootstrapSucceeded = "succeeded"
+	bootstrapFailed    = "failed"
+)
+
+type bootstrapFlags struct {
+	ConnectionDetails api.ConnectionDetails `mapstructure:"api"`
+	File              string
+	Parallel          int
+	Timeout           time.Duration
+	Failures          string
+	Output            string
+}
+
+// hostDefinition is a host to bootstrap as defined in the inventory file.
+type hostDefinition struct {
+	Host            string `yaml:"host,omitempty"`
+	Port            int    `yaml:"port,omitempty"`
+	User            string `yaml:"user,omitempty"`
+	Password        string `yaml:"password,omitempty"`
+	Key             string `yaml:"key,omitempty"`
+	KeyPassphrase   string `yaml:"keyPassphrase,omitempty"`
+	ActivationKey   string `yaml:"activationKey,omitempty"`
+	ReactivationKey string `yaml:"reactivationKey,omitempty"`
+	Proxy           string `yaml:"proxy,omitempty"`
+	SaltSSH         *bool  `yaml:"saltSSH,omitempty"`
+}
+
+// inventory is the list of hosts to bootstrap with the values shared by all of them.
+type inventory struct {
+	Defaults hostDefinition   `yaml:"defaults,omitempty"`
+	Hosts    []hostDefinition `yaml:"hosts"`
+}
+
+// bootstrapResult is the outcome of the bootstrap of a host.
+type bootstrapResult struct {
+	Host     string        `json:"host"`
+	Status   string        `json:"status"`
+	Duration time.Duration `json:"duration"`
+	Error    string        `json:"error,omitempty"`
+}
+
+func newBootstrapCmd(globalFlags *types.GlobalFlags, run utils.CommandFunc[bootstrapFlags]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bootstrap",
+		Short: L("Bootstrap systems listed in an inventory file"),
+		Long: L(`Bootstrap systems listed in an inventory file and report the result for each host.
+
+The inventory is a YAML file with a list of hosts and optional defaults for all of them:
+
+  defaults:
+    user: root
+    key: /root/.ssh/id_ed25519
+    activationKey: 1-sles15
+  hosts:
+    - host: web1.example.com
+    - host: web2.example.com
+      password: secret
+      proxy: proxy.example.com
+
+Each host accepts the following keys:
+  host, port, user, password, key, keyPassphrase,
+  activationKey, reactivationKey, proxy and saltSSH.
+
+The port defaults to 22 and the user to root. The key is the path to a private SSH key,
+relative to the inventory file. The proxy is the ID or profile name of a registered proxy.
+
+The failed hosts are written to an inventory file to retry them later.`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var flags bootstrapFlags
+			return utils.CommandHelper(globalFlags, cmd, args, &flags, nil, run)
+		},
+	}
+
+	cmd.Flags().StringP("file", "f", "", L("path to the inventory file"))
+	_ = cmd.MarkFlagRequired("file")
+	cmd.Flags().Int("parallel", 5, L("maximum number of hosts to bootstrap at the same time"))
+	cmd.Flags().Duration("timeout", 10*time.Minute, L("maximum duration of the bootstrap of a host"))
+	cmd.Flags().String("failures", "",
+		L("inventory file to write the failed hosts to. Defaults to the inventory path with a .failed suffix"),
+	)
+	ctl_utils.AddOutputFlag(cmd)
+	api.AddAPIFlags(cmd)
+
+	return cmd
+}
+
+func newBootstrapCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	return newBootstrapCmd(globalFlags, runBootstrap)
+}
+
+func runBootstrap(_ *types.GlobalFlags, flags *bootstrapFlags, _ *cobra.Command, _ []string) error {
+	inv, err := readInventory(flags.File)
+	if err != nil {
+		return err
+	}
+
+	client, err := ctl_utils.Connect(&flags.ConnectionDetails)
+	if err != nil {
+		return err
+	}
+	// The bootstrap calls only return once the system is registered
+	if httpClient, ok := client.Client.(*http.Client); ok && flags.Timeout > 0 {
+		httpClient.Timeout = flags.Timeout
+	}
+
+	return bootstrapInventory(client, inv, flags, os.Stdout)
+}
+
+// readInventory reads and validates an inventory file.
+//
+// The relative key paths are resolved from the inventory directory.
+func readInventory(path string) (*inventory, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to read %s"), path)
+	}
+	var inv inventory
+	if err := yaml.UnmarshalStrict(data, &inv); err != nil {
+		return nil, utils.Errorf(err, L("failed to parse %s"), path)
+	}
+
+	if inv.Defaults.Host != "" {
+		return nil, fmt.Errorf(L("the defaults cannot have a host in %s"), path)
+	}
+	inv.Defaults.Key = resolveKeyPath(filepath.Dir(path), inv.Defaults.Key)
+	for i := range inv.Hosts {
+		host := &inv.Hosts[i]
+		if host.Host == "" {
+			return nil, fmt.Errorf(L("host definition without host in %s"), path)
+		}
+		host.Key = resolveKeyPath(filepath.Dir(path), host.Key)
+	}
+	return &inv, nil
+}
+
+func resolveKeyPath(dir string, key string) string {
+	if key == "" || filepath.IsAbs(key) {
+		return key
+	}
+	// Absolute paths are still valid in the failures file
+	if abs, err := filepath.Abs(filepath.Join(dir, key)); err == nil {
+		return abs
+	}
+	return filepath.Join(dir, key)
+}
+
+// merge returns the host definition completed with the defaults.
+//
+// The SSH credentials are only taken from the defaults if the host has neither a password nor a key.
+func (h hostDefinition) merge(defaults *hostDefinition) hostDefinition {
+	merged := h
+	mergeString := func(value *string, fallback string) {
+		if *value == "" {
+			*value = fallback
+		}
+	}
+	if merged.Password == "" && merged.Key == "" {
+		merged.Password = defaults.Password
+		merged.Key = defaults.Key
+		merged.KeyPassphrase = defaults.KeyPassphrase
+	}
+	mergeString(&merged.User, defaults.User)
+	mergeString(&merged.ActivationKey, defaults.ActivationKey)
+	mergeString(&merged.ReactivationKey, defaults.ReactivationKey)
+	mergeString(&merged.Proxy, defaults.Proxy)
+	mergeString(&merged.User, "root")
+	if merged.Port == 0 {
+		merged.Port = defaults.Port
+	}
+	if merged.Port == 0 {
+		merged.Port = 22
+	}
+	if merged.SaltSSH == nil {
+		merged.SaltSSH = defaults.SaltSSH
+	}
+	return merged
+}
+
+// bootstrapParams computes the API parameters to bootstrap a host.
+func bootstrapParams(client *api.APIClient, host *hostDefinition) (*apiTypes.BootstrapParams, error) {
+	params := apiTypes.BootstrapParams{
+		Host:            host.Host,
+		SSHPort:         host.Port,
+		SSHUser:         host.User,
+		SSHPassword:     host.Password,
+		SSHPrivKeyPass:  host.KeyPassphrase,
+		ActivationKey:   host.ActivationKey,
+		ReactivationKey: host.ReactivationKey,
+		SaltSSH:         host.SaltSSH != nil && *host.SaltSSH,
+	}
+	if host.Key != "" {
+		key, err := os.ReadFile(host.Key)
+		if err != nil {
+			return nil, utils.Errorf(err, L("failed to read the SSH key %s"), host.Key)
+		}
+		params.SSHPrivKey = string(key)
+	} else if host.Password == "" {
+		return nil, errors.New(L("either an SSH password or a key is required"))
+	}
+	if host.Proxy != "" {
+		proxyID, err := system.LookupID(client, host.Proxy)
+		if err != nil {
+			return nil, err
+		}
+		params.ProxyID = proxyID
+	}
+	return &params, nil
+}
+
+func bootstrapHost(client *api.APIClient, host *hostDefinition) error {
+	params, err := bootstrapParams(client, host)
+	if err != nil {
+		return err
+	}
+	return system.Bootstrap(client, params)
+}
+
+// bootstrapHosts bootstraps the hosts with at most parallel calls at the same time.
+//
+// The results are in the same order than the hosts.
+func bootstrapHosts(client *api.APIClient, hosts []hostDefinition, parallel int) []bootstrapResult {
+	if parallel < 1 {
+		parallel = 1
+	}
+	results := make([]bootstrapResult, len(hosts))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for worker := 0; worker < parallel && worker < len(hosts); worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				host := &hosts[i]
+				log.Info().Msgf(L("Bootstrapping %s"), host.Host)
+				start := time.Now()
+				err := bootstrapHost(client, host)
+				result := bootstrapResult{
+					Host:     host.Host,
+					Status:   bootstrapSucceeded,
+					Duration: time.Since(start).Round(time.Second),
+				}
+				if err != nil {
+					log.Error().Err(err).Msgf(L("Failed to bootstrap %s"), host.Host)
+					result.Status = bootstrapFailed
+					result.Error = err.Error()
+				} else {
+					log.Info().Msgf(L("Bootstrapped %s"), host.Host)
+				}
+				results[i] = result
+			}
+		}()
+	}
+	for i := range hosts {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+	return results
+}
+
+// failuresPath returns the path of the inventory file for the failed hosts.
+//
+// Retrying from a failures file overwrites it.
+func failuresPath(inventoryPath string) string {
+	ext := filepath.Ext(inventoryPath)
+	base := strings.TrimSuffix(inventoryPath, ext)
+	if strings.HasSuffix(base, ".failed") {
+		return inventoryPath
+	}
+	return base + ".failed" + ext
+}
+
+// writeFailures writes the failed hosts in an inventory file or removes it if none failed.
+//
+// The file may contain passwords and is only readable by its owner.
+func writeFailures(path string, inv *inventory, results []bootstrapResult) error {
+	failed := inventory{Defaults: inv.Defaults, Hosts: []hostDefinition{}}
+	for i, result := range results {
+		if result.Status == bootstrapFailed {
+			failed.Hosts = append(failed.Hosts, inv.Hosts[i])
+		}
+	}
+	if len(failed.Hosts) == 0 {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return utils.Errorf(err, L("failed to remove %s"), path)
+		}
+		return nil
+	}
+
+	data, err := yaml.Marshal(&failed)
+	if err != nil {
+		return utils.Errorf(err, L("failed to serialize the failed hosts"))
+	}
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return utils.Errorf(err, L("failed to write %s"), path)
+	}
+	return nil
+}
+
+func bootstrapInventory(client *api.APIClient, inv *inventory, flags *bootstrapFlags, w io.Writer) error {
+	hosts := []hostDefinition{}
+	for _, host := range inv.Hosts {
+		hosts = append(hosts, host.merge(&inv.Defaults))
+	}
+	results := bootstrapHosts(client, hosts, flags.Parallel)
+
+	err := ctl_utils.PrintOutput(w, flags.Output, results, func() *ctl_utils.Table {
+		table := ctl_utils.Table{Headers: []string{L("HOST"), L("STATUS"), L("DURATION"), L("ERROR")}}
+		for _, result := range results {
+			table.AddRow(result.Host, result.Status, result.Duration.String(), result.Error)
+		}
+		return &table
+	})
+	if err != nil {
+		return err
+	}
+
+	failuresFile := flags.Failures
+	if failuresFile == "" {
+		failuresFile = failuresPath(flags.File)
+	}
+	if err := writeFailures(failuresFile, inv, results); err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, result := range results {
+		if result.Status == bootstrapFailed {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf(
+			L("failed to bootstrap %[1]d of %[2]d hosts, retry them with: mgrctl system bootstrap -f %[3]s"),
+			failed, len(results), failuresFile,
+		)
+	}
+	return nil
+}
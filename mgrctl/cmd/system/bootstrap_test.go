@@ -0,0 +1,147 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package system
+
+import (
+	"bytes"
+	"os"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/uyuni-project/uyuni-tools/shared/api/fake"
+	"github.com/uyuni-project/uyuni-tools/shared/testutils"
+	"github.com/uyuni-project/uyuni-tools/shared/testutils/flagstests"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+)
+
+const inventoryFile = `defaults:
+  key: id_ed25519
+  activationKey: 1-sles15
+hosts:
+  - host: web1.example.com
+  - host: web2.example.com
+    port: 2222
+    user: admin
+    password: secret
+    proxy: proxy.example.com
+  - host: db1.example.com
+    reactivationKey: re-1-db1
+`
+
+func TestBootstrapParamsParsing(t *testing.T) {
+	args := []string{
+		"--file", "inventory.yaml",
+		"--parallel", "10",
+		"--timeout", "20m",
+		"--failures", "retry.yaml",
+		"--output", "json",
+	}
+	args = append(args, flagstests.APIFlagsTestArgs...)
+
+	// Test function asserting that the args are properly parsed
+	tester := func(_ *types.GlobalFlags, flags *bootstrapFlags, _ *cobra.Command, _ []string) error {
+		flagstests.AssertAPIFlags(t, &flags.ConnectionDetails)
+		testutils.AssertEquals(t, "Error parsing --file", "inventory.yaml", flags.File)
+		testutils.AssertEquals(t, "Error parsing --parallel", 10, flags.Parallel)
+		testutils.AssertEquals(t, "Error parsing --timeout", 20*time.Minute, flags.Timeout)
+		testutils.AssertEquals(t, "Error parsing --failures", "retry.yaml", flags.Failures)
+		testutils.AssertEquals(t, "Error parsing --output", "json", flags.Output)
+		return nil
+	}
+
+	globalFlags := types.GlobalFlags{}
+	cmd := newBootstrapCmd(&globalFlags, tester)
+
+	testutils.AssertHasAllFlags(t, cmd, args)
+
+	cmd.SetArgs(args)
+	if err := cmd.Execute(); err != nil {
+		t.Errorf("command failed with error: %s", err)
+	}
+}
+
+func TestFailuresPath(t *testing.T) {
+	testutils.AssertEquals(t, "Unexpected failures path", "hosts.failed.yaml", failuresPath("hosts.yaml"))
+	testutils.AssertEquals(t, "Retrying should reuse the file", "hosts.failed.yaml", failuresPath("hosts.failed.yaml"))
+}
+
+func TestBootstrapInventory(t *testing.T) {
+	server := newFakeServer(t)
+	defer server.Close()
+	server.Handle("GET", "system/getId", func(_ *fake.Request) *fake.Response {
+		return fake.Success([]map[string]interface{}{{"id": 1000010000, "name": "proxy.example.com"}})
+	})
+	server.Handle("POST", "system/bootstrap", func(_ *fake.Request) *fake.Response {
+		return fake.Success(1)
+	})
+	server.Handle("POST", "system/bootstrapWithPrivateSshKey", func(req *fake.Request) *fake.Response {
+		if req.Params["host"] == "db1.example.com" {
+			return fake.Failure("Host unreachable")
+		}
+		return fake.Success(1)
+	})
+
+	dir := t.TempDir()
+	testutils.WriteFile(t, path.Join(dir, "id_ed25519"), "PRIVATE KEY")
+	inventoryPath := path.Join(dir, "hosts.yaml")
+	testutils.WriteFile(t, inventoryPath, inventoryFile)
+	inv, err := readInventory(inventoryPath)
+	if err != nil {
+		t.Fatalf("failed to read the inventory: %s", err)
+	}
+
+	client, err := connect(server)
+	if err != nil {
+		t.Fatalf("failed to connect: %s", err)
+	}
+	var out bytes.Buffer
+	flags := bootstrapFlags{File: inventoryPath, Parallel: 2, Output: "csv"}
+	err = bootstrapInventory(client, inv, &flags, &out)
+	testutils.AssertTrue(t, "The failed host should be reported as an error", err != nil)
+
+	calls := server.CallsTo("system/bootstrap")
+	testutils.AssertEquals(t, "Unexpected number of password bootstraps", 1, len(calls))
+	testutils.AssertEquals[interface{}](t, "Unexpected port", 2222.0, calls[0].Params["sshPort"])
+	testutils.AssertEquals[interface{}](t, "Unexpected proxy", 1000010000.0, calls[0].Params["proxyId"])
+	testutils.AssertEquals[interface{}](t, "Unexpected activation key", "1-sles15", calls[0].Params["activationKey"])
+
+	for _, call := range server.CallsTo("system/bootstrapWithPrivateSshKey") {
+		testutils.AssertEquals[interface{}](t, "Unexpected key", "PRIVATE KEY", call.Params["sshPrivKey"])
+		testutils.AssertEquals[interface{}](t, "Unexpected default user", "root", call.Params["sshUser"])
+		testutils.AssertEquals[interface{}](t, "Unexpected default port", 22.0, call.Params["sshPort"])
+	}
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	testutils.AssertEquals(t, "Unexpected number of report lines", 4, len(lines))
+	testutils.AssertTrue(t, "Unexpected db1 result: "+lines[3], strings.HasPrefix(lines[3], "db1.example.com,failed,"))
+
+	// Retry only the failed host
+	failuresPath := path.Join(dir, "hosts.failed.yaml")
+	info, err := os.Stat(failuresPath)
+	if err != nil {
+		t.Fatalf("missing failures file: %s", err)
+	}
+	testutils.AssertEquals(t, "Unexpected failures file permissions", os.FileMode(0600), info.Mode().Perm())
+	retry, err := readInventory(failuresPath)
+	if err != nil {
+		t.Fatalf("failed to read the failures: %s", err)
+	}
+	testutils.AssertEquals(t, "Unexpected number of failed hosts", 1, len(retry.Hosts))
+	testutils.AssertEquals(t, "Unexpected failed host", "db1.example.com", retry.Hosts[0].Host)
+	testutils.AssertEquals(t, "Unexpected key path", path.Join(dir, "id_ed25519"), retry.Defaults.Key)
+
+	server.Handle("POST", "system/bootstrapWithPrivateSshKey", func(_ *fake.Request) *fake.Response {
+		return fake.Success(1)
+	})
+	flags.File = failuresPath
+	if err := bootstrapInventory(client, retry, &flags, &out); err != nil {
+		t.Fatalf("failed to retry: %s", err)
+	}
+	_, err = os.Stat(failuresPath)
+	testutils.AssertTrue(t, "The failures file should be removed", os.IsNotExist(err))
+}
@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package system
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	ctl_utils "github.com/uyuni-project/uyuni-tools/mgrctl/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/system"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+var cleanupTypes = []string{system.FailOnCleanupErr, system.NoCleanup, system.ForceDelete}
+
+type deleteFlags struct {
+	ConnectionDetails api.ConnectionDetails `mapstructure:"api"`
+	Cleanup           string
+	Force             bool
+}
+
+func newDeleteCmd(globalFlags *types.GlobalFlags, run utils.CommandFunc[deleteFlags]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete system...",
+		Short: L("Delete systems"),
+		Long:  L("Delete systems given their ID or profile name."),
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var flags deleteFlags
+			return utils.CommandHelper(globalFlags, cmd, args, &flags, nil, run)
+		},
+	}
+
+	cmd.Flags().String("cleanup", system.FailOnCleanupErr,
+		fmt.Sprintf(L("how to cleanup the systems, one of %s"), strings.Join(cleanupTypes, ", ")),
+	)
+	cmd.Flags().BoolP("force", "f", false, L("do not ask for confirmation"))
+	api.AddAPIFlags(cmd)
+
+	return cmd
+}
+
+func newDeleteCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	return newDeleteCmd(globalFlags, runDelete)
+}
+
+func runDelete(_ *types.GlobalFlags, flags *deleteFlags, _ *cobra.Command, args []string) error {
+	if !utils.Contains(cleanupTypes, flags.Cleanup) {
+		return fmt.Errorf(L("invalid cleanup type: %s"), flags.Cleanup)
+	}
+
+	client, err := ctl_utils.Connect(&flags.ConnectionDetails)
+	if err != nil {
+		return err
+	}
+
+	sids, err := lookupIDs(client, args)
+	if err != nil {
+		return err
+	}
+
+	if !flags.Force {
+		confirmed, err := utils.YesNo(fmt.Sprintf(L("Delete systems %s"), strings.Join(args, ", ")))
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return errors.New(L("deletion cancelled"))
+		}
+	}
+
+	if err := system.DeleteSystems(client, sids, flags.Cleanup); err != nil {
+		return err
+	}
+	log.Info().Msgf(L("Deleted systems %s"), strings.Join(args, ", "))
+	return nil
+}
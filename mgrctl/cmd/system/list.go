@@ -0,0 +1,196 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package system
+
+import (
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	ctl_utils "github.com/uyuni-project/uyuni-tools/mgrctl/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/system"
+	"github.com/uyuni-project/uyuni-tools/shared/api/systemgroup"
+	apiTypes "github.com/uyuni-project/uyuni-tools/shared/api/types"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+type checkinFlags struct {
+	Before string
+	After  string
+}
+
+type listFlags struct {
+	ConnectionDetails api.ConnectionDetails `mapstructure:"api"`
+	Group             string
+	Entitlement       string
+	Checkin           checkinFlags
+	OS                string `mapstructure:"os"`
+	Output            string
+}
+
+// listedSystem is a system as printed by the list command.
+type listedSystem struct {
+	apiTypes.SystemOverview
+	OS string `json:"os,omitempty"`
+}
+
+func newListCmd(globalFlags *types.GlobalFlags, run utils.CommandFunc[listFlags]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: L("List the systems"),
+		Long: L(`List the registered systems, optionally filtered.
+
+The check-in filters accept a date like 2025-03-14 or a duration in the past like 7d or 12h.
+
+Example to list the systems of the web group not checking in for a week:
+# mgrctl system list --group web --checkin-before 7d`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var flags listFlags
+			return utils.CommandHelper(globalFlags, cmd, args, &flags, nil, run)
+		},
+	}
+
+	cmd.Flags().String("group", "", L("only list the systems of this group"))
+	cmd.Flags().String("entitlement", "", L("only list the systems with this entitlement, like salt_entitled"))
+	cmd.Flags().String("checkin-before", "", L("only list the systems whose last check-in is before this date"))
+	cmd.Flags().String("checkin-after", "", L("only list the systems whose last check-in is after this date"))
+	cmd.Flags().String("os", "", L("only list the systems whose base product contains this text, like SLES 15"))
+	ctl_utils.AddOutputFlag(cmd)
+	api.AddAPIFlags(cmd)
+
+	return cmd
+}
+
+func newListCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	return newListCmd(globalFlags, runList)
+}
+
+func runList(_ *types.GlobalFlags, flags *listFlags, _ *cobra.Command, _ []string) error {
+	client, err := ctl_utils.Connect(&flags.ConnectionDetails)
+	if err != nil {
+		return err
+	}
+
+	systems, err := listSystems(client, flags, time.Now())
+	if err != nil {
+		return err
+	}
+
+	return ctl_utils.PrintOutput(os.Stdout, flags.Output, systems, func() *ctl_utils.Table {
+		table := ctl_utils.Table{Headers: []string{L("ID"), L("NAME"), L("LAST CHECK-IN"), L("OUTDATED PACKAGES")}}
+		if flags.OS != "" {
+			table.Headers = append(table.Headers, L("OS"))
+		}
+		for _, s := range systems {
+			row := []string{
+				strconv.Itoa(s.ID), s.Name, ctl_utils.FormatTime(s.LastCheckin.Time), strconv.Itoa(s.OutdatedPkgCount),
+			}
+			if flags.OS != "" {
+				row = append(row, s.OS)
+			}
+			table.AddRow(row...)
+		}
+		return &table
+	})
+}
+
+// listSystems gets the systems matching the filters, sorted by name.
+func listSystems(client *api.APIClient, flags *listFlags, now time.Time) ([]listedSystem, error) {
+	var before, after time.Time
+	var err error
+	if flags.Checkin.Before != "" {
+		if before, err = ctl_utils.ParseTime(flags.Checkin.Before, now); err != nil {
+			return nil, err
+		}
+	}
+	if flags.Checkin.After != "" {
+		if after, err = ctl_utils.ParseTime(flags.Checkin.After, now); err != nil {
+			return nil, err
+		}
+	}
+
+	var systems []apiTypes.SystemOverview
+	if flags.Entitlement != "" {
+		systems, err = system.ListSystemsWithEntitlement(client, flags.Entitlement)
+	} else {
+		systems, err = system.ListSystems(client)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var groupMembers map[int]bool
+	if flags.Group != "" {
+		members, err := systemgroup.ListSystemsMinimal(client, flags.Group)
+		if err != nil {
+			return nil, err
+		}
+		groupMembers = map[int]bool{}
+		for _, member := range members {
+			groupMembers[member.ID] = true
+		}
+	}
+
+	result := []listedSystem{}
+	for _, s := range systems {
+		if groupMembers != nil && !groupMembers[s.ID] {
+			continue
+		}
+		if !before.IsZero() && !s.LastCheckin.Before(before) {
+			continue
+		}
+		if !after.IsZero() && !s.LastCheckin.After(after) {
+			continue
+		}
+
+		listed := listedSystem{SystemOverview: s}
+		if flags.OS != "" {
+			// Only query the products of the systems matching the other filters
+			if listed.OS, err = getOS(client, s.ID); err != nil {
+				return nil, err
+			}
+			if !strings.Contains(strings.ToLower(listed.OS), strings.ToLower(flags.OS)) {
+				continue
+			}
+		}
+		result = append(result, listed)
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result, nil
+}
+
+// getOS returns the name of the base product installed on a system.
+func getOS(client *api.APIClient, sid int) (string, error) {
+	products, err := system.ListInstalledProducts(client, sid)
+	if err != nil {
+		return "", err
+	}
+	for _, product := range products {
+		if !product.IsBaseProduct {
+			continue
+		}
+		if product.FriendlyName != "" {
+			return product.FriendlyName, nil
+		}
+		return strings.TrimSpace(product.Name + " " + product.Version), nil
+	}
+
+	// Systems without products like non SUSE ones
+	details, err := system.GetDetails(client, sid)
+	if err != nil {
+		return "", err
+	}
+	return details.Release, nil
+}
@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package system
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/uyuni-project/uyuni-tools/shared/api/fake"
+	"github.com/uyuni-project/uyuni-tools/shared/testutils"
+	"github.com/uyuni-project/uyuni-tools/shared/testutils/flagstests"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+)
+
+func TestListParamsParsing(t *testing.T) {
+	args := []string{
+		"--group", "web",
+		"--entitlement", "salt_entitled",
+		"--checkin-before", "7d",
+		"--checkin-after", "2025-01-01",
+		"--os", "SLES 15",
+		"--output", "json",
+	}
+	args = append(args, flagstests.APIFlagsTestArgs...)
+
+	// Test function asserting that the args are properly parsed
+	tester := func(_ *types.GlobalFlags, flags *listFlags, _ *cobra.Command, _ []string) error {
+		flagstests.AssertAPIFlags(t, &flags.ConnectionDetails)
+		testutils.AssertEquals(t, "Error parsing --group", "web", flags.Group)
+		testutils.AssertEquals(t, "Error parsing --entitlement", "salt_entitled", flags.Entitlement)
+		testutils.AssertEquals(t, "Error parsing --checkin-before", "7d", flags.Checkin.Before)
+		testutils.AssertEquals(t, "Error parsing --checkin-after", "2025-01-01", flags.Checkin.After)
+		testutils.AssertEquals(t, "Error parsing --os", "SLES 15", flags.OS)
+		testutils.AssertEquals(t, "Error parsing --output", "json", flags.Output)
+		return nil
+	}
+
+	globalFlags := types.GlobalFlags{}
+	cmd := newListCmd(&globalFlags, tester)
+
+	testutils.AssertHasAllFlags(t, cmd, args)
+
+	cmd.SetArgs(args)
+	if err := cmd.Execute(); err != nil {
+		t.Errorf("command failed with error: %s", err)
+	}
+}
+
+func newFakeServer(t *testing.T) *fake.Server {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	server := fake.NewServer("admin", "secret")
+	server.Handle("GET", "system/listSystems", func(_ *fake.Request) *fake.Response {
+		return fake.Success([]map[string]interface{}{
+			{"id": 1000010002, "name": "web2", "last_checkin": "2025-03-01T10:00:00Z"},
+			{"id": 1000010001, "name": "web1", "last_checkin": "2025-03-14T10:00:00Z"},
+			{"id": 1000010003, "name": "db1", "last_checkin": "Feb 1, 2025, 10:00:00 AM"},
+		})
+	})
+	server.Handle("GET", "systemgroup/listSystemsMinimal", func(req *fake.Request) *fake.Response {
+		if req.Params["systemGroupName"] != "web" {
+			return fake.Failure("Unable to locate or access server group: " + req.Params["systemGroupName"].(string))
+		}
+		return fake.Success([]map[string]interface{}{
+			{"id": 1000010001, "name": "web1"},
+			{"id": 1000010002, "name": "web2"},
+		})
+	})
+	server.Handle("GET", "system/listInstalledProducts", func(req *fake.Request) *fake.Response {
+		name := "SUSE Linux Enterprise Server 15 SP6"
+		if req.Params["sid"] == "1000010002" {
+			name = "openSUSE Leap 15.6"
+		}
+		return fake.Success([]map[string]interface{}{
+			{"name": "base", "isBaseProduct": true, "friendlyName": name},
+		})
+	})
+	return server
+}
+
+func TestListSystems(t *testing.T) {
+	server := newFakeServer(t)
+	defer server.Close()
+
+	client, err := connect(server)
+	if err != nil {
+		t.Fatalf("failed to connect: %s", err)
+	}
+	now := time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC)
+
+	systems, err := listSystems(client, &listFlags{}, now)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	testutils.AssertEquals(t, "Unexpected number of systems", 3, len(systems))
+	testutils.AssertEquals(t, "Systems should be sorted by name", "db1", systems[0].Name)
+	testutils.AssertEquals(t, "Unexpected check-in date",
+		time.Date(2025, 2, 1, 10, 0, 0, 0, time.UTC), systems[0].LastCheckin.Time,
+	)
+
+	systems, err = listSystems(client, &listFlags{Group: "web", Checkin: checkinFlags{Before: "7d"}}, now)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	testutils.AssertEquals(t, "Unexpected number of filtered systems", 1, len(systems))
+	testutils.AssertEquals(t, "Unexpected filtered system", "web2", systems[0].Name)
+
+	filters := listFlags{OS: "enterprise server", Checkin: checkinFlags{After: "2025-02-15"}}
+	systems, err = listSystems(client, &filters, now)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	testutils.AssertEquals(t, "Unexpected number of systems by OS", 1, len(systems))
+	testutils.AssertEquals(t, "Unexpected system by OS", "web1", systems[0].Name)
+	testutils.AssertEquals(t, "Unexpected OS", "SUSE Linux Enterprise Server 15 SP6", systems[0].OS)
+
+	if _, err := listSystems(client, &listFlags{Group: "db"}, now); err == nil {
+		t.Error("expected an error for an unknown group")
+	}
+}
@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package system
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	ctl_utils "github.com/uyuni-project/uyuni-tools/mgrctl/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/system"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+type rebootFlags struct {
+	ConnectionDetails api.ConnectionDetails `mapstructure:"api"`
+	Earliest          string
+}
+
+func newRebootCmd(globalFlags *types.GlobalFlags, run utils.CommandFunc[rebootFlags]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reboot system...",
+		Short: L("Schedule the reboot of systems"),
+		Long: L(`Schedule the reboot of systems given their ID or profile name.
+
+The IDs of the scheduled actions are printed, one per system.`),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var flags rebootFlags
+			return utils.CommandHelper(globalFlags, cmd, args, &flags, nil, run)
+		},
+	}
+
+	cmd.Flags().String("earliest", "",
+		L("date or delay from now of the earliest reboot, like 2025-03-14 22:00 or 2h. Defaults to now"),
+	)
+	api.AddAPIFlags(cmd)
+
+	return cmd
+}
+
+func newRebootCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	return newRebootCmd(globalFlags, runReboot)
+}
+
+func runReboot(_ *types.GlobalFlags, flags *rebootFlags, _ *cobra.Command, args []string) error {
+	earliest := time.Now()
+	if flags.Earliest != "" {
+		var err error
+		if earliest, err = ctl_utils.ParseFutureTime(flags.Earliest, earliest); err != nil {
+			return err
+		}
+	}
+
+	client, err := ctl_utils.Connect(&flags.ConnectionDetails)
+	if err != nil {
+		return err
+	}
+
+	sids, err := lookupIDs(client, args)
+	if err != nil {
+		return err
+	}
+
+	for i, sid := range sids {
+		actionID, err := system.ScheduleReboot(client, sid, earliest)
+		if err != nil {
+			return err
+		}
+		log.Info().Msgf(L("Scheduled reboot of system %[1]s as action %[2]d"), args[i], actionID)
+		fmt.Println(actionID)
+	}
+	return nil
+}
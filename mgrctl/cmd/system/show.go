@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package system
+
+import (
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	ctl_utils "github.com/uyuni-project/uyuni-tools/mgrctl/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/system"
+	apiTypes "github.com/uyuni-project/uyuni-tools/shared/api/types"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+type showFlags struct {
+	ConnectionDetails api.ConnectionDetails `mapstructure:"api"`
+	Output            string
+}
+
+// systemInfo gathers the information printed by the show command.
+type systemInfo struct {
+	apiTypes.SystemDetails
+	OS           string                  `json:"os"`
+	Entitlements []string                `json:"entitlements"`
+	Groups       []string                `json:"groups"`
+	Network      *apiTypes.SystemNetwork `json:"network"`
+}
+
+func newShowCmd(globalFlags *types.GlobalFlags, run utils.CommandFunc[showFlags]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show system",
+		Short: L("Show the details of a system"),
+		Long:  L("Show the details of a system given its ID or profile name."),
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var flags showFlags
+			return utils.CommandHelper(globalFlags, cmd, args, &flags, nil, run)
+		},
+	}
+
+	ctl_utils.AddOutputFlag(cmd)
+	api.AddAPIFlags(cmd)
+
+	return cmd
+}
+
+func newShowCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	return newShowCmd(globalFlags, runShow)
+}
+
+func runShow(_ *types.GlobalFlags, flags *showFlags, _ *cobra.Command, args []string) error {
+	client, err := ctl_utils.Connect(&flags.ConnectionDetails)
+	if err != nil {
+		return err
+	}
+
+	info, err := getSystemInfo(client, args[0])
+	if err != nil {
+		return err
+	}
+
+	return ctl_utils.PrintOutput(os.Stdout, flags.Output, info, func() *ctl_utils.Table {
+		table := ctl_utils.Table{}
+		table.AddRow(L("ID:"), strconv.Itoa(info.ID))
+		table.AddRow(L("Name:"), info.ProfileName)
+		table.AddRow(L("Hostname:"), info.Network.Hostname)
+		table.AddRow(L("IP address:"), info.Network.IP)
+		table.AddRow(L("IPv6 address:"), info.Network.IP6)
+		table.AddRow(L("OS:"), info.OS)
+		table.AddRow(L("Minion ID:"), info.MinionID)
+		table.AddRow(L("Contact method:"), info.ContactMethod)
+		table.AddRow(L("Entitlements:"), strings.Join(info.Entitlements, ", "))
+		table.AddRow(L("Groups:"), strings.Join(info.Groups, ", "))
+		table.AddRow(L("Last boot:"), ctl_utils.FormatTime(info.LastBoot.Time))
+		table.AddRow(L("Locked:"), strconv.FormatBool(info.LockStatus))
+		table.AddRow(L("Description:"), strings.ReplaceAll(info.Description, "\n", " "))
+		return &table
+	})
+}
+
+func getSystemInfo(client *api.APIClient, name string) (*systemInfo, error) {
+	sid, err := system.LookupID(client, name)
+	if err != nil {
+		return nil, err
+	}
+
+	details, err := system.GetDetails(client, sid)
+	if err != nil {
+		return nil, err
+	}
+	info := systemInfo{SystemDetails: *details, Groups: []string{}}
+
+	if info.OS, err = getOS(client, sid); err != nil {
+		return nil, err
+	}
+	if info.Entitlements, err = system.GetEntitlements(client, sid); err != nil {
+		return nil, err
+	}
+	if info.Network, err = system.GetNetwork(client, sid); err != nil {
+		return nil, err
+	}
+
+	groups, err := system.ListGroups(client, sid)
+	if err != nil {
+		return nil, err
+	}
+	for _, group := range groups {
+		if group.Subscribed == 1 {
+			info.Groups = append(info.Groups, group.Name)
+		}
+	}
+	return &info, nil
+}
@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package system
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/system"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+)
+
+// NewCommand entry command for managing the registered systems.
+func NewCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	systemCmd := &cobra.Command{
+		Use:   "system",
+		Short: L("Manage the registered systems"),
+		Run: func(cmd *cobra.Command, _ []string) {
+			_ = cmd.Help()
+		},
+	}
+
+	systemCmd.AddCommand(newListCommand(globalFlags))
+	systemCmd.AddCommand(newShowCommand(globalFlags))
+	systemCmd.AddCommand(newDeleteCommand(globalFlags))
+	systemCmd.AddCommand(newRebootCommand(globalFlags))
+	systemCmd.AddCommand(newTagCommand(globalFlags))
+	systemCmd.AddCommand(newBootstrapCommand(globalFlags))
+
+	return systemCmd
+}
+
+// lookupIDs resolves the IDs of the systems given by ID or profile name.
+func lookupIDs(client *api.APIClient, systems []string) ([]int, error) {
+	ids := []int{}
+	for _, name := range systems {
+		id, err := system.LookupID(client, name)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package system
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	ctl_utils "github.com/uyuni-project/uyuni-tools/mgrctl/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/fake"
+	"github.com/uyuni-project/uyuni-tools/shared/testutils"
+	"github.com/uyuni-project/uyuni-tools/shared/testutils/flagstests"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+)
+
+func connect(server *fake.Server) (*api.APIClient, error) {
+	return ctl_utils.Connect(server.ConnectionDetails())
+}
+
+func TestDeleteParamsParsing(t *testing.T) {
+	args := []string{"--cleanup", "FORCE_DELETE", "--force", "web1"}
+	args = append(args, flagstests.APIFlagsTestArgs...)
+
+	// Test function asserting that the args are properly parsed
+	tester := func(_ *types.GlobalFlags, flags *deleteFlags, _ *cobra.Command, args []string) error {
+		flagstests.AssertAPIFlags(t, &flags.ConnectionDetails)
+		testutils.AssertEquals(t, "Error parsing --cleanup", "FORCE_DELETE", flags.Cleanup)
+		testutils.AssertTrue(t, "Error parsing --force", flags.Force)
+		testutils.AssertEquals(t, "Unexpected args", []string{"web1"}, args)
+		return nil
+	}
+
+	globalFlags := types.GlobalFlags{}
+	cmd := newDeleteCmd(&globalFlags, tester)
+
+	testutils.AssertHasAllFlags(t, cmd, args)
+
+	cmd.SetArgs(args)
+	if err := cmd.Execute(); err != nil {
+		t.Errorf("command failed with error: %s", err)
+	}
+}
+
+func TestRunDelete(t *testing.T) {
+	server := newFakeServer(t)
+	defer server.Close()
+	server.Handle("GET", "system/getId", func(req *fake.Request) *fake.Response {
+		if req.Params["name"] == "web1" {
+			return fake.Success([]map[string]interface{}{{"id": 1000010001, "name": "web1"}})
+		}
+		return fake.Success([]map[string]interface{}{})
+	})
+	server.Handle("POST", "system/deleteSystems", func(_ *fake.Request) *fake.Response {
+		return fake.Success(1)
+	})
+
+	flags := deleteFlags{ConnectionDetails: *server.ConnectionDetails(), Cleanup: "NO_CLEANUP", Force: true}
+	if err := runDelete(nil, &flags, nil, []string{"web1", "1000010002"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	calls := server.CallsTo("system/deleteSystems")
+	testutils.AssertEquals(t, "Unexpected number of delete calls", 1, len(calls))
+	testutils.AssertEquals[interface{}](t, "Unexpected deleted systems",
+		[]interface{}{1000010001.0, 1000010002.0}, calls[0].Params["sids"],
+	)
+	testutils.AssertEquals[interface{}](t, "Unexpected cleanup type", "NO_CLEANUP", calls[0].Params["cleanupType"])
+
+	if err := runDelete(nil, &flags, nil, []string{"unknown"}); err == nil {
+		t.Error("expected an error for an unknown system")
+	}
+	flags.Cleanup = "invalid"
+	if err := runDelete(nil, &flags, nil, []string{"web1"}); err == nil {
+		t.Error("expected an error for an invalid cleanup type")
+	}
+	testutils.AssertEquals(t, "Systems should only be deleted once", 1, len(server.CallsTo("system/deleteSystems")))
+}
+
+func TestRunTag(t *testing.T) {
+	server := newFakeServer(t)
+	defer server.Close()
+	server.Handle("POST", "system/tagLatestSnapshot", func(_ *fake.Request) *fake.Response {
+		return fake.Success(1)
+	})
+
+	flags := tagFlags{ConnectionDetails: *server.ConnectionDetails()}
+	if err := runTag(nil, &flags, nil, []string{"before-upgrade", "1000010001", "1000010002"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	calls := server.CallsTo("system/tagLatestSnapshot")
+	testutils.AssertEquals(t, "Unexpected number of tag calls", 2, len(calls))
+	testutils.AssertEquals[interface{}](t, "Unexpected tag", "before-upgrade", calls[1].Params["tagName"])
+}
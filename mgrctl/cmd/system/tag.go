@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package system
+
+import (
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	ctl_utils "github.com/uyuni-project/uyuni-tools/mgrctl/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/system"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+type tagFlags struct {
+	ConnectionDetails api.ConnectionDetails `mapstructure:"api"`
+}
+
+func newTagCmd(globalFlags *types.GlobalFlags, run utils.CommandFunc[tagFlags]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tag tag system...",
+		Short: L("Tag the latest snapshot of systems"),
+		Long: L(`Tag the latest snapshot of systems given their ID or profile name.
+
+Example:
+# mgrctl system tag before-upgrade web1.example.com 1000010000`),
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var flags tagFlags
+			return utils.CommandHelper(globalFlags, cmd, args, &flags, nil, run)
+		},
+	}
+
+	api.AddAPIFlags(cmd)
+
+	return cmd
+}
+
+func newTagCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	return newTagCmd(globalFlags, runTag)
+}
+
+func runTag(_ *types.GlobalFlags, flags *tagFlags, _ *cobra.Command, args []string) error {
+	client, err := ctl_utils.Connect(&flags.ConnectionDetails)
+	if err != nil {
+		return err
+	}
+
+	tag := args[0]
+	sids, err := lookupIDs(client, args[1:])
+	if err != nil {
+		return err
+	}
+
+	for i, sid := range sids {
+		if err := system.TagLatestSnapshot(client, sid, tag); err != nil {
+			return err
+		}
+		log.Info().Msgf(L("Tagged latest snapshot of system %[1]s with %[2]s"), args[i+1], tag)
+	}
+	return nil
+}
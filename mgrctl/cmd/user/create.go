@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package user
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	ctl_user "github.com/uyuni-project/uyuni-tools/mgrctl/shared/user"
+	ctl_utils "github.com/uyuni-project/uyuni-tools/mgrctl/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	apiTypes "github.com/uyuni-project/uyuni-tools/shared/api/types"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+type createFlags struct {
+	ConnectionDetails api.ConnectionDetails `mapstructure:"api"`
+	apiTypes.User     `mapstructure:",squash"`
+	Pam               bool
+	Roles             []string
+	Groups            []string
+	File              string
+}
+
+func newCreateCmd(globalFlags *types.GlobalFlags, run utils.CommandFunc[createFlags]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create [login]",
+		Short: L("Create users"),
+		Long: L(`Create a user in the organization or several ones from a file.
+
+The file is either a YAML list or a CSV file with a header line.
+The fields are login, password, firstName, lastName, email, pam, roles and groups.
+In CSV files, the roles and groups are separated by semicolons.
+Existing users are skipped.
+
+YAML example:
+- login: jdoe
+  password: secret
+  firstName: Jane
+  lastName: Doe
+  email: jdoe@example.com
+  roles: [system_group_admin]
+  groups: [web]
+
+CSV example:
+login,password,firstName,lastName,email,roles,groups
+jdoe,secret,Jane,Doe,jdoe@example.com,system_group_admin,web;db`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var flags createFlags
+			return utils.CommandHelper(globalFlags, cmd, args, &flags, nil, run)
+		},
+	}
+
+	cmd.Flags().String("password", "", L("password of the user"))
+	cmd.Flags().String("firstName", "", L("first name of the user"))
+	cmd.Flags().String("lastName", "", L("last name of the user"))
+	cmd.Flags().String("email", "", L("email of the user"))
+	cmd.Flags().Bool("pam", false, L("use PAM authentication for the user"))
+	cmd.Flags().StringSlice("roles", []string{}, L("roles of the user"))
+	cmd.Flags().StringSlice("groups", []string{}, L("system groups the user can administer"))
+	cmd.Flags().StringP("file", "f", "", L("YAML or CSV file describing the users to create"))
+	api.AddAPIFlags(cmd)
+
+	return cmd
+}
+
+func newCreateCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	return newCreateCmd(globalFlags, runCreate)
+}
+
+func runCreate(_ *types.GlobalFlags, flags *createFlags, _ *cobra.Command, args []string) error {
+	var defs []ctl_user.UserDefinition
+	if flags.File != "" {
+		if len(args) > 0 {
+			return errors.New(L("the user login and --file cannot be used together"))
+		}
+		var err error
+		if defs, err = ctl_utils.ReadRecords[ctl_user.UserDefinition](flags.File); err != nil {
+			return err
+		}
+	} else {
+		if len(args) == 0 {
+			return errors.New(L("either the user login or --file is required"))
+		}
+		def := ctl_user.UserDefinition{User: flags.User, Pam: flags.Pam, Roles: flags.Roles, Groups: flags.Groups}
+		def.Login = args[0]
+		if !def.Pam {
+			utils.AskPasswordIfMissing(&def.Password, L("Password"), 5, 48)
+		}
+		defs = append(defs, def)
+	}
+
+	for _, def := range defs {
+		if def.Login == "" {
+			return errors.New(L("the user login is required"))
+		}
+		if def.Password == "" && !def.Pam {
+			return fmt.Errorf(L("missing password for user %s"), def.Login)
+		}
+	}
+
+	client, err := ctl_utils.Connect(&flags.ConnectionDetails)
+	if err != nil {
+		return err
+	}
+	return ctl_user.CreateUsers(client, defs)
+}
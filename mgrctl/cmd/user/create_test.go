@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package user
+
+import (
+	"path"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/uyuni-project/uyuni-tools/shared/api/fake"
+	"github.com/uyuni-project/uyuni-tools/shared/testutils"
+	"github.com/uyuni-project/uyuni-tools/shared/testutils/flagstests"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+)
+
+func TestCreateParamsParsing(t *testing.T) {
+	args := []string{
+		"--password", "secret",
+		"--firstName", "Jane",
+		"--lastName", "Doe",
+		"--email", "jdoe@example.com",
+		"--pam",
+		"--roles", "org_admin",
+		"--groups", "web,db",
+		"--file", "users.csv",
+		"jdoe",
+	}
+	args = append(args, flagstests.APIFlagsTestArgs...)
+
+	// Test function asserting that the args are properly parsed
+	tester := func(_ *types.GlobalFlags, flags *createFlags, _ *cobra.Command, args []string) error {
+		flagstests.AssertAPIFlags(t, &flags.ConnectionDetails)
+		testutils.AssertEquals(t, "Error parsing --password", "secret", flags.Password)
+		testutils.AssertEquals(t, "Error parsing --firstName", "Jane", flags.FirstName)
+		testutils.AssertEquals(t, "Error parsing --lastName", "Doe", flags.LastName)
+		testutils.AssertEquals(t, "Error parsing --email", "jdoe@example.com", flags.Email)
+		testutils.AssertTrue(t, "Error parsing --pam", flags.Pam)
+		testutils.AssertEquals(t, "Error parsing --roles", []string{"org_admin"}, flags.Roles)
+		testutils.AssertEquals(t, "Error parsing --groups", []string{"web", "db"}, flags.Groups)
+		testutils.AssertEquals(t, "Error parsing --file", "users.csv", flags.File)
+		testutils.AssertEquals(t, "Unexpected args", []string{"jdoe"}, args)
+		return nil
+	}
+
+	globalFlags := types.GlobalFlags{}
+	cmd := newCreateCmd(&globalFlags, tester)
+
+	testutils.AssertHasAllFlags(t, cmd, args)
+
+	cmd.SetArgs(args)
+	if err := cmd.Execute(); err != nil {
+		t.Errorf("command failed with error: %s", err)
+	}
+}
+
+func TestRunCreateFromFile(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	server := fake.NewServer("admin", "secret")
+	defer server.Close()
+
+	server.Handle("GET", "user/listUsers", func(_ *fake.Request) *fake.Response {
+		return fake.Success([]map[string]interface{}{{"id": 1, "login": "admin", "enabled": true}})
+	})
+	for _, path := range []string{"user/create", "user/addRole", "user/addAssignedSystemGroups"} {
+		server.Handle("POST", path, func(_ *fake.Request) *fake.Response {
+			return fake.Success(1)
+		})
+	}
+
+	file := path.Join(t.TempDir(), "users.csv")
+	testutils.WriteFile(t, file, `login,password,firstName,lastName,email,roles,groups
+admin,secret,Admin,Admin,admin@example.com,,
+jdoe,secret,Jane,Doe,jdoe@example.com,org_admin;channel_admin,web;db
+`)
+
+	flags := createFlags{ConnectionDetails: *server.ConnectionDetails(), File: file}
+	if err := runCreate(nil, &flags, nil, []string{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	calls := server.CallsTo("user/create")
+	testutils.AssertEquals(t, "Existing users should be skipped", 1, len(calls))
+	testutils.AssertEquals[interface{}](t, "Unexpected login", "jdoe", calls[0].Params["login"])
+	testutils.AssertEquals[interface{}](t, "Unexpected first name", "Jane", calls[0].Params["firstName"])
+	testutils.AssertEquals(t, "Unexpected roles count", 2, len(server.CallsTo("user/addRole")))
+	groups := server.CallsTo("user/addAssignedSystemGroups")
+	testutils.AssertEquals(t, "Unexpected groups calls count", 1, len(groups))
+	testutils.AssertEquals[interface{}](t, "Unexpected groups", []interface{}{"web", "db"}, groups[0].Params["sgNames"])
+
+	testutils.WriteFile(t, file, "login,firstName\nnopassword,No\n")
+	testutils.AssertTrue(t, "Missing passwords should fail", runCreate(nil, &flags, nil, []string{}) != nil)
+}
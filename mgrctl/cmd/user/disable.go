@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package user
+
+import (
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	ctl_utils "github.com/uyuni-project/uyuni-tools/mgrctl/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/user"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+type disableFlags struct {
+	ConnectionDetails api.ConnectionDetails `mapstructure:"api"`
+}
+
+func newDisableCmd(globalFlags *types.GlobalFlags, run utils.CommandFunc[disableFlags]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "disable login...",
+		Short: L("Disable users"),
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var flags disableFlags
+			return utils.CommandHelper(globalFlags, cmd, args, &flags, nil, run)
+		},
+	}
+
+	api.AddAPIFlags(cmd)
+
+	return cmd
+}
+
+func newDisableCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	return newDisableCmd(globalFlags, runDisable)
+}
+
+func runDisable(_ *types.GlobalFlags, flags *disableFlags, _ *cobra.Command, args []string) error {
+	client, err := ctl_utils.Connect(&flags.ConnectionDetails)
+	if err != nil {
+		return err
+	}
+
+	for _, login := range args {
+		if err := user.Disable(client, login); err != nil {
+			return err
+		}
+		log.Info().Msgf(L("Disabled user %s"), login)
+	}
+	return nil
+}
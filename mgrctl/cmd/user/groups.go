@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package user
+
+import (
+	"github.com/spf13/cobra"
+	ctl_utils "github.com/uyuni-project/uyuni-tools/mgrctl/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared/api/user"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+func newGroupsCmd(globalFlags *types.GlobalFlags, run utils.CommandFunc[membershipFlags]) *cobra.Command {
+	return newMembershipCmd(globalFlags, "groups", L("Manage the system groups a user can administer"),
+		L("system groups to add to the user"), L("system groups to remove from the user"), run,
+	)
+}
+
+func newGroupsCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	return newGroupsCmd(globalFlags, runGroups)
+}
+
+func runGroups(_ *types.GlobalFlags, flags *membershipFlags, _ *cobra.Command, args []string) error {
+	client, err := ctl_utils.Connect(&flags.ConnectionDetails)
+	if err != nil {
+		return err
+	}
+	login := args[0]
+
+	if len(flags.Remove) > 0 {
+		if err := user.RemoveAssignedSystemGroups(client, login, flags.Remove); err != nil {
+			return err
+		}
+	}
+	if len(flags.Add) > 0 {
+		if err := user.AddAssignedSystemGroups(client, login, flags.Add); err != nil {
+			return err
+		}
+	}
+
+	groups, err := user.ListAssignedSystemGroups(client, login)
+	if err != nil {
+		return err
+	}
+	names := []string{}
+	for _, group := range groups {
+		names = append(names, group.Name)
+	}
+	return printNames(flags.Output, L("GROUP"), names)
+}
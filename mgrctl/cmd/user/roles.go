@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package user
+
+import (
+	"os"
+	"sort"
+
+	"github.com/spf13/cobra"
+	ctl_utils "github.com/uyuni-project/uyuni-tools/mgrctl/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/user"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+// membershipFlags are the flags of the commands managing the roles and groups of a user.
+type membershipFlags struct {
+	ConnectionDetails api.ConnectionDetails `mapstructure:"api"`
+	Add               []string
+	Remove            []string
+	Output            string
+}
+
+func newMembershipCmd(
+	globalFlags *types.GlobalFlags,
+	use string,
+	short string,
+	addUsage string,
+	removeUsage string,
+	run utils.CommandFunc[membershipFlags],
+) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use + " login",
+		Short: short,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var flags membershipFlags
+			return utils.CommandHelper(globalFlags, cmd, args, &flags, nil, run)
+		},
+	}
+
+	cmd.Flags().StringSlice("add", []string{}, addUsage)
+	cmd.Flags().StringSlice("remove", []string{}, removeUsage)
+	ctl_utils.AddOutputFlag(cmd)
+	api.AddAPIFlags(cmd)
+
+	return cmd
+}
+
+func newRolesCmd(globalFlags *types.GlobalFlags, run utils.CommandFunc[membershipFlags]) *cobra.Command {
+	return newMembershipCmd(globalFlags, "roles", L("Manage the roles of a user"),
+		L("roles to add to the user"), L("roles to remove from the user"), run,
+	)
+}
+
+func newRolesCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	return newRolesCmd(globalFlags, runRoles)
+}
+
+func runRoles(_ *types.GlobalFlags, flags *membershipFlags, _ *cobra.Command, args []string) error {
+	client, err := ctl_utils.Connect(&flags.ConnectionDetails)
+	if err != nil {
+		return err
+	}
+	login := args[0]
+
+	for _, role := range flags.Remove {
+		if err := user.RemoveRole(client, login, role); err != nil {
+			return err
+		}
+	}
+	for _, role := range flags.Add {
+		if err := user.AddRole(client, login, role); err != nil {
+			return err
+		}
+	}
+
+	roles, err := user.ListRoles(client, login)
+	if err != nil {
+		return err
+	}
+	return printNames(flags.Output, L("ROLE"), roles)
+}
+
+// printNames prints a list of names as a one column table or JSON.
+func printNames(format string, header string, names []string) error {
+	sort.Strings(names)
+	return ctl_utils.PrintOutput(os.Stdout, format, names, func() *ctl_utils.Table {
+		table := ctl_utils.Table{Headers: []string{header}}
+		for _, name := range names {
+			table.AddRow(name)
+		}
+		return &table
+	})
+}
@@ -0,0 +1,30 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package user
+
+import (
+	"github.com/spf13/cobra"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+)
+
+// NewCommand entry command for managing the users.
+func NewCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	userCmd := &cobra.Command{
+		Use:   "user",
+		Short: L("Manage the users of the organization"),
+		Run: func(cmd *cobra.Command, _ []string) {
+			_ = cmd.Help()
+		},
+	}
+
+	userCmd.AddCommand(newListCommand(globalFlags))
+	userCmd.AddCommand(newCreateCommand(globalFlags))
+	userCmd.AddCommand(newDisableCommand(globalFlags))
+	userCmd.AddCommand(newRolesCommand(globalFlags))
+	userCmd.AddCommand(newGroupsCommand(globalFlags))
+
+	return userCmd
+}
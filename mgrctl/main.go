@@ -5,10 +5,12 @@
 package main
 
 import (
+	"errors"
 	"os"
 
 	"github.com/chai2010/gettext-go"
 	"github.com/uyuni-project/uyuni-tools/mgrctl/cmd"
+	ctl_utils "github.com/uyuni-project/uyuni-tools/mgrctl/shared/utils"
 	l10n_utils "github.com/uyuni-project/uyuni-tools/shared/l10n/utils"
 	"github.com/uyuni-project/uyuni-tools/shared/utils"
 )
@@ -23,6 +25,10 @@ func Run() error {
 
 func main() {
 	if err := Run(); err != nil {
+		var exitErr *ctl_utils.ExitCodeError
+		if errors.As(err, &exitErr) {
+			os.Exit(exitErr.Code)
+		}
 		os.Exit(1)
 	}
 }
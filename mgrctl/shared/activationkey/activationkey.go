@@ -0,0 +1,31 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package activationkey
+
+import (
+	"regexp"
+
+	apiTypes "github.com/uyuni-project/uyuni-tools/shared/api/types"
+)
+
+// orgPrefixRegex matches the organization ID prefix the server adds to the keys.
+var orgPrefixRegex = regexp.MustCompile(`^[0-9]+-`)
+
+// StripOrgPrefix removes the organization ID prefix from a key.
+func StripOrgPrefix(key string) string {
+	return orgPrefixRegex.ReplaceAllString(key, "")
+}
+
+// FindKey looks for a key given with or without the organization ID prefix.
+//
+// returns nil if there is no such key.
+func FindKey(keys []apiTypes.ActivationKey, key string) *apiTypes.ActivationKey {
+	for i, candidate := range keys {
+		if candidate.Key == key || StripOrgPrefix(candidate.Key) == key {
+			return &keys[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,368 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package activationkey
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/rs/zerolog/log"
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/activationkey"
+	"github.com/uyuni-project/uyuni-tools/shared/api/systemgroup"
+	apiTypes "github.com/uyuni-project/uyuni-tools/shared/api/types"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+// KeyDefinition is the full definition of an activation key as exported to YAML.
+//
+// The key is stored without the organization prefix and the groups by name
+// to be able to import it on another server.
+type KeyDefinition struct {
+	Key              string                 `yaml:"key"`
+	Description      string                 `yaml:"description,omitempty"`
+	BaseChannel      string                 `yaml:"baseChannel,omitempty"`
+	ChildChannels    []string               `yaml:"childChannels,omitempty"`
+	ConfigChannels   []string               `yaml:"configChannels,omitempty"`
+	Groups           []string               `yaml:"groups,omitempty"`
+	Entitlements     []string               `yaml:"entitlements,omitempty"`
+	Packages         []apiTypes.PackageArch `yaml:"packages,omitempty"`
+	UsageLimit       int                    `yaml:"usageLimit,omitempty"`
+	UniversalDefault bool                   `yaml:"universalDefault,omitempty"`
+	Disabled         bool                   `yaml:"disabled,omitempty"`
+	ContactMethod    string                 `yaml:"contactMethod,omitempty"`
+}
+
+// GetGroups returns the system groups indexed by ID.
+func GetGroups(client *api.APIClient) (map[int]string, error) {
+	groups, err := systemgroup.ListAllGroups(client)
+	if err != nil {
+		return nil, err
+	}
+	names := map[int]string{}
+	for _, group := range groups {
+		names[group.ID] = group.Name
+	}
+	return names, nil
+}
+
+// ExportKey computes the definition of an existing key.
+//
+// groups are the system group names indexed by ID as returned by GetGroups.
+func ExportKey(client *api.APIClient, key *apiTypes.ActivationKey, groups map[int]string) (*KeyDefinition, error) {
+	def := KeyDefinition{
+		Key:              StripOrgPrefix(key.Key),
+		Description:      key.Description,
+		ChildChannels:    sorted(key.ChildChannelLabels),
+		Entitlements:     sorted(key.Entitlements),
+		Packages:         key.Packages,
+		UsageLimit:       key.UsageLimit,
+		UniversalDefault: key.UniversalDefault,
+		Disabled:         key.Disabled,
+		ContactMethod:    key.ContactMethod,
+	}
+	if key.BaseChannelLabel != activationkey.NoBaseChannel {
+		def.BaseChannel = key.BaseChannelLabel
+	}
+
+	for _, id := range key.ServerGroupIDs {
+		name, ok := groups[id]
+		if !ok {
+			return nil, fmt.Errorf(L("no system group with ID %[1]d for activation key %[2]s"), id, key.Key)
+		}
+		def.Groups = append(def.Groups, name)
+	}
+	sort.Strings(def.Groups)
+
+	configChannels, err := activationkey.ListConfigChannels(client, key.Key)
+	if err != nil {
+		return nil, err
+	}
+	for _, configChannel := range configChannels {
+		def.ConfigChannels = append(def.ConfigChannels, configChannel.Label)
+	}
+	return &def, nil
+}
+
+// importKey creates or updates the key to match the definition.
+//
+// keys are the existing keys and groups the system group names indexed by ID.
+// returns whether the key has been changed.
+func importKey(
+	client *api.APIClient,
+	def *KeyDefinition,
+	keys []apiTypes.ActivationKey,
+	groups map[int]string,
+) (bool, error) {
+	// Like the create command, default to the key as description
+	if def.Description == "" {
+		def.Description = def.Key
+	}
+
+	groupIDs := []int{}
+	for _, name := range def.Groups {
+		id := -1
+		for groupID, groupName := range groups {
+			if groupName == name {
+				id = groupID
+			}
+		}
+		if id < 0 {
+			return false, fmt.Errorf(L("no system group named %s"), name)
+		}
+		groupIDs = append(groupIDs, id)
+	}
+
+	changed := false
+	existing := FindKey(keys, def.Key)
+	var key string
+	if existing == nil {
+		var err error
+		key, err = activationkey.Create(client, def.Key, def.Description, def.BaseChannel, def.UsageLimit,
+			def.Entitlements, def.UniversalDefault,
+		)
+		if err != nil {
+			return false, err
+		}
+		log.Info().Msgf(L("Created activation key %s"), key)
+		changed = true
+	} else {
+		key = existing.Key
+	}
+
+	current, err := activationkey.GetDetails(client, key)
+	if err != nil {
+		return false, err
+	}
+
+	if details := detailsChanges(current, def); len(details) > 0 {
+		if err := activationkey.SetDetails(client, key, details); err != nil {
+			return false, err
+		}
+		changed = true
+		// Changing the base channel may change the child channels
+		if current, err = activationkey.GetDetails(client, key); err != nil {
+			return false, err
+		}
+	}
+
+	added, removed := diff(current.ChildChannelLabels, def.ChildChannels)
+	if len(removed) > 0 {
+		if err := activationkey.RemoveChildChannels(client, key, removed); err != nil {
+			return false, err
+		}
+	}
+	if len(added) > 0 {
+		if err := activationkey.AddChildChannels(client, key, added); err != nil {
+			return false, err
+		}
+	}
+	changed = changed || len(added)+len(removed) > 0
+
+	added, removed = diff(current.Entitlements, def.Entitlements)
+	if len(removed) > 0 {
+		if err := activationkey.RemoveEntitlements(client, key, removed); err != nil {
+			return false, err
+		}
+	}
+	if len(added) > 0 {
+		if err := activationkey.AddEntitlements(client, key, added); err != nil {
+			return false, err
+		}
+	}
+	changed = changed || len(added)+len(removed) > 0
+
+	addedGroups, removedGroups := diff(current.ServerGroupIDs, groupIDs)
+	if len(removedGroups) > 0 {
+		if err := activationkey.RemoveServerGroups(client, key, removedGroups); err != nil {
+			return false, err
+		}
+	}
+	if len(addedGroups) > 0 {
+		if err := activationkey.AddServerGroups(client, key, addedGroups); err != nil {
+			return false, err
+		}
+	}
+	changed = changed || len(addedGroups)+len(removedGroups) > 0
+
+	addedPackages, removedPackages := diff(current.Packages, def.Packages)
+	if len(removedPackages) > 0 {
+		if err := activationkey.RemovePackages(client, key, removedPackages); err != nil {
+			return false, err
+		}
+	}
+	if len(addedPackages) > 0 {
+		if err := activationkey.AddPackages(client, key, addedPackages); err != nil {
+			return false, err
+		}
+	}
+	changed = changed || len(addedPackages)+len(removedPackages) > 0
+
+	configChannels, err := activationkey.ListConfigChannels(client, key)
+	if err != nil {
+		return false, err
+	}
+	labels := []string{}
+	for _, configChannel := range configChannels {
+		labels = append(labels, configChannel.Label)
+	}
+	wanted := def.ConfigChannels
+	if wanted == nil {
+		wanted = []string{}
+	}
+	// The order of the configuration channels matters
+	if !reflect.DeepEqual(labels, wanted) {
+		if err := activationkey.SetConfigChannels(client, key, wanted); err != nil {
+			return false, err
+		}
+		changed = true
+	}
+
+	return changed, nil
+}
+
+// ImportKeys creates or updates the activation keys to match their definitions.
+func ImportKeys(client *api.APIClient, defs []*KeyDefinition) error {
+	keys, err := activationkey.ListActivationKeys(client)
+	if err != nil {
+		return err
+	}
+	groups, err := GetGroups(client)
+	if err != nil {
+		return err
+	}
+
+	for _, def := range defs {
+		changed, err := importKey(client, def, keys, groups)
+		if err != nil {
+			return utils.Errorf(err, L("failed to import activation key %s"), def.Key)
+		}
+		if changed {
+			log.Info().Msgf(L("Activation key %s updated"), def.Key)
+		} else {
+			log.Info().Msgf(L("Activation key %s is up to date"), def.Key)
+		}
+	}
+	return nil
+}
+
+// PlanKeys computes which activation keys ImportKeys would create or update without changing them.
+func PlanKeys(client *api.APIClient, defs []*KeyDefinition) (created []string, updated []string, err error) {
+	keys, err := activationkey.ListActivationKeys(client)
+	if err != nil {
+		return nil, nil, err
+	}
+	groups, err := GetGroups(client)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, def := range defs {
+		key := FindKey(keys, def.Key)
+		if key == nil {
+			created = append(created, def.Key)
+			continue
+		}
+		current, err := ExportKey(client, key, groups)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !reflect.DeepEqual(normalize(current), normalize(def)) {
+			updated = append(updated, def.Key)
+		}
+	}
+	return created, updated, nil
+}
+
+// normalize returns a copy of the definition with the default values set and the unordered lists sorted.
+//
+// The configuration channels are kept in order since it matters.
+func normalize(def *KeyDefinition) KeyDefinition {
+	result := *def
+	if result.Description == "" {
+		result.Description = result.Key
+	}
+	if result.ContactMethod == "" {
+		result.ContactMethod = "default"
+	}
+	result.ChildChannels = sorted(result.ChildChannels)
+	result.Groups = sorted(result.Groups)
+	result.Entitlements = sorted(result.Entitlements)
+	result.ConfigChannels = append([]string{}, result.ConfigChannels...)
+	result.Packages = append([]apiTypes.PackageArch{}, result.Packages...)
+	sort.Slice(result.Packages, func(i, j int) bool {
+		if result.Packages[i].Name != result.Packages[j].Name {
+			return result.Packages[i].Name < result.Packages[j].Name
+		}
+		return result.Packages[i].Arch < result.Packages[j].Arch
+	})
+	return result
+}
+
+// detailsChanges computes the details to pass to activationkey.setDetails.
+//
+// returns an empty map if there is no change.
+func detailsChanges(current *apiTypes.ActivationKey, def *KeyDefinition) map[string]interface{} {
+	baseChannel := def.BaseChannel
+	if baseChannel == "" {
+		baseChannel = activationkey.NoBaseChannel
+	}
+	contactMethod := def.ContactMethod
+	if contactMethod == "" {
+		contactMethod = "default"
+	}
+
+	if current.Description == def.Description && current.BaseChannelLabel == baseChannel &&
+		current.UsageLimit == def.UsageLimit && current.UniversalDefault == def.UniversalDefault &&
+		current.Disabled == def.Disabled && current.ContactMethod == contactMethod {
+		return map[string]interface{}{}
+	}
+
+	details := map[string]interface{}{
+		"description":        def.Description,
+		"base_channel_label": def.BaseChannel,
+		"universal_default":  def.UniversalDefault,
+		"disabled":           def.Disabled,
+		"contact_method":     contactMethod,
+	}
+	if def.UsageLimit > 0 {
+		details["usage_limit"] = def.UsageLimit
+	} else {
+		details["unlimited_usage_limit"] = true
+	}
+	return details
+}
+
+// diff computes the values to add and to remove to get the wanted ones.
+func diff[T comparable](current []T, wanted []T) (added []T, removed []T) {
+	for _, value := range wanted {
+		if !contains(current, value) {
+			added = append(added, value)
+		}
+	}
+	for _, value := range current {
+		if !contains(wanted, value) {
+			removed = append(removed, value)
+		}
+	}
+	return
+}
+
+func contains[T comparable](values []T, needle T) bool {
+	for _, value := range values {
+		if value == needle {
+			return true
+		}
+	}
+	return false
+}
+
+func sorted(values []string) []string {
+	result := append([]string{}, values...)
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,260 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package activationkey
+
+import (
+	"strings"
+	"testing"
+
+	ctl_utils "github.com/uyuni-project/uyuni-tools/mgrctl/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared/api/fake"
+	apiTypes "github.com/uyuni-project/uyuni-tools/shared/api/types"
+	"github.com/uyuni-project/uyuni-tools/shared/testutils"
+	"gopkg.in/yaml.v2"
+)
+
+const webKeyDefinition = `key: web
+description: Web servers
+baseChannel: sles15-sp6-pool-x86_64
+childChannels:
+- sles15-sp6-updates-x86_64
+configChannels:
+- webconfig
+- common
+groups:
+- web
+entitlements:
+- monitoring_entitled
+packages:
+- name: nginx
+  arch: x86_64
+- name: vim
+usageLimit: 10
+contactMethod: default
+`
+
+// keysServer is a fake server storing activation keys.
+type keysServer struct {
+	*fake.Server
+	keys           map[string]*apiTypes.ActivationKey
+	configChannels map[string][]string
+}
+
+func toStrings(value interface{}) []string {
+	result := []string{}
+	for _, item := range value.([]interface{}) {
+		result = append(result, item.(string))
+	}
+	return result
+}
+
+func toInts(value interface{}) []int {
+	result := []int{}
+	for _, item := range value.([]interface{}) {
+		result = append(result, int(item.(float64)))
+	}
+	return result
+}
+
+func toPackages(value interface{}) []apiTypes.PackageArch {
+	result := []apiTypes.PackageArch{}
+	for _, item := range value.([]interface{}) {
+		pkg := item.(map[string]interface{})
+		arch, _ := pkg["arch"].(string)
+		result = append(result, apiTypes.PackageArch{Name: pkg["name"].(string), Arch: arch})
+	}
+	return result
+}
+
+func remove[T comparable](values []T, removed []T) []T {
+	result := []T{}
+	for _, value := range values {
+		if !contains(removed, value) {
+			result = append(result, value)
+		}
+	}
+	return result
+}
+
+func newKeysServer(t *testing.T) *keysServer {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	s := &keysServer{
+		Server:         fake.NewServer("admin", "secret"),
+		keys:           map[string]*apiTypes.ActivationKey{},
+		configChannels: map[string][]string{},
+	}
+
+	s.Handle("GET", "systemgroup/listAllGroups", func(_ *fake.Request) *fake.Response {
+		return fake.Success([]apiTypes.SystemGroup{{ID: 5, Name: "web"}, {ID: 6, Name: "db"}})
+	})
+	s.Handle("GET", "activationkey/listActivationKeys", func(_ *fake.Request) *fake.Response {
+		keys := []apiTypes.ActivationKey{}
+		for _, key := range s.keys {
+			keys = append(keys, *key)
+		}
+		return fake.Success(keys)
+	})
+	s.Handle("GET", "activationkey/getDetails", func(req *fake.Request) *fake.Response {
+		return fake.Success(s.keys[req.Params["key"].(string)])
+	})
+	s.Handle("GET", "activationkey/listConfigChannels", func(req *fake.Request) *fake.Response {
+		channels := []apiTypes.ConfigChannel{}
+		for _, label := range s.configChannels[req.Params["key"].(string)] {
+			channels = append(channels, apiTypes.ConfigChannel{Label: label})
+		}
+		return fake.Success(channels)
+	})
+	s.Handle("POST", "activationkey/create", func(req *fake.Request) *fake.Response {
+		key := "1-" + req.Params["key"].(string)
+		s.keys[key] = &apiTypes.ActivationKey{
+			Key:              key,
+			Description:      req.Params["description"].(string),
+			BaseChannelLabel: req.Params["baseChannelLabel"].(string),
+			Entitlements:     toStrings(req.Params["entitlements"]),
+			ContactMethod:    "default",
+		}
+		if limit, ok := req.Params["usageLimit"].(float64); ok {
+			s.keys[key].UsageLimit = int(limit)
+		}
+		return fake.Success(key)
+	})
+	s.Handle("POST", "activationkey/setDetails", func(req *fake.Request) *fake.Response {
+		key := s.keys[req.Params["key"].(string)]
+		details := req.Params["details"].(map[string]interface{})
+		key.Description = details["description"].(string)
+		key.BaseChannelLabel = details["base_channel_label"].(string)
+		if key.BaseChannelLabel == "" {
+			key.BaseChannelLabel = "none"
+		}
+		if limit, ok := details["usage_limit"].(float64); ok {
+			key.UsageLimit = int(limit)
+		} else {
+			key.UsageLimit = 0
+		}
+		key.ContactMethod = details["contact_method"].(string)
+		return fake.Success(1)
+	})
+	s.Handle("POST", "activationkey/addChildChannels", func(req *fake.Request) *fake.Response {
+		key := s.keys[req.Params["key"].(string)]
+		key.ChildChannelLabels = append(key.ChildChannelLabels, toStrings(req.Params["childChannelLabels"])...)
+		return fake.Success(1)
+	})
+	s.Handle("POST", "activationkey/removeChildChannels", func(req *fake.Request) *fake.Response {
+		key := s.keys[req.Params["key"].(string)]
+		key.ChildChannelLabels = remove(key.ChildChannelLabels, toStrings(req.Params["childChannelLabels"]))
+		return fake.Success(1)
+	})
+	s.Handle("POST", "activationkey/removeEntitlements", func(req *fake.Request) *fake.Response {
+		key := s.keys[req.Params["key"].(string)]
+		key.Entitlements = remove(key.Entitlements, toStrings(req.Params["entitlements"]))
+		return fake.Success(1)
+	})
+	s.Handle("POST", "activationkey/addServerGroups", func(req *fake.Request) *fake.Response {
+		key := s.keys[req.Params["key"].(string)]
+		key.ServerGroupIDs = append(key.ServerGroupIDs, toInts(req.Params["serverGroupIds"])...)
+		return fake.Success(1)
+	})
+	s.Handle("POST", "activationkey/removeServerGroups", func(req *fake.Request) *fake.Response {
+		key := s.keys[req.Params["key"].(string)]
+		key.ServerGroupIDs = remove(key.ServerGroupIDs, toInts(req.Params["serverGroupIds"]))
+		return fake.Success(1)
+	})
+	s.Handle("POST", "activationkey/addPackages", func(req *fake.Request) *fake.Response {
+		key := s.keys[req.Params["key"].(string)]
+		key.Packages = append(key.Packages, toPackages(req.Params["packages"])...)
+		return fake.Success(1)
+	})
+	s.Handle("POST", "activationkey/removePackages", func(req *fake.Request) *fake.Response {
+		key := s.keys[req.Params["key"].(string)]
+		key.Packages = remove(key.Packages, toPackages(req.Params["packages"]))
+		return fake.Success(1)
+	})
+	s.Handle("POST", "activationkey/setConfigChannels", func(req *fake.Request) *fake.Response {
+		for _, key := range toStrings(req.Params["keys"]) {
+			s.configChannels[key] = toStrings(req.Params["configChannelLabels"])
+		}
+		return fake.Success(1)
+	})
+	return s
+}
+
+func TestImportExportKey(t *testing.T) {
+	server := newKeysServer(t)
+	defer server.Close()
+
+	client, err := ctl_utils.Connect(server.ConnectionDetails())
+	if err != nil {
+		t.Fatalf("failed to connect: %s", err)
+	}
+
+	var webKey KeyDefinition
+	if err := yaml.UnmarshalStrict([]byte(webKeyDefinition), &webKey); err != nil {
+		t.Fatalf("failed to parse the definition: %s", err)
+	}
+	defs := []*KeyDefinition{&webKey}
+	created, _, err := PlanKeys(client, defs)
+	if err != nil {
+		t.Fatalf("failed to plan: %s", err)
+	}
+	testutils.AssertEquals(t, "Unexpected planned creation", []string{"web"}, created)
+
+	if err := ImportKeys(client, defs); err != nil {
+		t.Fatalf("failed to import: %s", err)
+	}
+	testutils.AssertEquals(t, "Unexpected config channels", []string{"webconfig", "common"},
+		server.configChannels["1-web"],
+	)
+
+	// Importing again should not change anything
+	calls := len(server.Calls())
+	keys := []apiTypes.ActivationKey{*server.keys["1-web"]}
+	groups := map[int]string{5: "web", 6: "db"}
+	changed, err := importKey(client, defs[0], keys, groups)
+	if err != nil {
+		t.Fatalf("failed to import again: %s", err)
+	}
+	testutils.AssertTrue(t, "Importing the same definition should not change the key", !changed)
+	created, updated, err := PlanKeys(client, defs)
+	if err != nil {
+		t.Fatalf("failed to plan: %s", err)
+	}
+	testutils.AssertEquals(t, "No planned change expected", 0, len(created)+len(updated))
+	for _, call := range server.Calls()[calls:] {
+		testutils.AssertEquals(t, "No update call expected: "+call.Path, "GET", call.Method)
+	}
+
+	exported, err := ExportKey(client, server.keys["1-web"], groups)
+	if err != nil {
+		t.Fatalf("failed to export: %s", err)
+	}
+	out, err := yaml.Marshal(exported)
+	if err != nil {
+		t.Fatalf("failed to write the exported definition: %s", err)
+	}
+	testutils.AssertEquals(t, "Unexpected exported definition", webKeyDefinition, string(out))
+
+	_, err = ExportKey(client, server.keys["1-web"], map[int]string{6: "db"})
+	testutils.AssertTrue(t, "Exporting a key with an unknown group should fail",
+		err != nil && strings.Contains(err.Error(), "5"),
+	)
+
+	// Update the key with a smaller definition
+	def := KeyDefinition{Key: "web", Description: "Web", Packages: []apiTypes.PackageArch{{Name: "vim"}}}
+	_, updated, err = PlanKeys(client, []*KeyDefinition{&def})
+	if err != nil {
+		t.Fatalf("failed to plan: %s", err)
+	}
+	testutils.AssertEquals(t, "Unexpected planned update", []string{"web"}, updated)
+	if _, err := importKey(client, &def, keys, groups); err != nil {
+		t.Fatalf("failed to update: %s", err)
+	}
+	key := server.keys["1-web"]
+	testutils.AssertEquals(t, "Unexpected base channel", "none", key.BaseChannelLabel)
+	testutils.AssertEquals(t, "Unexpected child channels", []string{}, key.ChildChannelLabels)
+	testutils.AssertEquals(t, "Unexpected entitlements", []string{}, key.Entitlements)
+	testutils.AssertEquals(t, "Unexpected groups", []int{}, key.ServerGroupIDs)
+	testutils.AssertEquals(t, "Unexpected packages", []apiTypes.PackageArch{{Name: "vim"}}, key.Packages)
+	testutils.AssertEquals(t, "Unexpected config channels", []string{}, server.configChannels["1-web"])
+}
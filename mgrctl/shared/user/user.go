@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package user
+
+import (
+	"github.com/rs/zerolog/log"
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	apiTypes "github.com/uyuni-project/uyuni-tools/shared/api/types"
+	"github.com/uyuni-project/uyuni-tools/shared/api/user"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+)
+
+// UserDefinition describes a user to create with its roles and system groups.
+type UserDefinition struct {
+	apiTypes.User `yaml:",inline"`
+	Pam           bool     `yaml:"pam,omitempty"`
+	Roles         []string `yaml:"roles,omitempty"`
+	Groups        []string `yaml:"groups,omitempty"`
+}
+
+// CreateUsers creates the users with their roles and system groups, skipping the existing ones.
+func CreateUsers(client *api.APIClient, defs []UserDefinition) error {
+	existing, err := user.ListUsers(client)
+	if err != nil {
+		return err
+	}
+	logins := map[string]bool{}
+	for _, u := range existing {
+		logins[u.Login] = true
+	}
+
+	for _, def := range defs {
+		if logins[def.Login] {
+			log.Info().Msgf(L("User %s already exists, skipping"), def.Login)
+			continue
+		}
+		if err := CreateUser(client, &def); err != nil {
+			return err
+		}
+		logins[def.Login] = true
+		log.Info().Msgf(L("Created user %s"), def.Login)
+	}
+	return nil
+}
+
+// CreateUser creates a user with its roles and system groups.
+func CreateUser(client *api.APIClient, def *UserDefinition) error {
+	if err := user.Create(client, &def.User, def.Pam); err != nil {
+		return err
+	}
+	return AddRolesAndGroups(client, def.Login, def.Roles, def.Groups)
+}
+
+// AddRolesAndGroups adds roles and system groups to an existing user.
+func AddRolesAndGroups(client *api.APIClient, login string, roles []string, groups []string) error {
+	for _, role := range roles {
+		if err := user.AddRole(client, login, role); err != nil {
+			return err
+		}
+	}
+	if len(groups) > 0 {
+		return user.AddAssignedSystemGroups(client, login, groups)
+	}
+	return nil
+}
@@ -0,0 +1,23 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+// Connect returns an API client logged in the server.
+func Connect(conn *api.ConnectionDetails) (*api.APIClient, error) {
+	client, err := api.Init(conn)
+	if err == nil {
+		err = client.Login()
+	}
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to connect to the server"))
+	}
+	return client, nil
+}
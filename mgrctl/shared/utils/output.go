@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+)
+
+// Output formats.
+const (
+	TableOutput = "table"
+	JSONOutput  = "json"
+	CSVOutput   = "csv"
+)
+
+// Table is a tabular representation of data.
+type Table struct {
+	Headers []string
+	Rows    [][]string
+}
+
+// AddRow appends a row to the table.
+func (t *Table) AddRow(values ...string) {
+	t.Rows = append(t.Rows, values)
+}
+
+// AddOutputFlag adds the --output flag to a command.
+func AddOutputFlag(cmd *cobra.Command) {
+	cmd.Flags().StringP("output", "o", TableOutput,
+		fmt.Sprintf(L("output format, one of %s"), strings.Join([]string{TableOutput, JSONOutput, CSVOutput}, ", ")),
+	)
+}
+
+// PrintOutput writes the data in the requested format.
+//
+// The table is only computed if needed: the CSV output uses it too.
+func PrintOutput(w io.Writer, format string, data interface{}, table func() *Table) error {
+	switch format {
+	case JSONOutput:
+		out, err := json.MarshalIndent(data, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w, string(out))
+		return err
+	case TableOutput, "":
+		return PrintTable(w, table())
+	case CSVOutput:
+		return printCSV(w, table())
+	}
+	return fmt.Errorf(L("unsupported output format: %s"), format)
+}
+
+// PrintTable writes the table with aligned columns.
+func PrintTable(w io.Writer, table *Table) error {
+	writer := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	if len(table.Headers) > 0 {
+		if _, err := fmt.Fprintln(writer, strings.Join(table.Headers, "\t")); err != nil {
+			return err
+		}
+	}
+	for _, row := range table.Rows {
+		if _, err := fmt.Fprintln(writer, strings.Join(row, "\t")); err != nil {
+			return err
+		}
+	}
+	return writer.Flush()
+}
+
+func printCSV(w io.Writer, table *Table) error {
+	writer := csv.NewWriter(w)
+	if len(table.Headers) > 0 {
+		if err := writer.Write(table.Headers); err != nil {
+			return err
+		}
+	}
+	return writer.WriteAll(table.Rows)
+}
+
+// FormatTime formats a date for the tables using the local time zone.
+func FormatTime(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+	return t.Local().Format("2006-01-02 15:04:05")
+}
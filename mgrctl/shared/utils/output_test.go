@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/uyuni-project/uyuni-tools/shared/testutils"
+)
+
+func TestPrintOutput(t *testing.T) {
+	data := []map[string]interface{}{{"id": 1, "name": "web1"}}
+	table := func() *Table {
+		return &Table{
+			Headers: []string{"ID", "NAME"},
+			Rows:    [][]string{{"1000010001", "web1"}},
+		}
+	}
+
+	var out bytes.Buffer
+	if err := PrintOutput(&out, TableOutput, data, table); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	testutils.AssertEquals(t, "Unexpected table", "ID          NAME\n1000010001  web1\n", out.String())
+
+	out.Reset()
+	if err := PrintOutput(&out, JSONOutput, data, table); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expected := "[\n  {\n    \"id\": 1,\n    \"name\": \"web1\"\n  }\n]\n"
+	testutils.AssertEquals(t, "Unexpected JSON", expected, out.String())
+
+	out.Reset()
+	if err := PrintOutput(&out, CSVOutput, data, table); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	testutils.AssertEquals(t, "Unexpected CSV", "ID,NAME\n1000010001,web1\n", out.String())
+
+	testutils.AssertTrue(t, "Unsupported format should fail", PrintOutput(&out, "xml", data, table) != nil)
+}
@@ -0,0 +1,146 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+	"gopkg.in/yaml.v2"
+)
+
+// CSVListSeparator separates the items of list values in CSV files.
+const CSVListSeparator = ";"
+
+// ReadRecords reads a list of records from a YAML or a CSV file.
+//
+// Files with the .csv extension are read as CSV, with a header line naming the columns
+// like the yaml tags of the record fields. List values are separated by CSVListSeparator.
+// Other files are read as a YAML list of records.
+func ReadRecords[T interface{}](path string) ([]T, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to open %s"), path)
+	}
+	defer file.Close()
+
+	var records []T
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		records, err = readCSVRecords[T](file)
+	} else {
+		decoder := yaml.NewDecoder(file)
+		decoder.SetStrict(true)
+		err = decoder.Decode(&records)
+		if errors.Is(err, io.EOF) {
+			err = nil
+		}
+	}
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to parse %s"), path)
+	}
+	return records, nil
+}
+
+func readCSVRecords[T interface{}](reader io.Reader) ([]T, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.TrimLeadingSpace = true
+	lines, err := csvReader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(lines) == 0 {
+		return nil, errors.New(L("missing CSV header"))
+	}
+
+	records := []T{}
+	for i, line := range lines[1:] {
+		var record T
+		fields := reflect.ValueOf(&record).Elem()
+		for column, name := range lines[0] {
+			if err := setRecordField(fields, strings.TrimSpace(name), line[column]); err != nil {
+				return nil, fmt.Errorf(L("line %[1]d: %[2]s"), i+2, err)
+			}
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// setRecordField sets the field named like the yaml tag, looking into the inline structures.
+func setRecordField(record reflect.Value, name string, value string) error {
+	if found, err := setField(record, name, value); found || err != nil {
+		return err
+	}
+	return fmt.Errorf(L("unknown column: %s"), name)
+}
+
+func setField(record reflect.Value, name string, value string) (bool, error) {
+	for i := 0; i < record.NumField(); i++ {
+		field := record.Type().Field(i)
+		tag := strings.Split(field.Tag.Get("yaml"), ",")
+		if len(tag) > 1 && tag[1] == "inline" && field.Type.Kind() == reflect.Struct {
+			if found, err := setField(record.Field(i), name, value); found || err != nil {
+				return found, err
+			}
+			continue
+		}
+		fieldName := tag[0]
+		if fieldName == "" {
+			fieldName = strings.ToLower(field.Name)
+		}
+		if !strings.EqualFold(fieldName, name) {
+			continue
+		}
+
+		target := record.Field(i)
+		value = strings.TrimSpace(value)
+		switch target.Kind() {
+		case reflect.String:
+			target.SetString(value)
+		case reflect.Bool:
+			if value == "" {
+				return true, nil
+			}
+			parsed, err := strconv.ParseBool(value)
+			if err != nil {
+				return true, fmt.Errorf(L("invalid boolean for %[1]s: %[2]s"), name, value)
+			}
+			target.SetBool(parsed)
+		case reflect.Int:
+			if value == "" {
+				return true, nil
+			}
+			parsed, err := strconv.Atoi(value)
+			if err != nil {
+				return true, fmt.Errorf(L("invalid number for %[1]s: %[2]s"), name, value)
+			}
+			target.SetInt(int64(parsed))
+		case reflect.Slice:
+			if target.Type().Elem().Kind() != reflect.String {
+				return true, fmt.Errorf(L("unsupported column type: %s"), name)
+			}
+			items := []string{}
+			for _, item := range strings.Split(value, CSVListSeparator) {
+				if item = strings.TrimSpace(item); item != "" {
+					items = append(items, item)
+				}
+			}
+			target.Set(reflect.ValueOf(items))
+		default:
+			return true, fmt.Errorf(L("unsupported column type: %s"), name)
+		}
+		return true, nil
+	}
+	return false, nil
+}
@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"path"
+	"testing"
+
+	apiTypes "github.com/uyuni-project/uyuni-tools/shared/api/types"
+	"github.com/uyuni-project/uyuni-tools/shared/testutils"
+)
+
+type testRecord struct {
+	apiTypes.User `yaml:",inline"`
+	Pam           bool     `yaml:"pam"`
+	Limit         int      `yaml:"limit"`
+	Roles         []string `yaml:"roles"`
+}
+
+func TestReadRecords(t *testing.T) {
+	dir := t.TempDir()
+	expected := []testRecord{
+		{
+			User:  apiTypes.User{Login: "jdoe", FirstName: "Jane", Email: "jdoe@example.com"},
+			Pam:   true,
+			Limit: 2,
+			Roles: []string{"org_admin", "channel_admin"},
+		},
+		{
+			User:  apiTypes.User{Login: "jsmith", Password: "secret"},
+			Roles: []string{},
+		},
+	}
+
+	csvPath := path.Join(dir, "users.CSV")
+	testutils.WriteFile(t, csvPath, `login, firstName,email,pam,limit,roles,password
+jdoe,Jane,jdoe@example.com,true,2,org_admin; channel_admin,
+jsmith,,,,,,secret
+`)
+	records, err := ReadRecords[testRecord](csvPath)
+	if err != nil {
+		t.Fatalf("failed to read CSV: %s", err)
+	}
+	testutils.AssertEquals(t, "Unexpected CSV records", expected, records)
+
+	yamlPath := path.Join(dir, "users.yaml")
+	testutils.WriteFile(t, yamlPath, `- login: jdoe
+  firstName: Jane
+  email: jdoe@example.com
+  pam: true
+  limit: 2
+  roles: [org_admin, channel_admin]
+- login: jsmith
+  password: secret
+  roles: []
+`)
+	records, err = ReadRecords[testRecord](yamlPath)
+	if err != nil {
+		t.Fatalf("failed to read YAML: %s", err)
+	}
+	testutils.AssertEquals(t, "Unexpected YAML records", expected, records)
+
+	testutils.WriteFile(t, csvPath, "login,unknown\njdoe,foo\n")
+	_, err = ReadRecords[testRecord](csvPath)
+	testutils.AssertTrue(t, "Unknown columns should fail", err != nil)
+
+	testutils.WriteFile(t, csvPath, "login,pam\njdoe,maybe\n")
+	_, err = ReadRecords[testRecord](csvPath)
+	testutils.AssertTrue(t, "Invalid booleans should fail", err != nil)
+}
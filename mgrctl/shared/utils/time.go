@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+)
+
+var dateFormats = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+// ParseTime parses a date or a duration relative to now.
+//
+// Durations are meant to be in the past and may use the d suffix for days in addition
+// to the Go duration units, like 7d or 12h.
+// Dates without time zone are considered local.
+func ParseTime(value string, now time.Time) (time.Time, error) {
+	return parseTime(value, now, -1)
+}
+
+// ParseFutureTime parses a date or a duration relative to now like ParseTime,
+// but with durations meant to be in the future.
+//
+// This is the parser to use for the scheduling flags.
+func ParseFutureTime(value string, now time.Time) (time.Time, error) {
+	return parseTime(value, now, 1)
+}
+
+// parseTime parses a date or a duration with sign giving its direction relative to now.
+func parseTime(value string, now time.Time, sign int) (time.Time, error) {
+	if days, found := strings.CutSuffix(value, "d"); found {
+		if count, err := strconv.Atoi(days); err == nil {
+			return now.AddDate(0, 0, sign*count), nil
+		}
+	}
+	if duration, err := time.ParseDuration(value); err == nil {
+		return now.Add(time.Duration(sign) * duration), nil
+	}
+	for _, format := range dateFormats {
+		if date, err := time.ParseInLocation(format, value, time.Local); err == nil {
+			return date, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf(L("invalid date or duration: %s"), value)
+}
@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/uyuni-project/uyuni-tools/shared/testutils"
+)
+
+func TestParseTime(t *testing.T) {
+	now := time.Date(2025, 3, 15, 12, 0, 0, 0, time.UTC)
+	data := map[string]time.Time{
+		"7d":                   time.Date(2025, 3, 8, 12, 0, 0, 0, time.UTC),
+		"90m":                  time.Date(2025, 3, 15, 10, 30, 0, 0, time.UTC),
+		"2025-03-01":           time.Date(2025, 3, 1, 0, 0, 0, 0, time.Local),
+		"2025-03-01 22:00":     time.Date(2025, 3, 1, 22, 0, 0, 0, time.Local),
+		"2025-03-01T22:00:00Z": time.Date(2025, 3, 1, 22, 0, 0, 0, time.UTC),
+	}
+
+	for value, expected := range data {
+		actual, err := ParseTime(value, now)
+		if err != nil {
+			t.Errorf("failed to parse %s: %s", value, err)
+			continue
+		}
+		testutils.AssertTrue(t, "Unexpected time for "+value+": "+actual.String(), expected.Equal(actual))
+	}
+
+	_, err := ParseTime("yesterday", now)
+	testutils.AssertTrue(t, "Invalid values should fail", err != nil)
+}
+
+func TestParseFutureTime(t *testing.T) {
+	now := time.Date(2025, 3, 15, 12, 0, 0, 0, time.UTC)
+	data := map[string]time.Time{
+		"2d":               time.Date(2025, 3, 17, 12, 0, 0, 0, time.UTC),
+		"2h":               time.Date(2025, 3, 15, 14, 0, 0, 0, time.UTC),
+		"2025-03-20 22:00": time.Date(2025, 3, 20, 22, 0, 0, 0, time.Local),
+	}
+
+	for value, expected := range data {
+		actual, err := ParseFutureTime(value, now)
+		if err != nil {
+			t.Errorf("failed to parse %s: %s", value, err)
+			continue
+		}
+		testutils.AssertTrue(t, "Unexpected time for "+value+": "+actual.String(), expected.Equal(actual))
+	}
+}
@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+)
+
+// TimeoutError is returned by Poll when reaching the timeout.
+type TimeoutError struct {
+	Timeout time.Duration
+}
+
+func (e *TimeoutError) Error() string {
+	return fmt.Sprintf(L("timed out after %s"), e.Timeout)
+}
+
+// Poll calls check every interval until it returns true or an error.
+//
+// A TimeoutError is returned if the timeout is reached. A zero timeout means waiting forever.
+func Poll(timeout time.Duration, interval time.Duration, check func() (bool, error)) error {
+	start := time.Now()
+	for {
+		done, err := check()
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+		if timeout > 0 && time.Since(start)+interval > timeout {
+			return &TimeoutError{Timeout: timeout}
+		}
+		time.Sleep(interval)
+	}
+}
@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backup
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/uyuni-project/uyuni-tools/mgrpxy/shared/podman"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	shared_podman "github.com/uyuni-project/uyuni-tools/shared/podman"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+var systemd shared_podman.Systemd = shared_podman.SystemdImpl{}
+
+func newCreateCmd(globalFlags *types.GlobalFlags, run utils.CommandFunc[podman.BackupFlags]) *cobra.Command {
+	createCmd := &cobra.Command{
+		Use:   "create output-directory",
+		Args:  cobra.ExactArgs(1),
+		Short: L("Create a backup of the proxy"),
+		Long: L(`Create a backup of the podman proxy
+
+The backup contains the proxy configuration, the systemd services with their configuration
+and the container images. The cache and tftpboot volumes can be added to avoid downloading
+all the packages again after restoring.`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var flags podman.BackupFlags
+			return utils.CommandHelper(globalFlags, cmd, args, &flags, nil, run)
+		},
+	}
+
+	createCmd.Flags().Bool("volumes", false,
+		L("Backup the squid cache, rhn cache and tftpboot volumes. The proxy is stopped during their backup"),
+	)
+	createCmd.Flags().Bool("skipimages", false, L("Do not backup container images"))
+
+	return createCmd
+}
+
+func newRestoreCmd(globalFlags *types.GlobalFlags, run utils.CommandFunc[podman.RestoreFlags]) *cobra.Command {
+	restoreCmd := &cobra.Command{
+		Use:   "restore directory",
+		Args:  cobra.ExactArgs(1),
+		Short: L("Restore a backup of the proxy"),
+		Long:  L("Restore a backup of the podman proxy from a specified directory"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var flags podman.RestoreFlags
+			return utils.CommandHelper(globalFlags, cmd, args, &flags, nil, run)
+		},
+	}
+
+	restoreCmd.Flags().Bool("skipimages", false, L("Skip restore of container images"))
+	restoreCmd.Flags().Bool("skipvolumes", false, L("Skip restore of volumes"))
+	restoreCmd.Flags().Bool("skipverify", false, L("Skip verification of the backup files"))
+	restoreCmd.Flags().Bool("force", false, L("Overwrite the existing proxy configuration and volumes"))
+	restoreCmd.Flags().Bool("restart", false, L("Start the proxy after the restore"))
+
+	return restoreCmd
+}
+
+// NewCommand creates the command to backup and restore the proxy.
+func NewCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	backupCmd := &cobra.Command{
+		Use:     "backup",
+		GroupID: "tool",
+		Short:   L("Backup and restore the proxy"),
+		Long:    L("Tools for local backup management of the podman proxy"),
+		Run: func(cmd *cobra.Command, _ []string) {
+			_ = cmd.Help()
+		},
+	}
+	backupCmd.AddCommand(newCreateCmd(globalFlags, doBackup))
+	backupCmd.AddCommand(newRestoreCmd(globalFlags, doRestore))
+	return backupCmd
+}
+
+func doBackup(_ *types.GlobalFlags, flags *podman.BackupFlags, _ *cobra.Command, args []string) error {
+	return podman.Backup(systemd, args[0], flags)
+}
+
+func doRestore(_ *types.GlobalFlags, flags *podman.RestoreFlags, _ *cobra.Command, args []string) error {
+	return podman.Restore(systemd, args[0], flags)
+}
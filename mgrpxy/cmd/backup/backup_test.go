@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backup
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/uyuni-project/uyuni-tools/mgrpxy/shared/podman"
+	"github.com/uyuni-project/uyuni-tools/shared/testutils"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+)
+
+func TestCreateParamsParsing(t *testing.T) {
+	args := []string{
+		"--volumes",
+		"--skipimages",
+		"/backup",
+	}
+
+	// Test function asserting that the args are properly parsed
+	tester := func(_ *types.GlobalFlags, flags *podman.BackupFlags,
+		_ *cobra.Command, args []string,
+	) error {
+		testutils.AssertTrue(t, "Error parsing --volumes", flags.Volumes)
+		testutils.AssertTrue(t, "Error parsing --skipimages", flags.SkipImages)
+		testutils.AssertEquals(t, "Wrong output directory", "/backup", args[0])
+		return nil
+	}
+
+	globalFlags := types.GlobalFlags{}
+	cmd := newCreateCmd(&globalFlags, tester)
+
+	testutils.AssertHasAllFlags(t, cmd, args)
+
+	cmd.SetArgs(args)
+	if err := cmd.Execute(); err != nil {
+		t.Errorf("command failed with error: %s", err)
+	}
+}
+
+func TestRestoreParamsParsing(t *testing.T) {
+	args := []string{
+		"--skipimages",
+		"--skipvolumes",
+		"--skipverify",
+		"--force",
+		"--restart",
+		"/backup",
+	}
+
+	// Test function asserting that the args are properly parsed
+	tester := func(_ *types.GlobalFlags, flags *podman.RestoreFlags,
+		_ *cobra.Command, args []string,
+	) error {
+		testutils.AssertTrue(t, "Error parsing --skipimages", flags.SkipImages)
+		testutils.AssertTrue(t, "Error parsing --skipvolumes", flags.SkipVolumes)
+		testutils.AssertTrue(t, "Error parsing --skipverify", flags.SkipVerify)
+		testutils.AssertTrue(t, "Error parsing --force", flags.Force)
+		testutils.AssertTrue(t, "Error parsing --restart", flags.Restart)
+		testutils.AssertEquals(t, "Wrong input directory", "/backup", args[0])
+		return nil
+	}
+
+	globalFlags := types.GlobalFlags{}
+	cmd := newRestoreCmd(&globalFlags, tester)
+
+	testutils.AssertHasAllFlags(t, cmd, args)
+
+	cmd.SetArgs(args)
+	if err := cmd.Execute(); err != nil {
+		t.Errorf("command failed with error: %s", err)
+	}
+}
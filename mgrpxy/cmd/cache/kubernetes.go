@@ -5,7 +5,9 @@
 package cache
 
 import (
+	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
+	proxy_kubernetes "github.com/uyuni-project/uyuni-tools/mgrpxy/shared/kubernetes"
 	"github.com/uyuni-project/uyuni-tools/shared"
 	"github.com/uyuni-project/uyuni-tools/shared/kubernetes"
 	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
@@ -35,3 +37,40 @@ func kubernetesCacheClear(
 
 	return kubernetes.Restart(namespace, kubernetes.ProxyApp)
 }
+
+func kubernetesCacheStatus(
+	_ *types.GlobalFlags,
+	_ *cacheStatusFlags,
+	_ *cobra.Command,
+	_ []string,
+) error {
+	cnx := shared.NewConnection("kubectl", "squid", kubernetes.ProxyFilter)
+
+	maxCache := 0
+	if config, err := proxy_kubernetes.ReadProxyConfig(); err != nil {
+		log.Warn().Err(err).Msg(L("Failed to read the proxy configuration"))
+	} else {
+		maxCache = config.MaxCache
+	}
+	return showStatus(cnx, maxCache)
+}
+
+func kubernetesCachePurge(
+	_ *types.GlobalFlags,
+	flags *cachePurgeFlags,
+	_ *cobra.Command,
+	_ []string,
+) error {
+	cnx := shared.NewConnection("kubectl", "squid", kubernetes.ProxyFilter)
+	return purge(cnx, flags.pattern())
+}
+
+func kubernetesCacheWarm(
+	_ *types.GlobalFlags,
+	flags *cacheWarmFlags,
+	_ *cobra.Command,
+	_ []string,
+) error {
+	cnx := shared.NewConnection("kubectl", "httpd", kubernetes.ProxyFilter)
+	return warm(cnx, flags)
+}
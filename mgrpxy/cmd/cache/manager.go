@@ -0,0 +1,269 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/uyuni-project/uyuni-tools/shared"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+// squidPort is the port squid listens to inside the proxy pod.
+const squidPort = "8080"
+
+const squidCacheDir = "/var/cache/squid"
+
+// cacheStats are the cache figures reported by squid's cache manager.
+type cacheStats struct {
+	// SizeKB is the size of the cache on disk in KB.
+	SizeKB int64
+	// Objects is the number of objects stored on disk.
+	Objects int64
+	// HitRatio is the percentage of requests served from the cache in the last hour.
+	HitRatio float64
+	// ByteHitRatio is the percentage of bytes served from the cache in the last hour.
+	ByteHitRatio float64
+}
+
+var (
+	hitRatioRegex     = regexp.MustCompile(`Hits as % of all requests:\s*5min: [0-9.]+%, 60min: ([0-9.]+)%`)
+	byteHitRatioRegex = regexp.MustCompile(`Hits as % of bytes sent:\s*5min: [0-9.-]+%, 60min: ([0-9.-]+)%`)
+	swapSizeRegex     = regexp.MustCompile(`Storage Swap size:\s*([0-9]+) KB`)
+	onDiskRegex       = regexp.MustCompile(`([0-9]+) on-disk objects`)
+	statusLineRegex   = regexp.MustCompile(`HTTP/[0-9.]+ ([0-9]{3})`)
+)
+
+// squidManager queries a page of squid's cache manager.
+func squidManager(cnx *shared.Connection, page string) (string, error) {
+	out, err := cnx.Exec("squidclient", "-h", "localhost", "-p", squidPort, "mgr:"+page)
+	if err != nil {
+		return "", utils.Errorf(err, L("failed to query squid cache manager"))
+	}
+	return string(out), nil
+}
+
+// parseCacheInfo extracts the cache figures from the output of the mgr:info page.
+func parseCacheInfo(info string) (*cacheStats, error) {
+	sizeMatch := swapSizeRegex.FindStringSubmatch(info)
+	objectsMatch := onDiskRegex.FindStringSubmatch(info)
+	if sizeMatch == nil || objectsMatch == nil {
+		return nil, errors.New(L("unexpected squid cache manager output"))
+	}
+
+	stats := cacheStats{}
+	stats.SizeKB, _ = strconv.ParseInt(sizeMatch[1], 10, 64)
+	stats.Objects, _ = strconv.ParseInt(objectsMatch[1], 10, 64)
+	if match := hitRatioRegex.FindStringSubmatch(info); match != nil {
+		stats.HitRatio, _ = strconv.ParseFloat(match[1], 64)
+	}
+	// squid reports negative byte hit ratios when more bytes were revalidated than sent.
+	if match := byteHitRatioRegex.FindStringSubmatch(info); match != nil {
+		stats.ByteHitRatio, _ = strconv.ParseFloat(match[1], 64)
+	}
+	return &stats, nil
+}
+
+// showStatus prints the cache figures and its usage compared to the maximum cache size in MB.
+func showStatus(cnx *shared.Connection, maxCache int) error {
+	info, err := squidManager(cnx, "info")
+	if err != nil {
+		return err
+	}
+	stats, err := parseCacheInfo(info)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf(L("Cache size: %.1f MB")+"\n", float64(stats.SizeKB)/1024)
+	fmt.Printf(L("Cached objects: %d")+"\n", stats.Objects)
+	fmt.Printf(L("Hit ratio (last hour): %.1f%% of requests, %.1f%% of bytes")+"\n",
+		stats.HitRatio, stats.ByteHitRatio,
+	)
+	if maxCache > 0 {
+		fmt.Printf(L("Usage: %.1f%% of %d MB")+"\n", cacheUsage(stats, maxCache), maxCache)
+	} else {
+		log.Warn().Msg(L("Maximum cache size not found in the proxy configuration"))
+	}
+	return nil
+}
+
+// cacheUsage returns the percentage of the maximum cache size in MB used by the cache.
+func cacheUsage(stats *cacheStats, maxCache int) float64 {
+	return float64(stats.SizeKB) / float64(maxCache*1024) * 100
+}
+
+// channelURLPattern returns the pattern matching the cached URLs of a channel.
+func channelURLPattern(label string) string {
+	return "/rhn/manager/download/" + regexp.QuoteMeta(label) + "/"
+}
+
+// Fields of the swap metadata squid writes at the start of each cache file, see squid's StoreMeta.h.
+const (
+	swapMetaOK  = 0x03
+	swapMetaURL = 0x04
+)
+
+// swapHeaderSize is the number of bytes read from each cache file to get the swap metadata.
+const swapHeaderSize = 2048
+
+// swapFilePattern matches the names of squid cache files.
+const swapFilePattern = "[0-9A-F][0-9A-F][0-9A-F][0-9A-F][0-9A-F][0-9A-F][0-9A-F][0-9A-F]"
+
+// listCachedURLs returns the URLs of the objects stored in the cache.
+//
+// Only the swap metadata at the start of the cache files is read, not the cached objects.
+func listCachedURLs(cnx *shared.Connection) ([]string, error) {
+	script := fmt.Sprintf(`for f in "$@"; do head -c %d "$f" | base64 -w 0; echo; done`, swapHeaderSize)
+	out, err := cnx.Exec("find", squidCacheDir, "-type", "f", "-name", swapFilePattern,
+		"-exec", "sh", "-c", script, "sh", "{}", "+",
+	)
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to list the cached objects"))
+	}
+	return parseCachedURLs(string(out)), nil
+}
+
+// parseCachedURLs returns the sorted unique URLs of the base64 encoded swap metadata, one per line.
+func parseCachedURLs(out string) []string {
+	urls := map[string]bool{}
+	for _, line := range strings.Split(out, "\n") {
+		if line = strings.TrimSpace(line); line == "" {
+			continue
+		}
+		header, err := base64.StdEncoding.DecodeString(line)
+		if err != nil {
+			log.Debug().Err(err).Msg("Skipping invalid cache file header")
+			continue
+		}
+		if url := parseSwapMetaURL(header); url != "" {
+			urls[url] = true
+		}
+	}
+	result := make([]string, 0, len(urls))
+	for url := range urls {
+		result = append(result, url)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// parseSwapMetaURL returns the URL stored in the swap metadata of a cache file or an empty string.
+//
+// The metadata starts with the OK marker and its size, followed by type, length and value fields.
+// squid writes the sizes in the host byte order, little endian on the supported architectures.
+func parseSwapMetaURL(header []byte) string {
+	if len(header) < 5 || header[0] != swapMetaOK {
+		return ""
+	}
+	end := 5 + int(int32(binary.LittleEndian.Uint32(header[1:5])))
+	if end > len(header) {
+		end = len(header)
+	}
+	for pos := 5; pos+5 <= end; {
+		fieldType := header[pos]
+		length := int(int32(binary.LittleEndian.Uint32(header[pos+1 : pos+5])))
+		pos += 5
+		if length < 0 || pos+length > end {
+			return ""
+		}
+		if fieldType == swapMetaURL {
+			return strings.TrimRight(string(header[pos:pos+length]), "\x00")
+		}
+		pos += length
+	}
+	return ""
+}
+
+// filterURLs returns the URLs matching the pattern.
+func filterURLs(urls []string, pattern *regexp.Regexp) []string {
+	matching := []string{}
+	for _, url := range urls {
+		if pattern.MatchString(url) {
+			matching = append(matching, url)
+		}
+	}
+	return matching
+}
+
+// purgeStatus returns the HTTP status code of a squidclient PURGE output, or 0 if not found.
+func purgeStatus(out string) int {
+	match := statusLineRegex.FindStringSubmatch(out)
+	if match == nil {
+		return 0
+	}
+	status, _ := strconv.Atoi(match[1])
+	return status
+}
+
+// purgeCheckURL is purged to check that squid accepts PURGE requests before listing the cache.
+const purgeCheckURL = "http://localhost/mgrpxy-purge-check"
+
+// checkPurgeAllowed fails if squid refuses the PURGE requests.
+func checkPurgeAllowed(cnx *shared.Connection) error {
+	out, err := cnx.Exec("squidclient", "-h", "localhost", "-p", squidPort, "-m", "PURGE", purgeCheckURL)
+	if err != nil {
+		return utils.Errorf(err, L("failed to send a PURGE request to squid"))
+	}
+	if purgeStatus(string(out)) == 403 {
+		return errors.New(L(`squid refuses PURGE requests: add the following lines to the squid tuning file
+passed to mgrpxy install or upgrade with --tuning-squid:
+acl PURGE method PURGE
+http_access allow PURGE localhost`))
+	}
+	return nil
+}
+
+// purge evicts the cached objects matching the pattern without restarting squid.
+func purge(cnx *shared.Connection, pattern string) error {
+	regex, err := regexp.Compile(pattern)
+	if err != nil {
+		return utils.Errorf(err, L("invalid URL pattern %s"), pattern)
+	}
+
+	if err := checkPurgeAllowed(cnx); err != nil {
+		return err
+	}
+
+	urls, err := listCachedURLs(cnx)
+	if err != nil {
+		return err
+	}
+	urls = filterURLs(urls, regex)
+	if len(urls) == 0 {
+		log.Info().Msgf(L("No cached object matches %s"), pattern)
+		return nil
+	}
+
+	purged := 0
+	for _, url := range urls {
+		out, err := cnx.Exec("squidclient", "-h", "localhost", "-p", squidPort, "-m", "PURGE", url)
+		if err != nil {
+			return utils.Errorf(err, L("failed to purge %s"), url)
+		}
+		switch status := purgeStatus(string(out)); status {
+		case 200:
+			log.Debug().Msgf("Purged %s", url)
+			purged++
+		case 404:
+			log.Debug().Msgf("%s was not in the cache anymore", url)
+		case 403:
+			return fmt.Errorf(L("squid refused to purge %s: PURGE requests are not allowed"), url)
+		default:
+			return fmt.Errorf(L("failed to purge %[1]s: unexpected status %[2]d"), url, status)
+		}
+	}
+	log.Info().Msgf(L("%d cached objects purged"), purged)
+	return nil
+}
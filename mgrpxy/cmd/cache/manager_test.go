@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/uyuni-project/uyuni-tools/shared/testutils"
+)
+
+const testCacheInfo = `Squid Object Cache: Version 6.10
+Cache information for squid:
+	Hits as % of all requests:	5min: 12.5%, 60min: 42.3%
+	Hits as % of bytes sent:	5min: 10.0%, 60min: 65.8%
+	Memory hits as % of hit requests:	5min: 0.0%, 60min: 3.1%
+	Disk hits as % of hit requests:	5min: 100.0%, 60min: 96.9%
+	Storage Swap size:	1572864 KB
+	Storage Swap capacity:	37.5% used, 62.5% free
+	Storage Mem size:	216 KB
+Internal Data Structures:
+	   652 StoreEntries
+	    40 StoreEntries with MemObjects
+	    38 Hot Object Cache Items
+	   612 on-disk objects
+`
+
+func TestParseCacheInfo(t *testing.T) {
+	stats, err := parseCacheInfo(testCacheInfo)
+	if err != nil {
+		t.Fatalf("failed to parse cache info: %s", err)
+	}
+	testutils.AssertEquals(t, "Unexpected size", int64(1572864), stats.SizeKB)
+	testutils.AssertEquals(t, "Unexpected objects count", int64(612), stats.Objects)
+	testutils.AssertEquals(t, "Unexpected hit ratio", 42.3, stats.HitRatio)
+	testutils.AssertEquals(t, "Unexpected byte hit ratio", 65.8, stats.ByteHitRatio)
+	testutils.AssertEquals(t, "Unexpected usage", 37.5, cacheUsage(stats, 4096))
+
+	if _, err := parseCacheInfo("ERROR: Cannot connect to localhost:8080"); err == nil {
+		t.Error("Expected an error for an invalid output")
+	}
+}
+
+// swapHeader builds the base64 encoded start of a squid cache file storing the URL.
+func swapHeader(url string) string {
+	field := func(fieldType byte, value []byte) []byte {
+		result := []byte{fieldType}
+		result = binary.LittleEndian.AppendUint32(result, uint32(len(value)))
+		return append(result, value...)
+	}
+	fields := field(0x03, make([]byte, 16))
+	fields = append(fields, field(0x09, make([]byte, 44))...)
+	fields = append(fields, field(0x04, append([]byte(url), 0))...)
+
+	header := binary.LittleEndian.AppendUint32([]byte{0x03}, uint32(len(fields)))
+	header = append(header, fields...)
+	// The object follows the metadata and may contain other URLs
+	header = append(header, []byte("HTTP/1.1 200 OK\r\nLocation: http://other.example.com/\r\n")...)
+	return base64.StdEncoding.EncodeToString(header)
+}
+
+func TestParseSwapMetaURL(t *testing.T) {
+	url := "http://uyuni.example.com/rhn/manager/download/sles15-sp6-pool-x86_64/repodata/repomd.xml"
+	header, _ := base64.StdEncoding.DecodeString(swapHeader(url))
+	testutils.AssertEquals(t, "Unexpected URL", url, parseSwapMetaURL(header))
+	testutils.AssertEquals(t, "Truncated metadata should be ignored", "", parseSwapMetaURL(header[:40]))
+	testutils.AssertEquals(t, "Invalid metadata should be ignored", "", parseSwapMetaURL([]byte("http://x/")))
+}
+
+func TestFilterURLs(t *testing.T) {
+	const download = "http://uyuni.example.com/rhn/manager/download/"
+	listing := strings.Join([]string{
+		swapHeader(download + "sles15-sp6-pool-x86_64/getPackage/vim-9.1-1.x86_64.rpm"),
+		swapHeader(download + "sles15-sp6-pool-x86_64/repodata/primary.xml.gz"),
+		swapHeader(download + "sles15-sp6-pool-x86_64.1/repodata/primary.xml.gz"),
+		swapHeader(download + "sles15-sp6-updates-x86_64/getPackage/vim-9.1-2.x86_64.rpm"),
+		swapHeader(download + "sles15-sp6-pool-x86_64/repodata/primary.xml.gz"),
+		"not base64!",
+		"",
+	}, "\n")
+	urls := parseCachedURLs(listing)
+	testutils.AssertEquals(t, "Duplicates and invalid lines should be removed", 4, len(urls))
+
+	channel := regexp.MustCompile(channelURLPattern("sles15-sp6-pool-x86_64"))
+	testutils.AssertEquals(t, "Unexpected channel objects", 2, len(filterURLs(urls, channel)))
+
+	vim := regexp.MustCompile(`getPackage/vim-`)
+	testutils.AssertEquals(t, "Unexpected matching objects", 2, len(filterURLs(urls, vim)))
+}
+
+func TestPurgeStatus(t *testing.T) {
+	testutils.AssertEquals(t, "Unexpected purged status", 200, purgeStatus("HTTP/1.1 200 OK\nServer: squid\n"))
+	testutils.AssertEquals(t, "Unexpected missing status", 404, purgeStatus("HTTP/1.1 404 Not Found\n"))
+	testutils.AssertEquals(t, "Unexpected invalid status", 0, purgeStatus("ERROR: connection refused"))
+}
@@ -5,7 +5,9 @@
 package cache
 
 import (
+	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
+	proxy_utils "github.com/uyuni-project/uyuni-tools/mgrpxy/shared/utils"
 	"github.com/uyuni-project/uyuni-tools/shared"
 	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
 	"github.com/uyuni-project/uyuni-tools/shared/podman"
@@ -33,3 +35,40 @@ func podmanCacheClear(
 
 	return systemd.RestartService(podman.ProxyService)
 }
+
+func podmanCacheStatus(
+	_ *types.GlobalFlags,
+	_ *cacheStatusFlags,
+	_ *cobra.Command,
+	_ []string,
+) error {
+	cnx := shared.NewConnection("podman", "uyuni-proxy-squid", "")
+
+	maxCache := 0
+	if config, err := proxy_utils.ReadProxyConfig(proxy_utils.ProxyConfigDir); err != nil {
+		log.Warn().Err(err).Msg(L("Failed to read the proxy configuration"))
+	} else {
+		maxCache = config.MaxCache
+	}
+	return showStatus(cnx, maxCache)
+}
+
+func podmanCachePurge(
+	_ *types.GlobalFlags,
+	flags *cachePurgeFlags,
+	_ *cobra.Command,
+	_ []string,
+) error {
+	cnx := shared.NewConnection("podman", "uyuni-proxy-squid", "")
+	return purge(cnx, flags.pattern())
+}
+
+func podmanCacheWarm(
+	_ *types.GlobalFlags,
+	flags *cacheWarmFlags,
+	_ *cobra.Command,
+	_ []string,
+) error {
+	cnx := shared.NewConnection("podman", "uyuni-proxy-httpd", "")
+	return warm(cnx, flags)
+}
@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/uyuni-project/uyuni-tools/shared"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+type cachePurgeFlags struct {
+	Backend string
+	URL     string
+	Channel string
+}
+
+// pattern returns the regular expression matching the URLs to purge.
+func (flags *cachePurgeFlags) pattern() string {
+	if flags.Channel != "" {
+		return channelURLPattern(flags.Channel)
+	}
+	return flags.URL
+}
+
+func newPurgeCmd(globalFlags *types.GlobalFlags, run utils.CommandFunc[cachePurgeFlags]) *cobra.Command {
+	purgeCmd := &cobra.Command{
+		Use:   "purge",
+		Short: L("Remove objects from the cache"),
+		Long: L(`Remove objects from the cache without restarting the proxy
+
+The objects to remove are either those with an URL matching a regular expression
+or the packages and metadata of a channel.
+
+squid needs to accept PURGE requests from localhost. Add the following lines to the squid
+tuning file passed to mgrpxy install or upgrade with --tuning-squid:
+acl PURGE method PURGE
+http_access allow PURGE localhost`),
+		Example: `  mgrpxy cache purge --url 'getPackage/.*kernel-default'
+  mgrpxy cache purge --channel sle-module-basesystem15-sp6-pool-x86_64`,
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var flags cachePurgeFlags
+			return utils.CommandHelper(globalFlags, cmd, args, &flags, nil, run)
+		},
+	}
+
+	purgeCmd.Flags().String("url", "", L("regular expression matching the URLs of the objects to remove"))
+	purgeCmd.Flags().String("channel", "", L("label of the channel to remove the objects of"))
+	purgeCmd.MarkFlagsMutuallyExclusive("url", "channel")
+	purgeCmd.MarkFlagsOneRequired("url", "channel")
+
+	utils.AddBackendFlag(purgeCmd)
+	return purgeCmd
+}
+
+// NewPurgeCmd creates the command to remove objects from the cache.
+func NewPurgeCmd(globalFlags *types.GlobalFlags) *cobra.Command {
+	return newPurgeCmd(globalFlags, purgeCmd)
+}
+
+func purgeCmd(globalFlags *types.GlobalFlags, flags *cachePurgeFlags, cmd *cobra.Command, args []string) error {
+	fn, err := shared.ChooseProxyPodmanOrKubernetes(cmd.Flags(), podmanCachePurge, kubernetesCachePurge)
+	if err != nil {
+		return err
+	}
+
+	return fn(globalFlags, flags, cmd, args)
+}
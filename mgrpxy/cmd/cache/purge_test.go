@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/uyuni-project/uyuni-tools/shared/testutils"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+)
+
+func TestPurgeParamsParsing(t *testing.T) {
+	args := []string{
+		"--backend", "kubectl",
+		"--channel", "sles15-sp6-pool-x86_64",
+	}
+
+	// Test function asserting that the args are properly parsed
+	tester := func(_ *types.GlobalFlags, flags *cachePurgeFlags,
+		_ *cobra.Command, _ []string,
+	) error {
+		testutils.AssertEquals(t, "Error parsing --backend", "kubectl", flags.Backend)
+		testutils.AssertEquals(t, "Error parsing --channel", "sles15-sp6-pool-x86_64", flags.Channel)
+		testutils.AssertEquals(t, "Unexpected pattern",
+			`/rhn/manager/download/sles15-sp6-pool-x86_64/`, flags.pattern(),
+		)
+		return nil
+	}
+
+	globalFlags := types.GlobalFlags{}
+	cmd := newPurgeCmd(&globalFlags, tester)
+
+	testutils.AssertHasAllFlags(t, cmd, append(args, "--url", "pattern"))
+
+	cmd.SetArgs(args)
+	if err := cmd.Execute(); err != nil {
+		t.Errorf("command failed with error: %s", err)
+	}
+}
+
+func TestPurgeParamsURL(t *testing.T) {
+	tester := func(_ *types.GlobalFlags, flags *cachePurgeFlags,
+		_ *cobra.Command, _ []string,
+	) error {
+		testutils.AssertEquals(t, "Error parsing --url", "getPackage/vim-", flags.pattern())
+		return nil
+	}
+
+	cmd := newPurgeCmd(&types.GlobalFlags{}, tester)
+	cmd.SetArgs([]string{"--url", "getPackage/vim-"})
+	if err := cmd.Execute(); err != nil {
+		t.Errorf("command failed with error: %s", err)
+	}
+}
@@ -0,0 +1,225 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/uyuni-project/uyuni-tools/shared"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+// downloadURL is the URL of the channels downloads on the proxy.
+const downloadURL = "http://localhost/rhn/manager/download/"
+
+// warmBatchSize is the number of packages downloaded by each command run in the container.
+const warmBatchSize = 200
+
+type repomdXML struct {
+	Data []struct {
+		Type     string `xml:"type,attr"`
+		Location struct {
+			Href string `xml:"href,attr"`
+		} `xml:"location"`
+	} `xml:"data"`
+}
+
+type primaryXML struct {
+	Packages []struct {
+		Location struct {
+			Href string `xml:"href,attr"`
+		} `xml:"location"`
+	} `xml:"package"`
+}
+
+// channelFileURL returns the proxy URL of a file of a channel.
+func channelFileURL(channel string, file string, token string) string {
+	url := downloadURL + channel + "/" + file
+	if token != "" {
+		url += "?" + token
+	}
+	return url
+}
+
+// parseRepomd returns the location of the primary metadata file.
+func parseRepomd(data []byte) (string, error) {
+	var repomd repomdXML
+	if err := xml.Unmarshal(data, &repomd); err != nil {
+		return "", utils.Errorf(err, L("failed to parse repomd.xml"))
+	}
+	for _, entry := range repomd.Data {
+		if entry.Type == "primary" {
+			return entry.Location.Href, nil
+		}
+	}
+	return "", errors.New(L("no primary metadata in repomd.xml"))
+}
+
+// parsePrimary returns the locations of the packages in the primary metadata, compressed or not.
+func parsePrimary(data []byte) ([]string, error) {
+	if bytes.HasPrefix(data, []byte{0x1f, 0x8b}) {
+		reader, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, utils.Errorf(err, L("failed to decompress the primary metadata"))
+		}
+		defer reader.Close()
+		if data, err = io.ReadAll(reader); err != nil {
+			return nil, utils.Errorf(err, L("failed to decompress the primary metadata"))
+		}
+	}
+
+	var primary primaryXML
+	if err := xml.Unmarshal(data, &primary); err != nil {
+		return nil, utils.Errorf(err, L("failed to parse the primary metadata"))
+	}
+	locations := make([]string, 0, len(primary.Packages))
+	for _, pkg := range primary.Packages {
+		if pkg.Location.Href != "" {
+			locations = append(locations, pkg.Location.Href)
+		}
+	}
+	return locations, nil
+}
+
+// parseRate converts a bandwidth like 10M into bytes per second.
+//
+// An empty value means no limit and is converted to 0.
+func parseRate(rate string) (int64, error) {
+	if rate == "" {
+		return 0, nil
+	}
+	value := rate
+	factor := int64(1)
+	switch strings.ToUpper(value[len(value)-1:]) {
+	case "K":
+		factor = 1024
+	case "M":
+		factor = 1024 * 1024
+	case "G":
+		factor = 1024 * 1024 * 1024
+	}
+	if factor != 1 {
+		value = value[:len(value)-1]
+	}
+	amount, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || amount <= 0 {
+		return 0, fmt.Errorf(L("invalid bandwidth limit: %s"), rate)
+	}
+	return amount * factor, nil
+}
+
+// fetch downloads a file through the proxy and returns its content.
+func fetch(cnx *shared.Connection, url string) ([]byte, error) {
+	out, err := cnx.Exec("curl", "-s", "-f", "-L", url)
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to download %s"), strings.Split(url, "?")[0])
+	}
+	return out, nil
+}
+
+// channelPackages returns the proxy URLs of the packages of a channel.
+func channelPackages(cnx *shared.Connection, channel string, token string) ([]string, error) {
+	repomd, err := fetch(cnx, channelFileURL(channel, "repodata/repomd.xml", token))
+	if err != nil {
+		return nil, err
+	}
+	primaryHref, err := parseRepomd(repomd)
+	if err != nil {
+		return nil, err
+	}
+	primary, err := fetch(cnx, channelFileURL(channel, primaryHref, token))
+	if err != nil {
+		return nil, err
+	}
+	locations, err := parsePrimary(primary)
+	if err != nil {
+		return nil, err
+	}
+
+	urls := make([]string, len(locations))
+	for i, location := range locations {
+		urls[i] = channelFileURL(channel, location, token)
+	}
+	return urls, nil
+}
+
+// warmScript returns the script downloading its arguments in parallel to fill the cache.
+//
+// The HTTP status of each download is printed on a line.
+func warmScript(concurrency int, rate int64) string {
+	curl := "curl -s -L -o /dev/null -w '%{http_code}\\n'"
+	if rate > 0 {
+		// Each download gets an equal share of the total bandwidth
+		perTransfer := rate / int64(concurrency)
+		if perTransfer < 1 {
+			perTransfer = 1
+		}
+		curl += fmt.Sprintf(" --limit-rate %d", perTransfer)
+	}
+	return fmt.Sprintf(`printf '%%s\n' "$@" | xargs -d '\n' -n 1 -P %d %s; exit 0`, concurrency, curl)
+}
+
+// countFailures returns the number of downloads without a success status in the script output.
+func countFailures(out string) int {
+	failures := 0
+	for _, line := range strings.Split(strings.TrimSpace(out), "\n") {
+		if line = strings.TrimSpace(line); line != "" && line != "200" {
+			failures++
+		}
+	}
+	return failures
+}
+
+// warm downloads the packages of the channels through the proxy.
+func warm(cnx *shared.Connection, flags *cacheWarmFlags) error {
+	if flags.Concurrency < 1 {
+		return fmt.Errorf(L("invalid concurrency: %d"), flags.Concurrency)
+	}
+	rate, err := parseRate(flags.Limit.Rate)
+	if err != nil {
+		return err
+	}
+
+	urls := []string{}
+	for _, channel := range flags.Channel {
+		packages, err := channelPackages(cnx, channel, flags.Token)
+		if err != nil {
+			return utils.Errorf(err, L("failed to read the metadata of channel %s"), channel)
+		}
+		log.Info().Msgf(L("%[1]d packages in channel %[2]s"), len(packages), channel)
+		urls = append(urls, packages...)
+	}
+
+	script := warmScript(flags.Concurrency, rate)
+	failures := 0
+	for start := 0; start < len(urls); start += warmBatchSize {
+		end := start + warmBatchSize
+		if end > len(urls) {
+			end = len(urls)
+		}
+		args := append([]string{"-c", script, "sh"}, urls[start:end]...)
+		out, err := cnx.Exec("sh", args...)
+		if err != nil {
+			return utils.Errorf(err, L("failed to download the packages"))
+		}
+		failures += countFailures(string(out))
+		log.Info().Msgf(L("Downloaded %[1]d of %[2]d packages"), end, len(urls))
+	}
+
+	if failures > 0 {
+		return fmt.Errorf(L("%[1]d of %[2]d packages could not be downloaded"), failures, len(urls))
+	}
+	log.Info().Msgf(L("%d packages are now cached"), len(urls))
+	return nil
+}
@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"bytes"
+	"compress/gzip"
+	"testing"
+
+	"github.com/uyuni-project/uyuni-tools/shared/testutils"
+)
+
+const testRepomd = `<?xml version="1.0" encoding="UTF-8"?>
+<repomd xmlns="http://linux.duke.edu/metadata/repo" xmlns:rpm="http://linux.duke.edu/metadata/rpm">
+  <revision>1718000000</revision>
+  <data type="filelists">
+    <location href="repodata/abc-filelists.xml.gz"/>
+  </data>
+  <data type="primary">
+    <checksum type="sha256">abc</checksum>
+    <location href="repodata/abc-primary.xml.gz"/>
+  </data>
+</repomd>`
+
+const testPrimary = `<?xml version="1.0" encoding="UTF-8"?>
+<metadata xmlns="http://linux.duke.edu/metadata/common" packages="2">
+<package type="rpm">
+  <name>vim</name>
+  <arch>x86_64</arch>
+  <location href="getPackage/vim-9.1.0330-150500.20.12.1.x86_64.rpm"/>
+</package>
+<package type="rpm">
+  <name>kernel-default</name>
+  <arch>x86_64</arch>
+  <location href="getPackage/kernel-default-6.4.0-150600.21.2.x86_64.rpm"/>
+</package>
+</metadata>`
+
+func TestParseRepodata(t *testing.T) {
+	href, err := parseRepomd([]byte(testRepomd))
+	if err != nil {
+		t.Fatalf("failed to parse repomd.xml: %s", err)
+	}
+	testutils.AssertEquals(t, "Unexpected primary location", "repodata/abc-primary.xml.gz", href)
+
+	var compressed bytes.Buffer
+	writer := gzip.NewWriter(&compressed)
+	_, _ = writer.Write([]byte(testPrimary))
+	_ = writer.Close()
+
+	for name, data := range map[string][]byte{"plain": []byte(testPrimary), "gzip": compressed.Bytes()} {
+		locations, err := parsePrimary(data)
+		if err != nil {
+			t.Fatalf("failed to parse %s primary: %s", name, err)
+		}
+		testutils.AssertEquals(t, "Unexpected packages in "+name+" primary", []string{
+			"getPackage/vim-9.1.0330-150500.20.12.1.x86_64.rpm",
+			"getPackage/kernel-default-6.4.0-150600.21.2.x86_64.rpm",
+		}, locations)
+	}
+}
+
+func TestChannelFileURL(t *testing.T) {
+	testutils.AssertEquals(t, "Unexpected URL without token",
+		"http://localhost/rhn/manager/download/pool/repodata/repomd.xml",
+		channelFileURL("pool", "repodata/repomd.xml", ""),
+	)
+	testutils.AssertEquals(t, "Unexpected URL with token",
+		"http://localhost/rhn/manager/download/pool/getPackage/vim.rpm?abc.def",
+		channelFileURL("pool", "getPackage/vim.rpm", "abc.def"),
+	)
+}
+
+func TestParseRate(t *testing.T) {
+	data := map[string]int64{
+		"":    0,
+		"512": 512,
+		"10K": 10 * 1024,
+		"20m": 20 * 1024 * 1024,
+		"1G":  1024 * 1024 * 1024,
+	}
+	for value, expected := range data {
+		rate, err := parseRate(value)
+		if err != nil {
+			t.Errorf("failed to parse %s: %s", value, err)
+		}
+		testutils.AssertEquals(t, "Unexpected rate for "+value, expected, rate)
+	}
+
+	for _, value := range []string{"M", "fast", "-2K"} {
+		if _, err := parseRate(value); err == nil {
+			t.Errorf("Expected an error for %s", value)
+		}
+	}
+}
+
+func TestWarmScript(t *testing.T) {
+	testutils.AssertEquals(t, "Unexpected script without limit",
+		`printf '%s\n' "$@" | xargs -d '\n' -n 1 -P 4 curl -s -L -o /dev/null -w '%{http_code}\n'; exit 0`,
+		warmScript(4, 0),
+	)
+	testutils.AssertEquals(t, "The bandwidth should be shared between the downloads",
+		`printf '%s\n' "$@" | xargs -d '\n' -n 1 -P 4 curl -s -L -o /dev/null -w '%{http_code}\n' `+
+			`--limit-rate 256; exit 0`,
+		warmScript(4, 1024),
+	)
+	testutils.AssertEquals(t, "Unexpected failures", 2, countFailures("200\n404\n200\n000\n"))
+}
@@ -11,7 +11,7 @@ import (
 )
 
 // NewCommand entry command for managing cache.
-// Setup for subcommand to clear (the cache).
+// Setup for subcommands to clear, purge, warm and show the status of the cache.
 func NewCommand(globalFlags *types.GlobalFlags) *cobra.Command {
 	var cacheCmd = &cobra.Command{
 		Use:   "cache",
@@ -23,5 +23,8 @@ func NewCommand(globalFlags *types.GlobalFlags) *cobra.Command {
 	}
 
 	cacheCmd.AddCommand(NewClearCmd(globalFlags))
+	cacheCmd.AddCommand(NewPurgeCmd(globalFlags))
+	cacheCmd.AddCommand(NewStatusCmd(globalFlags))
+	cacheCmd.AddCommand(NewWarmCmd(globalFlags))
 	return cacheCmd
 }
@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/uyuni-project/uyuni-tools/shared"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+type cacheStatusFlags struct {
+	Backend string
+}
+
+func newStatusCmd(globalFlags *types.GlobalFlags, run utils.CommandFunc[cacheStatusFlags]) *cobra.Command {
+	statusCmd := &cobra.Command{
+		Use:   "status",
+		Short: L("Show the cache status"),
+		Long: L(`Show the cache status
+
+The cache size, number of cached objects, hit ratio and usage of the maximum cache size
+are reported by squid's cache manager.`),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var flags cacheStatusFlags
+			return utils.CommandHelper(globalFlags, cmd, args, &flags, nil, run)
+		},
+	}
+
+	utils.AddBackendFlag(statusCmd)
+	return statusCmd
+}
+
+// NewStatusCmd creates the command to show the cache status.
+func NewStatusCmd(globalFlags *types.GlobalFlags) *cobra.Command {
+	return newStatusCmd(globalFlags, statusCmd)
+}
+
+func statusCmd(globalFlags *types.GlobalFlags, flags *cacheStatusFlags, cmd *cobra.Command, args []string) error {
+	fn, err := shared.ChooseProxyPodmanOrKubernetes(cmd.Flags(), podmanCacheStatus, kubernetesCacheStatus)
+	if err != nil {
+		return err
+	}
+
+	return fn(globalFlags, flags, cmd, args)
+}
@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/uyuni-project/uyuni-tools/shared"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+type cacheWarmFlags struct {
+	Backend     string
+	Channel     []string
+	Token       string
+	Concurrency int
+	Limit       struct {
+		Rate string
+	}
+}
+
+func newWarmCmd(globalFlags *types.GlobalFlags, run utils.CommandFunc[cacheWarmFlags]) *cobra.Command {
+	warmCmd := &cobra.Command{
+		Use:   "warm",
+		Short: L("Fill the cache with the packages of channels"),
+		Long: L(`Fill the cache with the packages of channels
+
+The repository metadata of the channels are read from the parent server and all the packages
+are downloaded through the proxy so that squid stores them as if clients requested them.`),
+		Example: `  mgrpxy cache warm --channel sle-module-basesystem15-sp6-pool-x86_64 \
+    --channel sle-module-basesystem15-sp6-updates-x86_64 --concurrency 2 --limit-rate 20M`,
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var flags cacheWarmFlags
+			return utils.CommandHelper(globalFlags, cmd, args, &flags, nil, run)
+		},
+	}
+
+	warmCmd.Flags().StringSlice("channel", []string{}, L("label of a channel to download the packages of"))
+	warmCmd.Flags().String("token", "", L("download token granting access to the channels"))
+	warmCmd.Flags().Int("concurrency", 4, L("maximum number of parallel downloads"))
+	warmCmd.Flags().String("limit-rate", "",
+		L("maximum total download bandwidth in bytes per second, with an optional K, M or G suffix"),
+	)
+	_ = warmCmd.MarkFlagRequired("channel")
+
+	utils.AddBackendFlag(warmCmd)
+	return warmCmd
+}
+
+// NewWarmCmd creates the command to fill the cache with the packages of channels.
+func NewWarmCmd(globalFlags *types.GlobalFlags) *cobra.Command {
+	return newWarmCmd(globalFlags, warmCmd)
+}
+
+func warmCmd(globalFlags *types.GlobalFlags, flags *cacheWarmFlags, cmd *cobra.Command, args []string) error {
+	fn, err := shared.ChooseProxyPodmanOrKubernetes(cmd.Flags(), podmanCacheWarm, kubernetesCacheWarm)
+	if err != nil {
+		return err
+	}
+
+	return fn(globalFlags, flags, cmd, args)
+}
@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/uyuni-project/uyuni-tools/shared/testutils"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+)
+
+func TestWarmParamsParsing(t *testing.T) {
+	args := []string{
+		"--backend", "kubectl",
+		"--channel", "pool",
+		"--channel", "updates",
+		"--token", "abc.def",
+		"--concurrency", "2",
+		"--limit-rate", "20M",
+	}
+
+	// Test function asserting that the args are properly parsed
+	tester := func(_ *types.GlobalFlags, flags *cacheWarmFlags,
+		_ *cobra.Command, _ []string,
+	) error {
+		testutils.AssertEquals(t, "Error parsing --backend", "kubectl", flags.Backend)
+		testutils.AssertEquals(t, "Error parsing --channel", []string{"pool", "updates"}, flags.Channel)
+		testutils.AssertEquals(t, "Error parsing --token", "abc.def", flags.Token)
+		testutils.AssertEquals(t, "Error parsing --concurrency", 2, flags.Concurrency)
+		testutils.AssertEquals(t, "Error parsing --limit-rate", "20M", flags.Limit.Rate)
+		return nil
+	}
+
+	globalFlags := types.GlobalFlags{}
+	cmd := newWarmCmd(&globalFlags, tester)
+
+	testutils.AssertHasAllFlags(t, cmd, args)
+
+	cmd.SetArgs(args)
+	if err := cmd.Execute(); err != nil {
+		t.Errorf("command failed with error: %s", err)
+	}
+}
@@ -0,0 +1,27 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package certificate
+
+import (
+	"github.com/spf13/cobra"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+)
+
+// NewCommand creates the command to manage the proxy certificates.
+func NewCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	certificateCmd := &cobra.Command{
+		Use:     "certificate",
+		GroupID: "management",
+		Short:   L("Manage the proxy certificates"),
+		Long:    L("Manage the proxy certificates"),
+		Run: func(cmd *cobra.Command, _ []string) {
+			_ = cmd.Help()
+		},
+	}
+
+	certificateCmd.AddCommand(newRenewCommand(globalFlags))
+	return certificateCmd
+}
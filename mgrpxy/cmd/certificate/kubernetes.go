@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package certificate
+
+import (
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	proxy_kubernetes "github.com/uyuni-project/uyuni-tools/mgrpxy/shared/kubernetes"
+	"github.com/uyuni-project/uyuni-tools/shared"
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/proxy"
+	"github.com/uyuni-project/uyuni-tools/shared/kubernetes"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+func kubernetesRenew(
+	_ *types.GlobalFlags,
+	flags *renewFlags,
+	_ *cobra.Command,
+	_ []string,
+) error {
+	cnx := shared.NewConnection("kubectl", "", kubernetes.ProxyFilter)
+	namespace, err := cnx.GetNamespace("")
+	if err != nil {
+		return utils.Errorf(err, L("failed retrieving namespace"))
+	}
+
+	tmpDir, cleaner, err := utils.TempDir()
+	if err != nil {
+		return err
+	}
+	defer cleaner()
+
+	if err := proxy_kubernetes.GetProxyConfigFiles(tmpDir); err != nil {
+		return err
+	}
+	if err := renewConfig(flags, tmpDir, api.Init, proxy.ContainerConfigGenerate); err != nil {
+		return err
+	}
+	if err := proxy_kubernetes.UpdateProxyConfig(namespace, tmpDir); err != nil {
+		return err
+	}
+
+	log.Info().Msg(L("Restarting the proxy"))
+	return kubernetes.Restart(namespace, kubernetes.ProxyApp)
+}
@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package certificate
+
+import (
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	"github.com/uyuni-project/uyuni-tools/mgrpxy/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/proxy"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/podman"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+)
+
+var systemd podman.Systemd = podman.SystemdImpl{}
+
+// httpdService is the only proxy service using the SSL material.
+const httpdService = "uyuni-proxy-httpd"
+
+func podmanRenew(
+	_ *types.GlobalFlags,
+	flags *renewFlags,
+	_ *cobra.Command,
+	_ []string,
+) error {
+	if err := renewConfig(flags, utils.ProxyConfigDir, api.Init, proxy.ContainerConfigGenerate); err != nil {
+		return err
+	}
+
+	log.Info().Msg(L("Restarting the proxy HTTPD container"))
+	return systemd.RestartService(httpdService)
+}
@@ -0,0 +1,184 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package certificate
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	"github.com/uyuni-project/uyuni-tools/mgrpxy/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared"
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/proxy"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/ssl"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	shared_utils "github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+type renewCaFlags struct {
+	types.SSLPair `mapstructure:",squash"`
+	Password      string
+}
+
+type renewSSLFlags struct {
+	types.SSLCertGenerationFlags `mapstructure:",squash"`
+	Ca                           renewCaFlags
+}
+
+type renewFlags struct {
+	Backend           string
+	ConnectionDetails api.ConnectionDetails `mapstructure:"api"`
+	Proxy             struct {
+		Port int `mapstructure:"sshPort"`
+	}
+	SSL renewSSLFlags
+}
+
+type generateFunc func(client *api.APIClient, request proxy.ProxyConfigGenerateRequest) (*[]int8, error)
+
+func newRenewCmd(globalFlags *types.GlobalFlags, run shared_utils.CommandFunc[renewFlags]) *cobra.Command {
+	renewCmd := &cobra.Command{
+		Use:   "renew",
+		Short: L("Renew the proxy certificate"),
+		Long: L(`Renew the proxy certificate
+
+A new proxy certificate is generated by the server using the CA key and replaces the one
+of the proxy configuration. The other configuration values are kept.
+The new certificate is validated before restarting the proxy containers using it.`),
+		Example: `  mgrpxy certificate renew --api-user admin --ssl-ca-key /root/ssl-build/RHN-ORG-PRIVATE-SSL-KEY`,
+		Args:    cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var flags renewFlags
+			return shared_utils.CommandHelper(globalFlags, cmd, args, &flags, nil, run)
+		},
+	}
+
+	renewCmd.Flags().Int("proxy-sshPort", 8022, L("SSH port the proxy listens on."))
+	renewCmd.Flags().String("ssl-ca-cert", "",
+		L("Path to the root CA certificate in PEM format. Defaults to the CA of the proxy configuration."),
+	)
+	renewCmd.Flags().String("ssl-ca-key", "",
+		L("Path to the private key of the CA to use to generate the certificate."),
+	)
+	renewCmd.Flags().String("ssl-ca-password", "", L("Password of the CA private key, will be prompted if not passed."))
+	_ = renewCmd.MarkFlagRequired("ssl-ca-key")
+	ssl.AddSSLGenerationFlags(renewCmd)
+	renewCmd.Flags().String("ssl-email", "", L("Email to set in the SSL certificate"))
+	_ = shared_utils.AddFlagToHelpGroupID(renewCmd, "ssl-email", "ssl")
+
+	api.AddAPIFlags(renewCmd)
+	shared_utils.AddBackendFlag(renewCmd)
+
+	return renewCmd
+}
+
+func newRenewCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	return newRenewCmd(globalFlags, renew)
+}
+
+func renew(globalFlags *types.GlobalFlags, flags *renewFlags, cmd *cobra.Command, args []string) error {
+	fn, err := shared.ChooseProxyPodmanOrKubernetes(cmd.Flags(), podmanRenew, kubernetesRenew)
+	if err != nil {
+		return err
+	}
+
+	return fn(globalFlags, flags, cmd, args)
+}
+
+// renewConfig requests a new certificate for the proxy configured in dir and replaces the SSL material in dir.
+//
+// The configuration in dir is only changed if the new certificate is valid.
+func renewConfig(flags *renewFlags, dir string, apiInit func(*api.ConnectionDetails) (*api.APIClient, error),
+	generate generateFunc,
+) error {
+	config, err := utils.ReadProxyConfig(dir)
+	if err != nil {
+		return err
+	}
+
+	if flags.ConnectionDetails.Server == "" {
+		flags.ConnectionDetails.Server = config.Server
+	}
+	client, err := apiInit(&flags.ConnectionDetails)
+	if err == nil {
+		err = client.Login()
+	}
+	if err != nil {
+		return shared_utils.Errorf(err, L("failed to connect to the server"))
+	}
+
+	caCertificate := config.CaCrt
+	if flags.SSL.Ca.Cert != "" {
+		caCertificate = string(shared_utils.ReadFile(flags.SSL.Ca.Cert))
+	}
+	caPassword := flags.SSL.Ca.Password
+	if caPassword == "" {
+		shared_utils.AskPasswordIfMissingOnce(&caPassword, L("Please enter SSL CA password"), 0, 0)
+	}
+
+	log.Info().Msgf(L("Requesting a new certificate for %s"), config.ProxyFQDN)
+	data, err := generate(client, proxy.ProxyConfigGenerateRequest{
+		ProxyName:  config.ProxyFQDN,
+		ProxyPort:  flags.Proxy.Port,
+		Server:     config.Server,
+		MaxCache:   config.MaxCache,
+		Email:      config.Email,
+		CaCrt:      caCertificate,
+		CaKey:      string(shared_utils.ReadFile(flags.SSL.Ca.Key)),
+		CaPassword: caPassword,
+		Cnames:     flags.SSL.Cnames,
+		Country:    flags.SSL.Country,
+		State:      flags.SSL.State,
+		City:       flags.SSL.City,
+		Org:        flags.SSL.Org,
+		OrgUnit:    flags.SSL.OU,
+		SSLEmail:   flags.SSL.Email,
+	})
+	if err != nil {
+		return shared_utils.Errorf(err, L("failed to execute proxy configuration api request"))
+	}
+
+	tmpDir, cleaner, err := shared_utils.TempDir()
+	if err != nil {
+		return err
+	}
+	defer cleaner()
+
+	tarball := path.Join(tmpDir, "config.tar.gz")
+	if err := shared_utils.SaveBinaryData(tarball, *data); err != nil {
+		return shared_utils.Errorf(err, L("error saving binary data: %v"), err)
+	}
+	newDir := path.Join(tmpDir, "config")
+	if err := os.Mkdir(newDir, 0700); err != nil {
+		return err
+	}
+	if err := shared_utils.ExtractTarGz(tarball, newDir); err != nil {
+		return shared_utils.Errorf(err, L("failed to extract proxy config from %s file"), tarball)
+	}
+
+	newConfig, err := utils.ReadProxyConfig(newDir)
+	if err != nil {
+		return err
+	}
+	problems, err := utils.ValidateProxyConfig(newConfig, time.Now())
+	if err != nil {
+		return err
+	}
+	if len(problems) > 0 {
+		for _, problem := range problems {
+			log.Error().Msg(problem)
+		}
+		return fmt.Errorf(L("%d problems found in the new certificate, the proxy configuration is unchanged"),
+			len(problems),
+		)
+	}
+
+	return utils.ReplaceSSLMaterial(dir, newDir)
+}
@@ -0,0 +1,209 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package certificate
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/mocks"
+	"github.com/uyuni-project/uyuni-tools/shared/api/proxy"
+	"github.com/uyuni-project/uyuni-tools/shared/testutils"
+	"github.com/uyuni-project/uyuni-tools/shared/testutils/flagstests"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+func TestParamsParsing(t *testing.T) {
+	args := []string{
+		"--backend", "kubectl",
+		"--proxy-sshPort", "22",
+		"--ssl-ca-cert", "path/ca.crt",
+		"--ssl-ca-key", "path/ca.key",
+		"--ssl-ca-password", "capass",
+		"--ssl-email", "ssl@example.com",
+	}
+	args = append(args, flagstests.APIFlagsTestArgs...)
+	args = append(args, flagstests.SSLGenerationFlagsTestArgs...)
+
+	// Test function asserting that the args are properly parsed
+	tester := func(_ *types.GlobalFlags, flags *renewFlags,
+		_ *cobra.Command, _ []string,
+	) error {
+		testutils.AssertEquals(t, "Error parsing --backend", "kubectl", flags.Backend)
+		testutils.AssertEquals(t, "Error parsing --proxy-sshPort", 22, flags.Proxy.Port)
+		testutils.AssertEquals(t, "Error parsing --ssl-ca-cert", "path/ca.crt", flags.SSL.Ca.Cert)
+		testutils.AssertEquals(t, "Error parsing --ssl-ca-key", "path/ca.key", flags.SSL.Ca.Key)
+		testutils.AssertEquals(t, "Error parsing --ssl-ca-password", "capass", flags.SSL.Ca.Password)
+		testutils.AssertEquals(t, "Error parsing --ssl-email", "ssl@example.com", flags.SSL.Email)
+		flagstests.AssertAPIFlags(t, &flags.ConnectionDetails)
+		flagstests.AssertSSLGenerationFlag(t, &flags.SSL.SSLCertGenerationFlags)
+		return nil
+	}
+
+	globalFlags := types.GlobalFlags{}
+	cmd := newRenewCmd(&globalFlags, tester)
+
+	testutils.AssertHasAllFlags(t, cmd, args)
+
+	cmd.SetArgs(args)
+	if err := cmd.Execute(); err != nil {
+		t.Errorf("command failed with error: %s", err)
+	}
+}
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  string
+}
+
+func newTestCA(t *testing.T) *testCA {
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "RootCA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(365 * 24 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("failed to create CA: %s", err)
+	}
+	cert, _ := x509.ParseCertificate(der)
+	return &testCA{cert: cert, key: key, pem: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))}
+}
+
+// sign returns a certificate and key for name in PEM format.
+func (ca *testCA) sign(t *testing.T, name string) (string, string) {
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(365 * 24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %s", err)
+	}
+	keyDer, _ := x509.MarshalPKCS8PrivateKey(key)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDer}))
+}
+
+// indent prefixes all the lines of a PEM value to use it as a YAML block.
+func indent(value string, prefix string) string {
+	return prefix + strings.ReplaceAll(strings.TrimSpace(value), "\n", "\n"+prefix) + "\n"
+}
+
+// writeTestConfig writes a proxy configuration with the given SSL material in dir.
+func writeTestConfig(t *testing.T, dir string, systemID string, ca string, cert string, key string) {
+	testutils.WriteFile(t, path.Join(dir, "config.yaml"), "server: uyuni.example.com\nproxy_fqdn: pxy.example.com\n"+
+		"max_cache_size_mb: 2048\nemail: admin@example.com\nca_crt: |\n"+indent(ca, "  "),
+	)
+	testutils.WriteFile(t, path.Join(dir, "httpd.yaml"), "httpd:\n  system_id: "+systemID+"\n"+
+		"  server_crt: |\n"+indent(cert, "    ")+"  server_key: |\n"+indent(key, "    "),
+	)
+}
+
+// fakeGenerate returns a function generating a proxy configuration with a certificate for name.
+func fakeGenerate(t *testing.T, ca *testCA, name string, request *proxy.ProxyConfigGenerateRequest) generateFunc {
+	return func(_ *api.APIClient, req proxy.ProxyConfigGenerateRequest) (*[]int8, error) {
+		*request = req
+		dir := t.TempDir()
+		cert, key := ca.sign(t, name)
+		writeTestConfig(t, dir, "NEWID", ca.pem, cert, key)
+
+		tarballPath := path.Join(t.TempDir(), "config.tar.gz")
+		tarball, err := utils.NewTarGz(tarballPath)
+		if err != nil {
+			t.Fatalf("failed to create tarball: %s", err)
+		}
+		for _, file := range []string{"config.yaml", "httpd.yaml"} {
+			if err := tarball.AddFile(path.Join(dir, file), file); err != nil {
+				t.Fatalf("failed to add %s to tarball: %s", file, err)
+			}
+		}
+		tarball.Close()
+
+		data, err := os.ReadFile(tarballPath)
+		if err != nil {
+			t.Fatalf("failed to read tarball: %s", err)
+		}
+		result := make([]int8, len(data))
+		for i, b := range data {
+			result[i] = int8(b)
+		}
+		return &result, nil
+	}
+}
+
+func mockLogin(conn *api.ConnectionDetails) (*api.APIClient, error) {
+	client, _ := api.Init(conn)
+	client.Client = &mocks.MockClient{
+		DoFunc: testutils.SuccessfulLoginTestDo,
+	}
+	return client, nil
+}
+
+func TestRenewConfig(t *testing.T) {
+	ca := newTestCA(t)
+	oldCert, oldKey := ca.sign(t, "pxy.example.com")
+	dir := t.TempDir()
+	writeTestConfig(t, dir, "OLDID", ca.pem, oldCert, oldKey)
+	caKey := path.Join(t.TempDir(), "ca.key")
+	testutils.WriteFile(t, caKey, "CAKEY")
+
+	flags := renewFlags{}
+	flags.ConnectionDetails = api.ConnectionDetails{User: "admin", Password: "secret"}
+	flags.Proxy.Port = 8022
+	flags.SSL.Ca.Key = caKey
+	flags.SSL.Ca.Password = "capass"
+
+	var request proxy.ProxyConfigGenerateRequest
+	if err := renewConfig(&flags, dir, mockLogin, fakeGenerate(t, ca, "pxy.example.com", &request)); err != nil {
+		t.Fatalf("failed to renew the certificate: %s", err)
+	}
+	testutils.AssertEquals(t, "The server should default to the parent", "uyuni.example.com",
+		flags.ConnectionDetails.Server,
+	)
+	testutils.AssertEquals(t, "Unexpected proxy name", "pxy.example.com", request.ProxyName)
+	testutils.AssertEquals(t, "Unexpected max cache", 2048, request.MaxCache)
+	testutils.AssertEquals(t, "Unexpected CA key", "CAKEY", request.CaKey)
+	testutils.AssertEquals(t, "The configured CA should be used", ca.pem, request.CaCrt)
+
+	httpd := testutils.ReadFile(t, path.Join(dir, "httpd.yaml"))
+	testutils.AssertTrue(t, "The system ID should be kept", strings.Contains(httpd, "OLDID"))
+	testutils.AssertTrue(t, "The certificate should be replaced",
+		!strings.Contains(httpd, indent(oldCert, "    ")),
+	)
+
+	// A certificate for another name is refused and the configuration left untouched
+	before := testutils.ReadFile(t, path.Join(dir, "httpd.yaml"))
+	if err := renewConfig(&flags, dir, mockLogin, fakeGenerate(t, ca, "other.example.com", &request)); err == nil {
+		t.Error("Expected an error for an invalid certificate")
+	}
+	testutils.AssertEquals(t, "The configuration should be unchanged", before,
+		testutils.ReadFile(t, path.Join(dir, "httpd.yaml")),
+	)
+}
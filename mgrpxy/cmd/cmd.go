@@ -11,10 +11,17 @@ import (
 
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
+	"github.com/uyuni-project/uyuni-tools/mgrpxy/cmd/backup"
 	"github.com/uyuni-project/uyuni-tools/mgrpxy/cmd/cache"
+	"github.com/uyuni-project/uyuni-tools/mgrpxy/cmd/certificate"
+	"github.com/uyuni-project/uyuni-tools/mgrpxy/cmd/config"
+	"github.com/uyuni-project/uyuni-tools/mgrpxy/cmd/doctor"
+	"github.com/uyuni-project/uyuni-tools/mgrpxy/cmd/images"
 	"github.com/uyuni-project/uyuni-tools/mgrpxy/cmd/install"
 	"github.com/uyuni-project/uyuni-tools/mgrpxy/cmd/logs"
+	"github.com/uyuni-project/uyuni-tools/mgrpxy/cmd/migrate"
 	"github.com/uyuni-project/uyuni-tools/mgrpxy/cmd/restart"
+	"github.com/uyuni-project/uyuni-tools/mgrpxy/cmd/rollback"
 	"github.com/uyuni-project/uyuni-tools/mgrpxy/cmd/start"
 	"github.com/uyuni-project/uyuni-tools/mgrpxy/cmd/status"
 	"github.com/uyuni-project/uyuni-tools/mgrpxy/cmd/stop"
@@ -71,15 +78,22 @@ func NewUyuniproxyCommand() (*cobra.Command, error) {
 
 	installCmd := install.NewCommand(globalFlags)
 	rootCmd.AddCommand(installCmd)
+	rootCmd.AddCommand(migrate.NewCommand(globalFlags))
 	uninstallCmd := uninstall.NewCommand(globalFlags)
 	rootCmd.AddCommand(uninstallCmd)
 	rootCmd.AddCommand(completion.NewCommand(globalFlags))
+	rootCmd.AddCommand(backup.NewCommand(globalFlags))
 	rootCmd.AddCommand(cache.NewCommand(globalFlags))
+	rootCmd.AddCommand(images.NewCommand(globalFlags))
+	rootCmd.AddCommand(certificate.NewCommand(globalFlags))
+	rootCmd.AddCommand(config.NewCommand(globalFlags))
 	rootCmd.AddCommand(status.NewCommand(globalFlags))
+	rootCmd.AddCommand(doctor.NewCommand(globalFlags))
 	rootCmd.AddCommand(start.NewCommand(globalFlags))
 	rootCmd.AddCommand(stop.NewCommand(globalFlags))
 	rootCmd.AddCommand(restart.NewCommand(globalFlags))
 	rootCmd.AddCommand(upgrade.NewCommand(globalFlags))
+	rootCmd.AddCommand(rollback.NewCommand(globalFlags))
 	rootCmd.AddCommand(logs.NewCommand(globalFlags))
 
 	if supportCommand := support.NewCommand(globalFlags); supportCommand != nil {
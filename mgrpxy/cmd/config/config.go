@@ -0,0 +1,27 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"github.com/spf13/cobra"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+)
+
+// NewCommand entry command for managing the proxy configuration.
+func NewCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	configCmd := &cobra.Command{
+		Use:     "config",
+		GroupID: "tool",
+		Short:   L("Manage the proxy configuration"),
+		Long:    L("Manage the proxy configuration"),
+		Run: func(cmd *cobra.Command, _ []string) {
+			_ = cmd.Help()
+		},
+	}
+
+	configCmd.AddCommand(newValidateCommand(globalFlags))
+	return configCmd
+}
@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	"github.com/uyuni-project/uyuni-tools/mgrpxy/shared/utils"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	shared_utils "github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+type validateFlags struct {
+}
+
+func newValidateCmd(globalFlags *types.GlobalFlags, run shared_utils.CommandFunc[validateFlags]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate [config.tar.gz]",
+		Short: L("Validate a proxy configuration"),
+		Long: L(`Validate a proxy configuration file generated by the server.
+
+The proxy certificate is checked against the CA chain, its expiry date, the proxy name and its key.
+Without configuration file, the configuration of the installed proxy is validated.`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var flags validateFlags
+			return shared_utils.CommandHelper(globalFlags, cmd, args, &flags, nil, run)
+		},
+	}
+	return cmd
+}
+
+func newValidateCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	return newValidateCmd(globalFlags, validate)
+}
+
+func validate(_ *types.GlobalFlags, _ *validateFlags, _ *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		return validateConfig(utils.ProxyConfigDir, time.Now())
+	}
+
+	tmpDir, cleaner, err := shared_utils.TempDir()
+	if err != nil {
+		return err
+	}
+	defer cleaner()
+
+	if err := shared_utils.ExtractTarGz(args[0], tmpDir); err != nil {
+		return shared_utils.Errorf(err, L("failed to extract proxy config from %s file"), args[0])
+	}
+	return validateConfig(tmpDir, time.Now())
+}
+
+// validateConfig logs the problems of the configuration in dir.
+func validateConfig(dir string, now time.Time) error {
+	config, err := utils.ReadProxyConfig(dir)
+	if err != nil {
+		return err
+	}
+	problems, err := utils.ValidateProxyConfig(config, now)
+	if err != nil {
+		return err
+	}
+	if len(problems) > 0 {
+		for _, problem := range problems {
+			log.Error().Msg(problem)
+		}
+		return fmt.Errorf(L("%d problems found in the proxy configuration"), len(problems))
+	}
+	log.Info().Msgf(L("The configuration of proxy %s is valid"), config.ProxyFQDN)
+	return nil
+}
@@ -0,0 +1,236 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package doctor
+
+import (
+	"bufio"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+)
+
+// parentPorts are the ports of the parent server the proxy needs to reach.
+var parentPorts = []int{443, 4505, 4506}
+
+// maxClockSkew is the maximum accepted time difference between the proxy and its parent.
+const maxClockSkew = 2 * time.Minute
+
+const dialTimeout = 5 * time.Second
+
+var lookupHost = net.LookupHost
+
+// checkResult is the outcome of a single check.
+type checkResult struct {
+	Check   string `json:"check"`
+	Passed  bool   `json:"passed"`
+	Message string `json:"message"`
+}
+
+func pass(check string, message string) checkResult {
+	return checkResult{Check: check, Passed: true, Message: message}
+}
+
+func fail(check string, message string) checkResult {
+	return checkResult{Check: check, Passed: false, Message: message}
+}
+
+// checkDNS checks that a host name can be resolved.
+func checkDNS(name string) checkResult {
+	check := "dns " + name
+	addresses, err := lookupHost(name)
+	if err != nil {
+		return fail(check, fmt.Sprintf(L("cannot resolve %[1]s: %[2]s"), name, err))
+	}
+	return pass(check, strings.Join(addresses, ", "))
+}
+
+// checkPort checks that a TCP port of a host can be reached.
+func checkPort(host string, port int) checkResult {
+	address := net.JoinHostPort(host, fmt.Sprint(port))
+	check := "tcp " + address
+	conn, err := net.DialTimeout("tcp", address, dialTimeout)
+	if err != nil {
+		return fail(check, fmt.Sprintf(L("cannot connect: %s"), err))
+	}
+	conn.Close()
+	return pass(check, L("reachable"))
+}
+
+// checkTLS connects to the HTTPS port of the server and checks the handshake,
+// the certificate chain against the CA and the clock difference with the server.
+func checkTLS(address string, serverName string, caCrt string, now time.Time) []checkResult {
+	handshakeCheck := "tls handshake"
+	chainCheck := "tls certificate chain"
+	clockCheck := "clock skew"
+
+	// The chain is verified separately to report the handshake and the chain problems independently
+	conn, err := tls.DialWithDialer(&net.Dialer{Timeout: dialTimeout}, "tcp", address, &tls.Config{
+		ServerName:         serverName,
+		InsecureSkipVerify: true, //nolint:gosec
+	})
+	if err != nil {
+		return []checkResult{fail(handshakeCheck, err.Error())}
+	}
+	defer conn.Close()
+	state := conn.ConnectionState()
+	results := []checkResult{pass(handshakeCheck, tls.VersionName(state.Version))}
+
+	results = append(results, verifyChain(chainCheck, state.PeerCertificates, serverName, caCrt, now))
+
+	serverTime, err := readServerTime(conn, serverName)
+	if err != nil {
+		return append(results, fail(clockCheck, fmt.Sprintf(L("cannot read the server time: %s"), err)))
+	}
+	skew := now.Sub(serverTime).Round(time.Second)
+	if skew < 0 {
+		skew = -skew
+	}
+	message := fmt.Sprintf(L("%s difference with the server"), skew)
+	if skew > maxClockSkew {
+		return append(results, fail(clockCheck, message))
+	}
+	return append(results, pass(clockCheck, message))
+}
+
+// verifyChain validates the certificates sent by the server against the CA.
+func verifyChain(check string, certs []*x509.Certificate, serverName string, caCrt string, now time.Time) checkResult {
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM([]byte(caCrt)) {
+		return fail(check, L("no valid CA certificate in the proxy configuration"))
+	}
+	if len(certs) == 0 {
+		return fail(check, L("no certificate sent by the server"))
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, err := certs[0].Verify(x509.VerifyOptions{
+		DNSName:       serverName,
+		Roots:         roots,
+		Intermediates: intermediates,
+		CurrentTime:   now,
+	})
+	var invalidErr x509.CertificateInvalidError
+	if errors.As(err, &invalidErr) && invalidErr.Reason == x509.Expired {
+		return fail(check, fmt.Sprintf(L("%[1]s: check the clock, local time is %[2]s"),
+			err, now.Format(time.RFC3339),
+		))
+	} else if err != nil {
+		return fail(check, err.Error())
+	}
+	return pass(check, certs[0].Subject.CommonName)
+}
+
+// readServerTime sends a request on the connection and returns the time from the Date header of the response.
+func readServerTime(conn io.ReadWriter, serverName string) (time.Time, error) {
+	request := fmt.Sprintf("HEAD / HTTP/1.1\r\nHost: %s\r\nConnection: close\r\n\r\n", serverName)
+	if _, err := conn.Write([]byte(request)); err != nil {
+		return time.Time{}, err
+	}
+	response, err := http.ReadResponse(bufio.NewReader(conn), nil)
+	if err != nil {
+		return time.Time{}, err
+	}
+	defer response.Body.Close()
+	return http.ParseTime(response.Header.Get("Date"))
+}
+
+// checkSSHKeys checks that the server SSH key is authorized in the SSH container.
+func checkSSHKeys(authorizedKeys string, serverKey string, err error) checkResult {
+	check := "ssh keys"
+	if serverKey == "" {
+		return fail(check, L("no server SSH key in the proxy configuration"))
+	}
+	if err != nil {
+		return fail(check, fmt.Sprintf(L("cannot read the authorized keys of the SSH container: %s"), err))
+	}
+
+	// Only compare the key type and data, not the comment
+	keyFields := strings.Fields(serverKey)
+	if len(keyFields) < 2 {
+		return fail(check, L("invalid server SSH key in the proxy configuration"))
+	}
+	for _, line := range strings.Split(authorizedKeys, "\n") {
+		fields := strings.Fields(line)
+		for i := 0; i+1 < len(fields); i++ {
+			if fields[i] == keyFields[0] && fields[i+1] == keyFields[1] {
+				return pass(check, L("the server SSH key is authorized"))
+			}
+		}
+	}
+	return fail(check, L("the server SSH key is not authorized in the SSH container"))
+}
+
+// runChecks runs the checks of the connection between the proxy and its parent server.
+//
+// The componentChecks are the results of the checks of the enabled proxy components added to the results.
+func runChecks(server string, proxyFQDN string, caCrt string, componentChecks ...checkResult) []checkResult {
+	results := []checkResult{checkDNS(server)}
+	if proxyFQDN != "" {
+		results = append(results, checkDNS(proxyFQDN))
+	}
+	if !results[0].Passed {
+		return append(results, componentChecks...)
+	}
+
+	portsReachable := true
+	for _, port := range parentPorts {
+		result := checkPort(server, port)
+		portsReachable = portsReachable && result.Passed
+		results = append(results, result)
+	}
+	if portsReachable {
+		results = append(results, checkTLS(net.JoinHostPort(server, "443"), server, caCrt, time.Now())...)
+	}
+	return append(results, componentChecks...)
+}
+
+// printResults writes the results in text or JSON format.
+func printResults(w io.Writer, format string, results []checkResult) error {
+	switch format {
+	case "json":
+		out, err := json.MarshalIndent(results, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w, string(out))
+		return err
+	case "text", "":
+		writer := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		for _, result := range results {
+			status := "PASS"
+			if !result.Passed {
+				status = "FAIL"
+			}
+			if _, err := fmt.Fprintf(writer, "%s\t%s\t%s\n", status, result.Check, result.Message); err != nil {
+				return err
+			}
+		}
+		return writer.Flush()
+	}
+	return fmt.Errorf(L("unsupported output format: %s"), format)
+}
+
+// countFailures returns the number of failed checks.
+func countFailures(results []checkResult) int {
+	failures := 0
+	for _, result := range results {
+		if !result.Passed {
+			failures++
+		}
+	}
+	return failures
+}
@@ -0,0 +1,158 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package doctor
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/uyuni-project/uyuni-tools/shared/testutils"
+)
+
+// newTestCA creates a CA and a server certificate for 127.0.0.1 it signed, valid for a day.
+func newTestCA(t *testing.T) (string, tls.Certificate) {
+	caKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "RootCA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	caDer, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatalf("failed to create CA: %s", err)
+	}
+
+	serverKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	serverTemplate := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "uyuni.example.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	serverDer, err := x509.CreateCertificate(rand.Reader, serverTemplate, caTemplate, &serverKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatalf("failed to create server certificate: %s", err)
+	}
+
+	caPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDer}))
+	return caPEM, tls.Certificate{Certificate: [][]byte{serverDer}, PrivateKey: serverKey}
+}
+
+func TestCheckTLS(t *testing.T) {
+	caPEM, serverCert := newTestCA(t)
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.TLS = &tls.Config{Certificates: []tls.Certificate{serverCert}}
+	server.StartTLS()
+	defer server.Close()
+	address := server.Listener.Addr().String()
+
+	assertResults := func(message string, results []checkResult, expected ...bool) {
+		testutils.AssertEquals(t, message+": unexpected number of results", len(expected), len(results))
+		for i, passed := range expected {
+			if i < len(results) && results[i].Passed != passed {
+				t.Errorf("%s: unexpected %s result: %s", message, results[i].Check, results[i].Message)
+			}
+		}
+	}
+
+	assertResults("Valid server", checkTLS(address, "127.0.0.1", caPEM, time.Now()), true, true, true)
+
+	otherCA, _ := newTestCA(t)
+	assertResults("Wrong CA", checkTLS(address, "127.0.0.1", otherCA, time.Now()), true, false, true)
+
+	results := checkTLS(address, "127.0.0.1", caPEM, time.Now().Add(48*time.Hour))
+	assertResults("Clock in the future", results, true, false, false)
+	testutils.AssertTrue(t, "The clock should be mentioned", strings.Contains(results[1].Message, "check the clock"))
+
+	assertResults("Skewed clock", checkTLS(address, "127.0.0.1", caPEM, time.Now().Add(10*time.Minute)),
+		true, true, false,
+	)
+}
+
+func TestCheckDNS(t *testing.T) {
+	lookupHost = func(host string) ([]string, error) {
+		if host == "uyuni.example.com" {
+			return []string{"192.168.1.2"}, nil
+		}
+		return nil, errors.New("no such host")
+	}
+	defer func() { lookupHost = net.LookupHost }()
+
+	testutils.AssertTrue(t, "The server should be resolved", checkDNS("uyuni.example.com").Passed)
+
+	results := runChecks("missing.example.com", "", "", pass("ssh keys", ""))
+	testutils.AssertEquals(t, "Other checks should be skipped when the server can't be resolved", 2, len(results))
+	testutils.AssertEquals(t, "Unexpected failures", 1, countFailures(results))
+
+	results = runChecks("missing.example.com", "", "")
+	testutils.AssertEquals(t, "Only the DNS check should run without component checks", 1, len(results))
+}
+
+func TestCheckSSHKeys(t *testing.T) {
+	serverKey := "ssh-ed25519 AAAAC3NzaC1 root@uyuni.example.com"
+	authorized := "# keys\nssh-rsa AAAAB3Nza other\nno-port-forwarding ssh-ed25519 AAAAC3NzaC1 root@uyuni\n"
+
+	testutils.AssertTrue(t, "The key should be authorized", checkSSHKeys(authorized, serverKey, nil).Passed)
+	testutils.AssertTrue(t, "The key should not be authorized",
+		!checkSSHKeys("ssh-rsa AAAAB3Nza other\n", serverKey, nil).Passed,
+	)
+	testutils.AssertTrue(t, "A missing key should fail", !checkSSHKeys(authorized, "", nil).Passed)
+	testutils.AssertTrue(t, "A stopped container should fail",
+		!checkSSHKeys("", serverKey, errors.New("container not running")).Passed,
+	)
+}
+
+func TestPrintResults(t *testing.T) {
+	results := []checkResult{
+		pass("dns uyuni.example.com", "192.168.1.2"),
+		fail("tcp uyuni.example.com:4505", "timeout"),
+	}
+
+	var text bytes.Buffer
+	if err := printResults(&text, "text", results); err != nil {
+		t.Fatalf("failed to print text: %s", err)
+	}
+	testutils.AssertEquals(t, "Unexpected text output",
+		"PASS  dns uyuni.example.com       192.168.1.2\nFAIL  tcp uyuni.example.com:4505  timeout\n", text.String(),
+	)
+
+	var out bytes.Buffer
+	if err := printResults(&out, "json", results); err != nil {
+		t.Fatalf("failed to print JSON: %s", err)
+	}
+	var parsed []checkResult
+	if err := json.Unmarshal(out.Bytes(), &parsed); err != nil {
+		t.Fatalf("invalid JSON output: %s", err)
+	}
+	testutils.AssertEquals(t, "Unexpected JSON results", results, parsed)
+
+	if err := printResults(&out, "yaml", results); err == nil {
+		t.Error("Expected an error for an unsupported format")
+	}
+}
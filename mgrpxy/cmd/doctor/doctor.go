@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package doctor
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	"github.com/uyuni-project/uyuni-tools/mgrpxy/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	shared_utils "github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+// authorizedKeysPath is the file of the SSH container listing the keys allowed to connect.
+const authorizedKeysPath = "/var/lib/spacewalk/mgrsshtunnel/.ssh/authorized_keys"
+
+type doctorFlags struct {
+	Backend string
+	Output  string
+}
+
+func newCmd(globalFlags *types.GlobalFlags, run shared_utils.CommandFunc[doctorFlags]) *cobra.Command {
+	doctorCmd := &cobra.Command{
+		Use:     "doctor",
+		GroupID: "tool",
+		Short:   L("Check the connection between the proxy and its parent server"),
+		Long: L(`Check the connection between the proxy and its parent server
+
+The parent server is read from the proxy configuration and the following checks are run:
+  - resolution of the parent server and proxy names,
+  - reachability of the parent server ports 443, 4505 and 4506,
+  - TLS handshake and validation of the parent server certificate against the proxy CA,
+  - clock difference with the parent server,
+  - authorization of the server SSH key in the SSH container, unless the ssh component is disabled.`),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var flags doctorFlags
+			return shared_utils.CommandHelper(globalFlags, cmd, args, &flags, nil, run)
+		},
+	}
+
+	doctorCmd.Flags().StringP("output", "o", "text", L("output format: text or json"))
+	shared_utils.AddBackendFlag(doctorCmd)
+
+	return doctorCmd
+}
+
+// NewCommand creates the command checking the proxy connection to its parent.
+func NewCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	return newCmd(globalFlags, doctor)
+}
+
+func doctor(globalFlags *types.GlobalFlags, flags *doctorFlags, cmd *cobra.Command, args []string) error {
+	fn, err := shared.ChooseProxyPodmanOrKubernetes(cmd.Flags(), podmanDoctor, kubernetesDoctor)
+	if err != nil {
+		return err
+	}
+
+	return fn(globalFlags, flags, cmd, args)
+}
+
+// diagnose runs the checks using the proxy configuration and SSH container connection and prints the results.
+//
+// The checks of the disabled components are skipped.
+func diagnose(config *utils.ProxyConfig, cnx *shared.Connection, disabled []string, format string) error {
+	componentChecks := []checkResult{}
+	if shared_utils.Contains(disabled, "ssh") {
+		log.Debug().Msg("Skipping the SSH keys check as the ssh component is disabled")
+	} else {
+		authorizedKeys, err := cnx.Exec("cat", authorizedKeysPath)
+		componentChecks = append(componentChecks, checkSSHKeys(string(authorizedKeys), config.SSHKeyPub, err))
+	}
+
+	results := runChecks(config.Server, config.ProxyFQDN, config.CaCrt, componentChecks...)
+	if err := printResults(os.Stdout, format, results); err != nil {
+		return err
+	}
+	if failures := countFailures(results); failures > 0 {
+		return fmt.Errorf(L("%d checks failed"), failures)
+	}
+	return nil
+}
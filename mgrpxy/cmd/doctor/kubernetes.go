@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package doctor
+
+import (
+	"github.com/spf13/cobra"
+	proxy_kubernetes "github.com/uyuni-project/uyuni-tools/mgrpxy/shared/kubernetes"
+	"github.com/uyuni-project/uyuni-tools/shared"
+	"github.com/uyuni-project/uyuni-tools/shared/kubernetes"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+)
+
+func kubernetesDoctor(
+	_ *types.GlobalFlags,
+	flags *doctorFlags,
+	_ *cobra.Command,
+	_ []string,
+) error {
+	config, err := proxy_kubernetes.ReadProxyConfig()
+	if err != nil {
+		return err
+	}
+	cnx := shared.NewConnection("kubectl", "ssh", kubernetes.ProxyFilter)
+	namespace, err := cnx.GetNamespace("")
+	if err != nil {
+		return err
+	}
+	disabled, err := proxy_kubernetes.DisabledComponents(namespace)
+	if err != nil {
+		return err
+	}
+	return diagnose(config, cnx, disabled, flags.Output)
+}
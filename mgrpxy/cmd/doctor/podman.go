@@ -0,0 +1,30 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package doctor
+
+import (
+	"github.com/spf13/cobra"
+	pxy_podman "github.com/uyuni-project/uyuni-tools/mgrpxy/shared/podman"
+	"github.com/uyuni-project/uyuni-tools/mgrpxy/shared/utils"
+	"github.com/uyuni-project/uyuni-tools/shared"
+	"github.com/uyuni-project/uyuni-tools/shared/podman"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+)
+
+var systemd podman.Systemd = podman.SystemdImpl{}
+
+func podmanDoctor(
+	_ *types.GlobalFlags,
+	flags *doctorFlags,
+	_ *cobra.Command,
+	_ []string,
+) error {
+	config, err := utils.ReadProxyConfig(utils.ProxyConfigDir)
+	if err != nil {
+		return err
+	}
+	cnx := shared.NewConnection("podman", "uyuni-proxy-ssh", "")
+	return diagnose(config, cnx, pxy_podman.DisabledComponents(systemd), flags.Output)
+}
@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package images
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/uyuni-project/uyuni-tools/mgrpxy/shared/podman"
+	pxy_utils "github.com/uyuni-project/uyuni-tools/mgrpxy/shared/utils"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+func newExportCmd(globalFlags *types.GlobalFlags, run utils.CommandFunc[podman.ImagesExportFlags]) *cobra.Command {
+	exportCmd := &cobra.Command{
+		Use:   "export output-directory",
+		Args:  cobra.ExactArgs(1),
+		Short: L("Export the proxy images"),
+		Long: L(`Export the proxy images
+
+Pull the images of the proxy components and save them with a manifest in the output directory.
+The directory can then be copied to a host without registry access and used with
+mgrpxy install podman --images-from.`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var flags podman.ImagesExportFlags
+			return utils.CommandHelper(globalFlags, cmd, args, &flags, nil, run)
+		},
+	}
+
+	pxy_utils.AddSCCFlag(exportCmd)
+	pxy_utils.AddImageFlags(exportCmd)
+
+	return exportCmd
+}
+
+// NewCommand creates the command to manage the proxy images.
+func NewCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	imagesCmd := &cobra.Command{
+		Use:     "images",
+		GroupID: "tool",
+		Short:   L("Manage the proxy images"),
+		Long:    L("Tools to prepare the proxy images for hosts without registry access"),
+		Run: func(cmd *cobra.Command, _ []string) {
+			_ = cmd.Help()
+		},
+	}
+	imagesCmd.AddCommand(newExportCmd(globalFlags, doExport))
+	return imagesCmd
+}
+
+func doExport(_ *types.GlobalFlags, flags *podman.ImagesExportFlags, _ *cobra.Command, args []string) error {
+	return podman.ExportImages(args[0], flags)
+}
@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package images
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/uyuni-project/uyuni-tools/mgrpxy/shared/podman"
+	"github.com/uyuni-project/uyuni-tools/shared/testutils"
+	"github.com/uyuni-project/uyuni-tools/shared/testutils/flagstests"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+)
+
+func TestExportParamsParsing(t *testing.T) {
+	args := []string{"/images"}
+	args = append(args, flagstests.ImageProxyFlagsTestArgs...)
+	args = append(args, flagstests.SCCFlagTestArgs...)
+
+	// Test function asserting that the args are properly parsed
+	tester := func(_ *types.GlobalFlags, flags *podman.ImagesExportFlags,
+		_ *cobra.Command, args []string,
+	) error {
+		flagstests.AssertProxyImageFlags(t, &flags.ProxyImageFlags)
+		flagstests.AssertSCCFlag(t, &flags.SCC)
+		testutils.AssertEquals(t, "Wrong output directory", "/images", args[0])
+		return nil
+	}
+
+	globalFlags := types.GlobalFlags{}
+	cmd := newExportCmd(&globalFlags, tester)
+
+	testutils.AssertHasAllFlags(t, cmd, args)
+
+	cmd.SetArgs(args)
+	if err := cmd.Execute(); err != nil {
+		t.Errorf("command failed with error: %s", err)
+	}
+}
@@ -12,7 +12,6 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/uyuni-project/uyuni-tools/mgrpxy/shared/kubernetes"
 	"github.com/uyuni-project/uyuni-tools/mgrpxy/shared/utils"
-	shared_kubernetes "github.com/uyuni-project/uyuni-tools/shared/kubernetes"
 	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
 	"github.com/uyuni-project/uyuni-tools/shared/types"
 	shared_utils "github.com/uyuni-project/uyuni-tools/shared/utils"
@@ -40,39 +39,5 @@ func installForKubernetes(_ *types.GlobalFlags,
 		return errors.New(L("failed to extract configuration"))
 	}
 
-	// Check the kubernetes cluster setup
-	clusterInfos, err := shared_kubernetes.CheckCluster()
-	if err != nil {
-		return err
-	}
-
-	// If installing on k3s, install the traefik helm config in manifests
-	isK3s := clusterInfos.IsK3s()
-	IsRke2 := clusterInfos.IsRke2()
-	ports := shared_utils.GetProxyPorts()
-	if isK3s {
-		err = shared_kubernetes.InstallK3sTraefikConfig(ports)
-	} else if IsRke2 {
-		err = shared_kubernetes.InstallRke2NginxConfig(ports, flags.Helm.Proxy.Namespace)
-	}
-	if err != nil {
-		return err
-	}
-
-	helmArgs := []string{"--set", "ingress=" + clusterInfos.Ingress}
-	helmArgs, err = shared_kubernetes.AddSCCSecret(
-		helmArgs, flags.Helm.Proxy.Namespace, &flags.SCC, shared_kubernetes.ProxyApp,
-	)
-	if err != nil {
-		return err
-	}
-
-	// Install the uyuni proxy helm chart
-	if err := kubernetes.Deploy(
-		&flags.ProxyImageFlags, &flags.Helm, tmpDir, clusterInfos.GetKubeconfig(), helmArgs...,
-	); err != nil {
-		return shared_utils.Errorf(err, L("cannot deploy proxy helm chart"))
-	}
-
-	return nil
+	return kubernetes.Install(&flags.ProxyImageFlags, &flags.Helm, &flags.SCC, tmpDir)
 }
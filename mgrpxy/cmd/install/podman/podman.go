@@ -37,6 +37,8 @@ NOTE: for now installing on a remote podman is not supported!
 	utils.AddSCCFlag(podmanCmd)
 	utils.AddImageFlags(podmanCmd)
 	shared_podman.AddPodmanArgFlag(podmanCmd)
+	podmanCmd.Flags().String("images-from", "",
+		L("folder created by mgrpxy images export to load the images from instead of pulling them"))
 
 	return podmanCmd
 }
@@ -18,6 +18,7 @@ import (
 func TestParamsParsing(t *testing.T) {
 	args := []string{
 		"config.tar.gz",
+		"--images-from", "path/to/images",
 	}
 	args = append(args, flagstests.ImageProxyFlagsTestArgs...)
 	args = append(args, flagstests.PodmanFlagsTestArgs...)
@@ -28,6 +29,7 @@ func TestParamsParsing(t *testing.T) {
 		flagstests.AssertProxyImageFlags(t, &flags.ProxyImageFlags)
 		flagstests.AssertPodmanInstallFlags(t, &flags.Podman)
 		flagstests.AssertSCCFlag(t, &flags.SCC)
+		testutils.AssertEquals(t, "Error parsing --images-from", "path/to/images", flags.Images.From)
 		return nil
 	}
 
@@ -19,15 +19,6 @@ import (
 
 var systemd shared_podman.Systemd = shared_podman.SystemdImpl{}
 
-// Start the proxy services.
-func startPod() error {
-	ret := systemd.IsServiceRunning(shared_podman.ProxyService)
-	if ret {
-		return systemd.RestartService(shared_podman.ProxyService)
-	}
-	return systemd.EnableService(shared_podman.ProxyService)
-}
-
 func installForPodman(
 	_ *types.GlobalFlags,
 	flags *podman.PodmanProxyFlags,
@@ -43,43 +34,5 @@ func installForPodman(
 		return shared_utils.Errorf(err, L("failed to retrieve proxy config files"))
 	}
 
-	hostData, err := shared_podman.InspectHost()
-	if err != nil {
-		return err
-	}
-
-	authFile, cleaner, err := shared_podman.PodmanLogin(hostData, flags.SCC)
-	if err != nil {
-		return shared_utils.Errorf(err, L("failed to login to registry.suse.com"))
-	}
-	defer cleaner()
-
-	httpdImage, err := podman.GetContainerImage(authFile, &flags.ProxyImageFlags, "httpd")
-	if err != nil {
-		return err
-	}
-	saltBrokerImage, err := podman.GetContainerImage(authFile, &flags.ProxyImageFlags, "salt-broker")
-	if err != nil {
-		return err
-	}
-	squidImage, err := podman.GetContainerImage(authFile, &flags.ProxyImageFlags, "squid")
-	if err != nil {
-		return err
-	}
-	sshImage, err := podman.GetContainerImage(authFile, &flags.ProxyImageFlags, "ssh")
-	if err != nil {
-		return err
-	}
-	tftpdImage, err := podman.GetContainerImage(authFile, &flags.ProxyImageFlags, "tftpd")
-	if err != nil {
-		return err
-	}
-
-	// Setup the systemd service configuration options
-	err = podman.GenerateSystemdService(systemd, httpdImage, saltBrokerImage, squidImage, sshImage, tftpdImage, flags)
-	if err != nil {
-		return err
-	}
-
-	return startPod()
+	return podman.Install(systemd, flags)
 }
@@ -10,6 +10,7 @@ import (
 
 	"github.com/rs/zerolog"
 	"github.com/spf13/cobra"
+	pxy_kubernetes "github.com/uyuni-project/uyuni-tools/mgrpxy/shared/kubernetes"
 	"github.com/uyuni-project/uyuni-tools/shared"
 	"github.com/uyuni-project/uyuni-tools/shared/kubernetes"
 	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
@@ -59,6 +60,13 @@ func kubernetesLogs(
 	} else if len(flags.Containers) == 1 {
 		commandArgs = append(commandArgs, flags.Containers[0], "--all-containers")
 	} else {
+		disabled, err := pxy_kubernetes.DisabledComponents(namespace)
+		if err != nil {
+			return err
+		}
+		if err := checkDisabled(args[1:], disabled, ""); err != nil {
+			return err
+		}
 		commandArgs = append(commandArgs, args...)
 	}
 
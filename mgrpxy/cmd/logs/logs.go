@@ -5,6 +5,7 @@
 package logs
 
 import (
+	"fmt"
 	"os/exec"
 	"strings"
 
@@ -89,6 +90,18 @@ func logs(globalFlags *types.GlobalFlags, flags *logsFlags, cmd *cobra.Command,
 	return fn(globalFlags, flags, cmd, args)
 }
 
+// checkDisabled returns an error if one of the containers is a disabled component.
+func checkDisabled(containers []string, disabled []string, prefix string) error {
+	for _, container := range containers {
+		for _, component := range disabled {
+			if container == prefix+component {
+				return fmt.Errorf(L("the %s component is disabled"), component)
+			}
+		}
+	}
+	return nil
+}
+
 func getContainerNames(_ *cobra.Command, args []string, _ string) ([]string, cobra.ShellCompDirective) {
 	var names []string
 
@@ -43,3 +43,16 @@ func TestParamsParsing(t *testing.T) {
 		t.Errorf("command failed with error: %s", err)
 	}
 }
+
+func TestCheckDisabled(t *testing.T) {
+	disabled := []string{"tftpd"}
+	if err := checkDisabled([]string{"uyuni-proxy-httpd", "uyuni-proxy-ssh"}, disabled, "uyuni-proxy-"); err != nil {
+		t.Errorf("Unexpected error for enabled containers: %s", err)
+	}
+	if err := checkDisabled([]string{"uyuni-proxy-tftpd"}, disabled, "uyuni-proxy-"); err == nil {
+		t.Error("Expected an error for a disabled container")
+	}
+	if err := checkDisabled([]string{"tftpd"}, disabled, ""); err == nil {
+		t.Error("Expected an error for a disabled kubernetes container")
+	}
+}
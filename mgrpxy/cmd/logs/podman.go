@@ -9,7 +9,8 @@ import (
 
 	"github.com/rs/zerolog"
 	"github.com/spf13/cobra"
-	"github.com/uyuni-project/uyuni-tools/shared/podman"
+	"github.com/uyuni-project/uyuni-tools/mgrpxy/shared/podman"
+	pxy_utils "github.com/uyuni-project/uyuni-tools/mgrpxy/shared/utils"
 	"github.com/uyuni-project/uyuni-tools/shared/types"
 	"github.com/uyuni-project/uyuni-tools/shared/utils"
 )
@@ -37,9 +38,15 @@ func podmanLogs(
 		commandArgs = append(commandArgs, fmt.Sprintf("--since=%s", flags.Since))
 	}
 
+	disabled := podman.DisabledComponents(systemd)
 	if len(flags.Containers) == 0 {
-		commandArgs = append(commandArgs, podman.ProxyContainerNames...)
+		for _, component := range pxy_utils.EnabledComponents(disabled) {
+			commandArgs = append(commandArgs, "uyuni-proxy-"+component)
+		}
 	} else {
+		if err := checkDisabled(args, disabled, "uyuni-proxy-"); err != nil {
+			return err
+		}
 		commandArgs = append(commandArgs, args...)
 	}
 
@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package kubernetes
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/uyuni-project/uyuni-tools/mgrpxy/cmd/migrate/shared"
+	"github.com/uyuni-project/uyuni-tools/mgrpxy/shared/kubernetes"
+	pxy_utils "github.com/uyuni-project/uyuni-tools/mgrpxy/shared/utils"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+type kubernetesMigrateFlags struct {
+	pxy_utils.ProxyImageFlags `mapstructure:",squash"`
+	Helm                      kubernetes.HelmFlags
+	SCC                       types.SCCCredentials
+	shared.MigrateFlags       `mapstructure:",squash"`
+}
+
+func newCmd(globalFlags *types.GlobalFlags, run utils.CommandFunc[kubernetesMigrateFlags]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "kubernetes [source proxy FQDN]",
+		Short: L("Migrate a remote proxy to containers running on a kubernetes cluster"),
+		Long: L(`Migrate a remote proxy to containers running on a kubernetes cluster
+
+The SSL certificates, system ID, squid settings and optionally the squid cache
+of the source proxy are copied to set up a proxy with the same identity.
+The services of the source proxy are stopped and started again if the migration fails.
+
+This migration command assumes a few things:
+  * the SSH configuration for the source proxy is complete, including user and
+    all needed options to connect to the machine,
+  * an SSH agent is started and the key to use to connect to the proxy is added to it,
+  * kubectl and helm are installed locally
+
+NOTE: migrating to a remote kubernetes cluster is not supported yet!
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var flags kubernetesMigrateFlags
+			return utils.CommandHelper(globalFlags, cmd, args, &flags, nil, run)
+		},
+	}
+
+	shared.AddMigrateFlags(cmd)
+	pxy_utils.AddImageFlags(cmd)
+	pxy_utils.AddSCCFlag(cmd)
+	kubernetes.AddHelmFlags(cmd)
+
+	return cmd
+}
+
+// NewCommand migrates a legacy proxy to a kubernetes cluster.
+func NewCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	return newCmd(globalFlags, migrateToKubernetes)
+}
@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package kubernetes
+
+import (
+	"fmt"
+	"os/exec"
+	"path"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	"github.com/uyuni-project/uyuni-tools/mgrpxy/cmd/migrate/shared"
+	"github.com/uyuni-project/uyuni-tools/mgrpxy/shared/kubernetes"
+	pxy_utils "github.com/uyuni-project/uyuni-tools/mgrpxy/shared/utils"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+func migrateToKubernetes(_ *types.GlobalFlags,
+	flags *kubernetesMigrateFlags, _ *cobra.Command, args []string,
+) (err error) {
+	for _, binary := range []string{"kubectl", "helm"} {
+		if _, err := exec.LookPath(binary); err != nil {
+			return fmt.Errorf(L("install %s before running this command"), binary)
+		}
+	}
+
+	source := shared.NewSourceProxy(args[0], flags.User)
+	config, err := source.FetchConfig()
+	if err != nil {
+		return err
+	}
+	if err := shared.Validate(config); err != nil {
+		return err
+	}
+
+	tmpDir, cleaner, err := utils.TempDir()
+	if err != nil {
+		return err
+	}
+	defer cleaner()
+
+	configDir := path.Join(tmpDir, "config")
+	if err := pxy_utils.WriteProxyConfig(configDir, config); err != nil {
+		return err
+	}
+
+	if err := source.Stop(); err != nil {
+		return err
+	}
+	defer func() {
+		// Leave the site with a working proxy if the migration failed
+		if err != nil {
+			if err := source.Start(); err != nil {
+				log.Error().Err(err).Msg(L("failed to restart the source proxy"))
+			}
+		}
+	}()
+
+	cachePath := path.Join(tmpDir, "squid-cache.tar.gz")
+	if flags.Cache {
+		if err := source.FetchCache(cachePath); err != nil {
+			return err
+		}
+	}
+
+	if err := kubernetes.Install(&flags.ProxyImageFlags, &flags.Helm, &flags.SCC, configDir); err != nil {
+		return err
+	}
+
+	if flags.Cache {
+		if err := kubernetes.ImportSquidCache(flags.Helm.Proxy.Namespace, cachePath); err != nil {
+			return err
+		}
+	}
+	log.Info().Msgf(L("Proxy %s migrated to kubernetes"), config.ProxyFQDN)
+	return nil
+}
@@ -0,0 +1,27 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package migrate
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/uyuni-project/uyuni-tools/mgrpxy/cmd/migrate/kubernetes"
+	"github.com/uyuni-project/uyuni-tools/mgrpxy/cmd/migrate/podman"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+)
+
+// NewCommand migrates a legacy proxy to containers.
+func NewCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	migrateCmd := &cobra.Command{
+		Use:     "migrate [source proxy FQDN]",
+		GroupID: "deploy",
+		Short:   L("Migrate a remote proxy to containers"),
+		Long:    L("Migrate a remote proxy to containers"),
+	}
+	migrateCmd.AddCommand(podman.NewCommand(globalFlags))
+	migrateCmd.AddCommand(kubernetes.NewCommand(globalFlags))
+
+	return migrateCmd
+}
@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package podman
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/uyuni-project/uyuni-tools/mgrpxy/cmd/migrate/shared"
+	"github.com/uyuni-project/uyuni-tools/mgrpxy/shared/podman"
+	"github.com/uyuni-project/uyuni-tools/mgrpxy/shared/utils"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	shared_podman "github.com/uyuni-project/uyuni-tools/shared/podman"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	shared_utils "github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+type podmanMigrateFlags struct {
+	podman.PodmanProxyFlags `mapstructure:",squash"`
+	shared.MigrateFlags     `mapstructure:",squash"`
+}
+
+func newCmd(globalFlags *types.GlobalFlags, run shared_utils.CommandFunc[podmanMigrateFlags]) *cobra.Command {
+	migrateCmd := &cobra.Command{
+		Use:   "podman [source proxy FQDN]",
+		Short: L("Migrate a remote proxy to containers running on podman"),
+		Long: L(`Migrate a remote proxy to containers running on podman
+
+The SSL certificates, system ID, squid settings and optionally the squid cache
+of the source proxy are copied to set up a proxy with the same identity.
+The services of the source proxy are stopped and started again if the migration fails.
+
+This migration command assumes a few things:
+  * the SSH configuration for the source proxy is complete, including user and
+    all needed options to connect to the machine,
+  * an SSH agent is started and the key to use to connect to the proxy is added to it,
+  * podman is installed locally
+
+NOTE: migrating to a remote podman is not supported yet!
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var flags podmanMigrateFlags
+			return shared_utils.CommandHelper(globalFlags, cmd, args, &flags, nil, run)
+		},
+	}
+
+	shared.AddMigrateFlags(migrateCmd)
+	utils.AddSCCFlag(migrateCmd)
+	utils.AddImageFlags(migrateCmd)
+	shared_podman.AddPodmanArgFlag(migrateCmd)
+
+	return migrateCmd
+}
+
+// NewCommand migrates a legacy proxy to podman.
+func NewCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	return newCmd(globalFlags, migrateToPodman)
+}
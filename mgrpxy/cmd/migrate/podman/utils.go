@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package podman
+
+import (
+	"errors"
+	"os/exec"
+	"path"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	"github.com/uyuni-project/uyuni-tools/mgrpxy/cmd/migrate/shared"
+	"github.com/uyuni-project/uyuni-tools/mgrpxy/shared/podman"
+	"github.com/uyuni-project/uyuni-tools/mgrpxy/shared/utils"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	shared_podman "github.com/uyuni-project/uyuni-tools/shared/podman"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	shared_utils "github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+var systemd shared_podman.Systemd = shared_podman.SystemdImpl{}
+
+func migrateToPodman(
+	_ *types.GlobalFlags,
+	flags *podmanMigrateFlags,
+	_ *cobra.Command,
+	args []string,
+) (err error) {
+	if _, err := exec.LookPath("podman"); err != nil {
+		return errors.New(L("install podman before running this command"))
+	}
+	if shared_utils.FileExists(path.Join(utils.ProxyConfigDir, "config.yaml")) {
+		return errors.New(L("a proxy is already configured on this machine"))
+	}
+
+	source := shared.NewSourceProxy(args[0], flags.User)
+	config, err := source.FetchConfig()
+	if err != nil {
+		return err
+	}
+	if err := shared.Validate(config); err != nil {
+		return err
+	}
+
+	tmpDir, cleaner, err := shared_utils.TempDir()
+	if err != nil {
+		return err
+	}
+	defer cleaner()
+
+	if err := source.Stop(); err != nil {
+		return err
+	}
+	defer func() {
+		// Leave the site with a working proxy if the migration failed
+		if err != nil {
+			if err := source.Start(); err != nil {
+				log.Error().Err(err).Msg(L("failed to restart the source proxy"))
+			}
+		}
+	}()
+
+	cachePath := path.Join(tmpDir, "squid-cache.tar.gz")
+	if flags.Cache {
+		if err := source.FetchCache(cachePath); err != nil {
+			return err
+		}
+	}
+
+	if err := utils.WriteProxyConfig(utils.ProxyConfigDir, config); err != nil {
+		return err
+	}
+	if err := podman.UnpackConfig(""); err != nil {
+		return shared_utils.Errorf(err, L("failed to retrieve proxy config files"))
+	}
+
+	if err := podman.Install(systemd, &flags.PodmanProxyFlags); err != nil {
+		return err
+	}
+
+	if flags.Cache {
+		if err := podman.ImportSquidCache(systemd, cachePath); err != nil {
+			return err
+		}
+	}
+	log.Info().Msgf(L("Proxy %s migrated to podman"), config.ProxyFQDN)
+	return nil
+}
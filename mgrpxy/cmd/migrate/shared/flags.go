@@ -0,0 +1,24 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shared
+
+import (
+	"github.com/spf13/cobra"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+)
+
+// MigrateFlags are the flags shared by the proxy migration commands.
+type MigrateFlags struct {
+	User  string
+	Cache bool
+}
+
+// AddMigrateFlags add the proxy migration flags to a command.
+func AddMigrateFlags(cmd *cobra.Command) {
+	cmd.Flags().String("user", "root",
+		L("User on the source proxy. Non-root user must have passwordless sudo privileges (NOPASSWD tag in /etc/sudoers)."),
+	)
+	cmd.Flags().Bool("cache", false, L("Also copy the squid cache of the source proxy."))
+}
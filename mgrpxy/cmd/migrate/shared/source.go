@@ -0,0 +1,250 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shared
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/uyuni-project/uyuni-tools/mgrpxy/shared/utils"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	shared_utils "github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+// Paths of the files on a legacy proxy.
+const (
+	rhnConfPath    = "/etc/rhn/rhn.conf"
+	systemIDPath   = "/etc/sysconfig/rhn/systemid"
+	serverCrtPath  = "/etc/apache2/ssl.crt/server.crt"
+	serverKeyPath  = "/etc/apache2/ssl.key/server.key"
+	squidConfPath  = "/etc/squid/squid.conf"
+	squidCacheDir  = "/var/cache/squid"
+	sshTunnelDir   = "/var/lib/spacewalk/mgrsshtunnel/.ssh"
+	sshPushKeyName = "id_susemanager_ssh_push"
+)
+
+// caCrtPaths are the locations of the CA certificate on a legacy proxy, by order of preference.
+var caCrtPaths = []string{
+	"/etc/pki/trust/anchors/RHN-ORG-TRUSTED-SSL-CERT",
+	"/usr/share/rhn/RHN-ORG-TRUSTED-SSL-CERT",
+	"/srv/www/htdocs/pub/RHN-ORG-TRUSTED-SSL-CERT",
+}
+
+// defaultMaxCache is the cache size in MB used when the source proxy has no squid disk cache.
+const defaultMaxCache = 102400
+
+var cacheDirRegex = regexp.MustCompile(`^\s*cache_dir\s+\S+\s+\S+\s+([0-9]+)`)
+
+// SourceProxy is a legacy proxy reached over SSH.
+type SourceProxy struct {
+	FQDN string
+	User string
+	// run executes a command on the source proxy and returns its output.
+	run func(args ...string) ([]byte, error)
+}
+
+// NewSourceProxy creates a source proxy running the commands over SSH.
+func NewSourceProxy(fqdn string, user string) *SourceProxy {
+	source := &SourceProxy{FQDN: fqdn, User: user}
+	source.run = func(args ...string) ([]byte, error) {
+		return shared_utils.RunCmdOutput(zerolog.DebugLevel, "ssh", source.sshArgs(args...)...)
+	}
+	return source
+}
+
+// sshArgs returns the ssh arguments to run a command on the source proxy.
+func (s *SourceProxy) sshArgs(args ...string) []string {
+	sshArgs := []string{s.User + "@" + s.FQDN}
+	if s.User != "root" {
+		sshArgs = append(sshArgs, "sudo")
+	}
+	return append(sshArgs, args...)
+}
+
+// readFile returns the content of a file of the source proxy.
+func (s *SourceProxy) readFile(file string) (string, error) {
+	out, err := s.run("cat", file)
+	if err != nil {
+		return "", shared_utils.Errorf(err, L("failed to read %[1]s on %[2]s"), file, s.FQDN)
+	}
+	return string(out), nil
+}
+
+// readFirstFile returns the content of the first existing file of the source proxy.
+func (s *SourceProxy) readFirstFile(files []string) (string, error) {
+	var err error
+	for _, file := range files {
+		var content string
+		if content, err = s.readFile(file); err == nil {
+			return content, nil
+		}
+		log.Debug().Err(err).Msgf("Cannot read %s", file)
+	}
+	return "", err
+}
+
+// FetchConfig reads the configuration of the source proxy.
+func (s *SourceProxy) FetchConfig() (*utils.ProxyConfig, error) {
+	log.Info().Msgf(L("Reading the configuration of %s"), s.FQDN)
+
+	fqdn, err := s.run("hostname", "-f")
+	if err != nil {
+		return nil, shared_utils.Errorf(err, L("failed to get the FQDN of %s"), s.FQDN)
+	}
+	config := utils.ProxyConfig{ProxyFQDN: strings.TrimSpace(string(fqdn))}
+
+	rhnConf, err := s.readFile(rhnConfPath)
+	if err != nil {
+		return nil, err
+	}
+	values := parseRhnConf(rhnConf)
+	config.Server = values["proxy.rhn_parent"]
+	if config.Server == "" {
+		return nil, fmt.Errorf(L("no parent server defined in %[1]s on %[2]s"), rhnConfPath, s.FQDN)
+	}
+	config.Email = values["traceback_mail"]
+
+	if config.SystemID, err = s.readFile(systemIDPath); err != nil {
+		return nil, err
+	}
+	if config.CaCrt, err = s.readFirstFile(caCrtPaths); err != nil {
+		return nil, err
+	}
+	if config.ServerCrt, err = s.readFile(serverCrtPath); err != nil {
+		return nil, err
+	}
+	if config.ServerKey, err = s.readFile(serverKeyPath); err != nil {
+		return nil, err
+	}
+
+	squidConf, err := s.readFile(squidConfPath)
+	if err != nil {
+		return nil, err
+	}
+	config.MaxCache = parseSquidCacheSize(squidConf)
+	if config.MaxCache == 0 {
+		log.Warn().Msgf(L("No squid disk cache configured on %[1]s, using %[2]d MB"), s.FQDN, defaultMaxCache)
+		config.MaxCache = defaultMaxCache
+	}
+
+	// The server key is only authorized on proxies configured for SSH push
+	if authorizedKeys, err := s.readFile(path.Join(sshTunnelDir, "authorized_keys")); err == nil {
+		config.SSHKeyPub = parseAuthorizedKey(authorizedKeys)
+	} else {
+		log.Warn().Msgf(L("No SSH push configuration found on %s"), s.FQDN)
+	}
+	if config.SSHPushKey, err = s.readFile(path.Join(sshTunnelDir, sshPushKeyName)); err == nil {
+		config.SSHPushKeyPub, _ = s.readFile(path.Join(sshTunnelDir, sshPushKeyName+".pub"))
+		config.SSHPushKeyPub = strings.TrimSpace(config.SSHPushKeyPub)
+	}
+
+	return &config, nil
+}
+
+// Validate checks the SSL material of the source proxy configuration.
+func Validate(config *utils.ProxyConfig) error {
+	problems, err := utils.ValidateProxyConfig(config, time.Now())
+	if err != nil {
+		return err
+	}
+	for _, problem := range problems {
+		log.Error().Msg(problem)
+	}
+	if len(problems) > 0 {
+		return errors.New(L("the configuration of the source proxy is not valid"))
+	}
+	return nil
+}
+
+// Stop stops the services of the source proxy.
+func (s *SourceProxy) Stop() error {
+	log.Info().Msgf(L("Stopping the proxy services on %s"), s.FQDN)
+	if _, err := s.run("spacewalk-proxy", "stop"); err != nil {
+		return shared_utils.Errorf(err, L("failed to stop the proxy services on %s"), s.FQDN)
+	}
+	return nil
+}
+
+// Start starts the services of the source proxy.
+func (s *SourceProxy) Start() error {
+	log.Info().Msgf(L("Starting the proxy services on %s"), s.FQDN)
+	if _, err := s.run("spacewalk-proxy", "start"); err != nil {
+		return shared_utils.Errorf(err, L("failed to start the proxy services on %s"), s.FQDN)
+	}
+	return nil
+}
+
+// FetchCache writes the squid cache of the source proxy in a tarball.
+func (s *SourceProxy) FetchCache(tarballPath string) error {
+	log.Info().Msgf(L("Copying the squid cache of %s"), s.FQDN)
+	tarball, err := os.Create(tarballPath)
+	if err != nil {
+		return shared_utils.Errorf(err, L("failed to create %s"), tarballPath)
+	}
+	defer tarball.Close()
+
+	args := s.sshArgs("tar", "czf", "-", "-C", squidCacheDir, ".")
+	log.Debug().Msgf("Running: ssh %s", strings.Join(args, " "))
+	cmd := exec.Command("ssh", args...)
+	cmd.Stdout = tarball
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return shared_utils.Errorf(err, L("failed to copy the squid cache of %s"), s.FQDN)
+	}
+	return nil
+}
+
+// parseRhnConf returns the values of a rhn.conf file.
+func parseRhnConf(content string) map[string]string {
+	values := map[string]string{}
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if key, value, found := strings.Cut(line, "="); found {
+			values[strings.TrimSpace(key)] = strings.TrimSpace(value)
+		}
+	}
+	return values
+}
+
+// parseSquidCacheSize returns the size in MB of the squid disk caches, or 0 if there is none.
+func parseSquidCacheSize(content string) int {
+	size := 0
+	for _, line := range strings.Split(content, "\n") {
+		if match := cacheDirRegex.FindStringSubmatch(line); match != nil {
+			value, _ := strconv.Atoi(match[1])
+			size += value
+		}
+	}
+	return size
+}
+
+// parseAuthorizedKey returns the first key of an authorized_keys file, without its options.
+func parseAuthorizedKey(content string) string {
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		for i, field := range fields {
+			if strings.HasPrefix(field, "ssh-") || strings.HasPrefix(field, "ecdsa-") {
+				return strings.Join(fields[i:], " ")
+			}
+		}
+	}
+	return ""
+}
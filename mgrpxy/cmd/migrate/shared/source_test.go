@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shared
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/uyuni-project/uyuni-tools/shared/testutils"
+)
+
+func TestSSHArgs(t *testing.T) {
+	root := SourceProxy{FQDN: "pxy.example.com", User: "root"}
+	testutils.AssertEquals(t, "Unexpected root command", "root@pxy.example.com cat /etc/rhn/rhn.conf",
+		strings.Join(root.sshArgs("cat", rhnConfPath), " "),
+	)
+	sudoer := SourceProxy{FQDN: "pxy.example.com", User: "admin"}
+	testutils.AssertEquals(t, "Non-root users should use sudo", "admin@pxy.example.com sudo cat /etc/rhn/rhn.conf",
+		strings.Join(sudoer.sshArgs("cat", rhnConfPath), " "),
+	)
+}
+
+func TestParseSquidCacheSize(t *testing.T) {
+	type testCase struct {
+		conf     string
+		expected int
+	}
+	data := []testCase{
+		{"cache_dir ufs /var/cache/squid 15000 16 256\n", 15000},
+		{"# cache_dir ufs /var/cache/squid 100 16 256\n" +
+			"cache_dir aufs /c1 10 16 256\ncache_dir aufs /c2 20 16 256\n", 30},
+		{"http_port 8080\n", 0},
+	}
+	for i, test := range data {
+		testutils.AssertEquals(t, fmt.Sprintf("case %d: unexpected cache size", i),
+			test.expected, parseSquidCacheSize(test.conf),
+		)
+	}
+}
+
+func TestParseAuthorizedKey(t *testing.T) {
+	data := [][]string{
+		{"ssh-rsa AAAA root@uyuni\n", "ssh-rsa AAAA root@uyuni"},
+		{"# comment\n\ncommand=\"/bin/true\",no-pty ecdsa-sha2-nistp256 BBBB root@uyuni\n",
+			"ecdsa-sha2-nistp256 BBBB root@uyuni"},
+		{"", ""},
+	}
+	for i, test := range data {
+		testutils.AssertEquals(t, fmt.Sprintf("case %d: unexpected key", i), test[1], parseAuthorizedKey(test[0]))
+	}
+}
+
+func TestFetchConfig(t *testing.T) {
+	files := map[string]string{
+		rhnConfPath: "# Proxy configuration\ntraceback_mail = admin@example.com\n" +
+			"proxy.rhn_parent = uyuni.example.com\n",
+		systemIDPath:                        "<systemid/>\n",
+		caCrtPaths[1]:                       "CA\n",
+		serverCrtPath:                       "CERT\n",
+		serverKeyPath:                       "KEY\n",
+		squidConfPath:                       "cache_dir ufs /var/cache/squid 15000 16 256\n",
+		sshTunnelDir + "/authorized_keys":   "ssh-rsa AAAA root@uyuni\n",
+		sshTunnelDir + "/" + sshPushKeyName: "PUSHKEY\n",
+		sshTunnelDir + "/" + sshPushKeyName + ".pub": "ssh-rsa BBBB mgrsshtunnel@pxy\n",
+	}
+	source := SourceProxy{FQDN: "pxy", User: "root"}
+	source.run = func(args ...string) ([]byte, error) {
+		switch args[0] {
+		case "hostname":
+			return []byte("pxy.example.com\n"), nil
+		case "cat":
+			if content, ok := files[args[1]]; ok {
+				return []byte(content), nil
+			}
+			return nil, errors.New("no such file")
+		}
+		return nil, fmt.Errorf("unexpected command %s", args[0])
+	}
+
+	config, err := source.FetchConfig()
+	if err != nil {
+		t.Fatalf("failed to fetch the configuration: %s", err)
+	}
+	testutils.AssertEquals(t, "Unexpected proxy FQDN", "pxy.example.com", config.ProxyFQDN)
+	testutils.AssertEquals(t, "Unexpected server", "uyuni.example.com", config.Server)
+	testutils.AssertEquals(t, "Unexpected email", "admin@example.com", config.Email)
+	testutils.AssertEquals(t, "Unexpected max cache", 15000, config.MaxCache)
+	testutils.AssertEquals(t, "The CA should be read from the first existing file", "CA\n", config.CaCrt)
+	testutils.AssertEquals(t, "Unexpected system ID", "<systemid/>\n", config.SystemID)
+	testutils.AssertEquals(t, "Unexpected certificate", "CERT\n", config.ServerCrt)
+	testutils.AssertEquals(t, "Unexpected key", "KEY\n", config.ServerKey)
+	testutils.AssertEquals(t, "Unexpected server SSH key", "ssh-rsa AAAA root@uyuni", config.SSHKeyPub)
+	testutils.AssertEquals(t, "Unexpected push key", "PUSHKEY\n", config.SSHPushKey)
+	testutils.AssertEquals(t, "Unexpected push public key", "ssh-rsa BBBB mgrsshtunnel@pxy", config.SSHPushKeyPub)
+
+	// The parent server is required
+	files[rhnConfPath] = "traceback_mail = admin@example.com\n"
+	if _, err := source.FetchConfig(); err == nil {
+		t.Error("Expected an error without parent server")
+	}
+}
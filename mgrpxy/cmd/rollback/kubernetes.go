@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rollback
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/uyuni-project/uyuni-tools/shared"
+	"github.com/uyuni-project/uyuni-tools/shared/kubernetes"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+func kubernetesRollback(
+	_ *types.GlobalFlags,
+	_ *rollbackFlags,
+	_ *cobra.Command,
+	_ []string,
+) error {
+	clusterInfos, err := kubernetes.CheckCluster()
+	if err != nil {
+		return err
+	}
+
+	cnx := shared.NewConnection("kubectl", "", kubernetes.ProxyFilter)
+	namespace, err := cnx.GetNamespace("")
+	if err != nil {
+		return utils.Errorf(err, L("failed retrieving namespace"))
+	}
+
+	if err := kubernetes.HelmRollback(clusterInfos.GetKubeconfig(), namespace, kubernetes.ProxyApp, 0); err != nil {
+		return err
+	}
+	return kubernetes.WaitForDeployments(namespace, kubernetes.ProxyApp)
+}
@@ -0,0 +1,23 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rollback
+
+import (
+	"github.com/spf13/cobra"
+	pxy_podman "github.com/uyuni-project/uyuni-tools/mgrpxy/shared/podman"
+	"github.com/uyuni-project/uyuni-tools/shared/podman"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+)
+
+var systemd podman.Systemd = podman.SystemdImpl{}
+
+func podmanRollback(
+	_ *types.GlobalFlags,
+	_ *rollbackFlags,
+	_ *cobra.Command,
+	_ []string,
+) error {
+	return pxy_podman.Rollback(systemd)
+}
@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rollback
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/uyuni-project/uyuni-tools/shared"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+type rollbackFlags struct {
+	Backend string
+}
+
+func newCmd(globalFlags *types.GlobalFlags, run utils.CommandFunc[rollbackFlags]) *cobra.Command {
+	rollbackCmd := &cobra.Command{
+		Use:     "rollback",
+		GroupID: "deploy",
+		Short:   L("Roll back the last proxy upgrade"),
+		Long: L(`Roll back the last proxy upgrade
+
+On podman, the systemd services and their configuration saved before the last upgrade are restored
+and the proxy is restarted with the previous images, identified by their IDs.
+The rollback fails if one of those images has been removed since the upgrade.
+On kubernetes, the helm release is rolled back to its previous revision.`),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var flags rollbackFlags
+			return utils.CommandHelper(globalFlags, cmd, args, &flags, nil, run)
+		},
+	}
+	rollbackCmd.SetUsageTemplate(rollbackCmd.UsageTemplate())
+
+	utils.AddBackendFlag(rollbackCmd)
+
+	return rollbackCmd
+}
+
+// NewCommand to roll back the last proxy upgrade.
+func NewCommand(globalFlags *types.GlobalFlags) *cobra.Command {
+	return newCmd(globalFlags, rollback)
+}
+
+func rollback(globalFlags *types.GlobalFlags, flags *rollbackFlags, cmd *cobra.Command, args []string) error {
+	fn, err := shared.ChooseProxyPodmanOrKubernetes(cmd.Flags(), podmanRollback, kubernetesRollback)
+	if err != nil {
+		return err
+	}
+
+	return fn(globalFlags, flags, cmd, args)
+}
@@ -6,9 +6,11 @@ package status
 
 import (
 	"errors"
+	"strings"
 
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
+	pxy_kubernetes "github.com/uyuni-project/uyuni-tools/mgrpxy/shared/kubernetes"
 	"github.com/uyuni-project/uyuni-tools/shared"
 	"github.com/uyuni-project/uyuni-tools/shared/kubernetes"
 	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
@@ -41,6 +43,14 @@ func kubernetesStatus(
 		return errors.New(L("the pod is not running"))
 	}
 
+	disabled, err := pxy_kubernetes.DisabledComponents(namespace)
+	if err != nil {
+		return err
+	}
+	if len(disabled) > 0 {
+		log.Info().Msgf(L("Disabled components: %s"), strings.Join(disabled, ", "))
+	}
+
 	log.Info().Msg(L("Proxy containers up and running"))
 
 	return nil
@@ -7,10 +7,13 @@ package status
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
+	"github.com/uyuni-project/uyuni-tools/mgrpxy/shared/podman"
+	pxy_utils "github.com/uyuni-project/uyuni-tools/mgrpxy/shared/utils"
 	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
 	"github.com/uyuni-project/uyuni-tools/shared/types"
 	"github.com/uyuni-project/uyuni-tools/shared/utils"
@@ -23,7 +26,11 @@ func podmanStatus(
 	_ []string,
 ) error {
 	var returnErr error
-	services := []string{"httpd", "salt-broker", "squid", "ssh", "tftpd", "pod"}
+	disabled := podman.DisabledComponents(systemd)
+	if len(disabled) > 0 {
+		log.Info().Msgf(L("Disabled components: %s"), strings.Join(disabled, ", "))
+	}
+	services := append(pxy_utils.EnabledComponents(disabled), "pod")
 	for _, service := range services {
 		serviceName := fmt.Sprintf("uyuni-proxy-%s", service)
 		if err := utils.RunCmdStdMapping(zerolog.DebugLevel, "systemctl", "status", "--no-pager", serviceName); err != nil {
@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package kubernetes
+
+import (
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/uyuni-project/uyuni-tools/shared"
+	"github.com/uyuni-project/uyuni-tools/shared/kubernetes"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	shared_utils "github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+// podCachePath is where the squid cache tarball is copied in the squid container.
+const podCachePath = "/tmp/squid-cache.tar.gz"
+
+// ImportSquidCache extracts a squid cache tarball in the squid container and restarts the proxy to index it.
+func ImportSquidCache(namespace string, tarballPath string) error {
+	log.Info().Msg(L("Importing the squid cache"))
+	cnx := shared.NewConnection("kubectl", "squid", kubernetes.ProxyFilter)
+	podName, err := cnx.GetPodName()
+	if err != nil {
+		return err
+	}
+
+	if err := shared_utils.RunCmdStdMapping(zerolog.DebugLevel, "kubectl", "cp", "-c", "squid", "-n", namespace,
+		tarballPath, podName+":"+podCachePath,
+	); err != nil {
+		return shared_utils.Errorf(err, L("failed to copy the squid cache to the proxy pod"))
+	}
+
+	cacheDir := shared_utils.ProxySquidVolumes[0].MountPath
+	_, err = cnx.Exec("sh", "-c", "tar xzf "+podCachePath+" -C "+cacheDir+" && chown -R squid:squid "+cacheDir)
+	if _, rmErr := cnx.Exec("rm", "-f", podCachePath); rmErr != nil {
+		log.Warn().Err(rmErr).Msgf(L("failed to remove %s from the proxy pod"), podCachePath)
+	}
+	if err != nil {
+		return shared_utils.Errorf(err, L("failed to extract the squid cache"))
+	}
+	return kubernetes.Restart(namespace, kubernetes.ProxyApp)
+}
@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package kubernetes
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"path"
+
+	"github.com/rs/zerolog"
+	"github.com/uyuni-project/uyuni-tools/mgrpxy/shared/utils"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	shared_utils "github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+// ReadProxyConfig reads the proxy configuration from the config map and secret of the cluster.
+func ReadProxyConfig() (*utils.ProxyConfig, error) {
+	tmpDir, cleaner, err := shared_utils.TempDir()
+	if err != nil {
+		return nil, err
+	}
+	defer cleaner()
+
+	if err := GetProxyConfigFiles(tmpDir); err != nil {
+		return nil, err
+	}
+	return utils.ReadProxyConfig(tmpDir)
+}
+
+// GetProxyConfigFiles writes the config.yaml, httpd.yaml and ssh.yaml files of the proxy in the cluster to dir.
+func GetProxyConfigFiles(dir string) error {
+	if _, err := getConfigYaml(dir); err != nil {
+		return err
+	}
+	if _, err := getHTTPDYaml(dir); err != nil {
+		return err
+	}
+	_, err := getSSHYaml(dir)
+	return err
+}
+
+// UpdateProxyConfig replaces the config.yaml and httpd.yaml of the proxy in the cluster with the files in dir.
+func UpdateProxyConfig(namespace string, dir string) error {
+	configData, err := os.ReadFile(path.Join(dir, "config.yaml"))
+	if err != nil {
+		return err
+	}
+	httpdData, err := os.ReadFile(path.Join(dir, "httpd.yaml"))
+	if err != nil {
+		return err
+	}
+
+	configPatch, err := json.Marshal(map[string]interface{}{
+		"data": map[string]string{"config.yaml": string(configData)},
+	})
+	if err != nil {
+		return err
+	}
+	if err := shared_utils.RunCmd("kubectl", "patch", "configmap", "proxy-configMap", "-n", namespace,
+		"--type", "merge", "-p", string(configPatch),
+	); err != nil {
+		return shared_utils.Errorf(err, L("failed to update the proxy configuration"))
+	}
+
+	// The patch value is not logged as it contains the proxy key
+	secretPatch, err := json.Marshal(map[string]interface{}{
+		"data": map[string]string{"httpd.yaml": base64.StdEncoding.EncodeToString(httpdData)},
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := shared_utils.RunCmdOutput(zerolog.Disabled, "kubectl", "patch", "secret", "proxy-secret",
+		"-n", namespace, "--type", "merge", "-p", string(secretPatch),
+	); err != nil {
+		return shared_utils.Errorf(err, L("failed to update the proxy secret"))
+	}
+	return nil
+}
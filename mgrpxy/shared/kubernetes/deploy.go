@@ -10,12 +10,15 @@ import (
 	"os/exec"
 	"path"
 	"path/filepath"
+	"strings"
 
+	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
 	"github.com/uyuni-project/uyuni-tools/mgrpxy/shared/utils"
 	"github.com/uyuni-project/uyuni-tools/shared/kubernetes"
 	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
 	shared_utils "github.com/uyuni-project/uyuni-tools/shared/utils"
 )
 
@@ -27,6 +30,66 @@ type KubernetesProxyUpgradeFlags struct {
 	Helm                  HelmFlags
 }
 
+// Install prepares the cluster and deploys the proxy with the configuration files in configDir.
+func Install(imageFlags *utils.ProxyImageFlags, helmFlags *HelmFlags, scc *types.SCCCredentials,
+	configDir string,
+) error {
+	if err := imageFlags.ValidateDisabled(); err != nil {
+		return err
+	}
+
+	// Check the kubernetes cluster setup
+	clusterInfos, err := kubernetes.CheckCluster()
+	if err != nil {
+		return err
+	}
+
+	if err := configureIngressPorts(clusterInfos, helmFlags.Proxy.Namespace, imageFlags.Disable); err != nil {
+		return err
+	}
+
+	helmArgs := []string{"--set", "ingress=" + clusterInfos.Ingress}
+	helmArgs, err = kubernetes.AddSCCSecret(helmArgs, helmFlags.Proxy.Namespace, scc, kubernetes.ProxyApp)
+	if err != nil {
+		return err
+	}
+
+	// Install the uyuni proxy helm chart
+	if err := Deploy(imageFlags, helmFlags, configDir, clusterInfos.GetKubeconfig(), helmArgs...); err != nil {
+		return shared_utils.Errorf(err, L("cannot deploy proxy helm chart"))
+	}
+	return nil
+}
+
+// configureIngressPorts exposes the ports of the enabled components on k3s and rke2 ingresses.
+func configureIngressPorts(clusterInfos *kubernetes.ClusterInfos, namespace string, disabled []string) error {
+	ports := utils.EnabledPorts(shared_utils.GetProxyPorts(), disabled)
+	if clusterInfos.IsK3s() {
+		return kubernetes.InstallK3sTraefikConfig(ports)
+	} else if clusterInfos.IsRke2() {
+		return kubernetes.InstallRke2NginxConfig(ports, namespace)
+	}
+	return nil
+}
+
+// DisabledComponents returns the optional components without container in the proxy deployment.
+func DisabledComponents(namespace string) ([]string, error) {
+	out, err := shared_utils.RunCmdOutput(zerolog.DebugLevel, "kubectl", "get", "deploy", kubernetes.ProxyApp,
+		"-n", namespace, "-o", "jsonpath={.spec.template.spec.containers[*].name}",
+	)
+	if err != nil {
+		return nil, shared_utils.Errorf(err, L("failed to get the containers of the proxy deployment"))
+	}
+	containers := strings.Fields(string(out))
+	disabled := []string{}
+	for _, component := range utils.OptionalComponents {
+		if !shared_utils.Contains(containers, component) {
+			disabled = append(disabled, component)
+		}
+	}
+	return disabled, nil
+}
+
 // Deploy will deploy proxy in kubernetes.
 func Deploy(imageFlags *utils.ProxyImageFlags, helmFlags *HelmFlags, configDir string,
 	kubeconfig string, helmArgs ...string,
@@ -88,6 +151,11 @@ func Deploy(imageFlags *utils.ProxyImageFlags, helmFlags *HelmFlags, configDir s
 		"--set", "version="+imageFlags.Tag,
 		"--set", "pullPolicy="+string(kubernetes.GetPullPolicy(imageFlags.PullPolicy)))
 
+	for _, component := range utils.OptionalComponents {
+		enabled := imageFlags.IsEnabled(component)
+		helmParams = append(helmParams, "--set", fmt.Sprintf("%s.enabled=%t", component, enabled))
+	}
+
 	helmParams = append(helmParams, helmArgs...)
 
 	// Install the helm chart
@@ -146,12 +214,15 @@ func getConfigYaml(directory string) (string, error) {
 }
 
 // Upgrade will upgrade the current kubernetes proxy.
-func Upgrade(flags *KubernetesProxyUpgradeFlags, _ *cobra.Command, _ []string) error {
+func Upgrade(flags *KubernetesProxyUpgradeFlags, cmd *cobra.Command, _ []string) error {
 	for _, binary := range []string{"kubectl", "helm"} {
 		if _, err := exec.LookPath(binary); err != nil {
 			return fmt.Errorf(L("install %s before running this command"), binary)
 		}
 	}
+	if err := flags.ValidateDisabled(); err != nil {
+		return err
+	}
 
 	tmpDir, cleaner, err := shared_utils.TempDir()
 	if err != nil {
@@ -166,6 +237,16 @@ func Upgrade(flags *KubernetesProxyUpgradeFlags, _ *cobra.Command, _ []string) e
 	}
 
 	namespace := flags.Helm.Proxy.Namespace
+
+	// Keep the components disabled at installation time unless requested otherwise
+	if cmd.Flags().Changed("disable") {
+		if err := configureIngressPorts(clusterInfos, namespace, flags.Disable); err != nil {
+			return err
+		}
+	} else if flags.Disable, err = DisabledComponents(namespace); err != nil {
+		return err
+	}
+
 	if _, err = kubernetes.GetNode(namespace, kubernetes.ProxyApp); err != nil {
 		if err := kubernetes.ReplicasTo(namespace, kubernetes.ProxyApp, 1); err != nil {
 			return err
@@ -0,0 +1,337 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package podman
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/uyuni-project/uyuni-tools/mgrpxy/shared/utils"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/podman"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	shared_utils "github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+const (
+	backupConfigFile  = "config.tar.gz"
+	backupSystemdFile = "systemd.tar.gz"
+	backupImagesDir   = "images"
+	backupVolumesDir  = "volumes"
+)
+
+// BackupFlags are the options of a proxy backup.
+type BackupFlags struct {
+	Volumes    bool
+	SkipImages bool `mapstructure:"skipimages"`
+}
+
+// RestoreFlags are the options of a proxy restore.
+type RestoreFlags struct {
+	SkipImages  bool `mapstructure:"skipimages"`
+	SkipVolumes bool `mapstructure:"skipvolumes"`
+	SkipVerify  bool `mapstructure:"skipverify"`
+	Force       bool
+	Restart     bool
+}
+
+// proxyVolumes returns the names of the volumes of the proxy.
+func proxyVolumes() []string {
+	volumes := []string{}
+	for _, mounts := range [][]types.VolumeMount{shared_utils.ProxyHttpdVolumes, shared_utils.ProxySquidVolumes} {
+		for _, mount := range mounts {
+			if !shared_utils.Contains(volumes, mount.Name) {
+				volumes = append(volumes, mount.Name)
+			}
+		}
+	}
+	return volumes
+}
+
+// Backup saves the proxy configuration, systemd services, images and optionally volumes in outputDir.
+func Backup(systemd podman.Systemd, outputDir string, flags *BackupFlags) error {
+	if shared_utils.FileExists(outputDir) && !shared_utils.IsEmptyDirectory(outputDir) {
+		return fmt.Errorf(L("output directory %s already exists and is not empty"), outputDir)
+	}
+	if !systemd.HasService(podman.ProxyService) {
+		return errors.New(L("no installed proxy to back up"))
+	}
+	imagesDir := path.Join(outputDir, backupImagesDir)
+	volumesDir := path.Join(outputDir, backupVolumesDir)
+	for _, dir := range []string{outputDir, imagesDir, volumesDir} {
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			return shared_utils.Errorf(err, L("failed to create folder %s"), dir)
+		}
+	}
+
+	log.Info().Msg(L("Backing up the proxy configuration"))
+	if err := archiveDir(utils.ProxyConfigDir, path.Join(outputDir, backupConfigFile)); err != nil {
+		return err
+	}
+
+	log.Info().Msg(L("Backing up the systemd services"))
+	images, err := backupServices(servicesDir(), path.Join(outputDir, backupSystemdFile))
+	if err != nil {
+		return err
+	}
+
+	if !flags.SkipImages {
+		log.Info().Msg(L("Backing up the container images"))
+		for _, image := range images {
+			// ExportImage silently skips the missing images
+			if !imageExists(image) {
+				return fmt.Errorf(
+					L("image %s of the proxy services is missing. Use --skipimages to back up without it"), image,
+				)
+			}
+			if err := podman.ExportImage(image, imagesDir, false); err != nil {
+				return err
+			}
+		}
+		if err := checksumFiles(imagesDir); err != nil {
+			return err
+		}
+	}
+
+	if flags.Volumes {
+		// The volumes are exported while the proxy is stopped to get a consistent cache
+		if err := systemd.StopService(podman.ProxyService); err != nil {
+			return err
+		}
+		log.Info().Msg(L("Backing up the proxy volumes"))
+		for _, volume := range proxyVolumes() {
+			if err := podman.ExportVolume(volume, volumesDir, false); err != nil {
+				_ = systemd.StartService(podman.ProxyService)
+				return err
+			}
+		}
+		if err := systemd.StartService(podman.ProxyService); err != nil {
+			return err
+		}
+	}
+
+	log.Info().Msgf(L("Proxy backed up into %s"), outputDir)
+	return nil
+}
+
+// Restore restores a proxy backup created in inputDir.
+//
+// If the restore fails, the proxy services are started again if they were running.
+func Restore(systemd podman.Systemd, inputDir string, flags *RestoreFlags) (err error) {
+	if !shared_utils.FileExists(path.Join(inputDir, backupConfigFile)) {
+		return fmt.Errorf(L("%s is not a proxy backup"), inputDir)
+	}
+	if shared_utils.FileExists(path.Join(utils.ProxyConfigDir, "config.yaml")) {
+		if !flags.Force {
+			return errors.New(L("a proxy is already configured. Use --force to overwrite it"))
+		}
+		log.Warn().Msg(L("Restoring over an already configured proxy"))
+	}
+
+	if systemd.HasService(podman.ProxyService) && systemd.IsServiceRunning(podman.ProxyService) {
+		if err := systemd.StopService(podman.ProxyService); err != nil {
+			return err
+		}
+		defer func() {
+			if err != nil {
+				if err := systemd.StartService(podman.ProxyService); err != nil {
+					log.Error().Err(err).Msg(L("failed to restart the proxy services"))
+				}
+			}
+		}()
+	}
+
+	log.Info().Msg(L("Restoring the proxy configuration"))
+	configFile := path.Join(inputDir, backupConfigFile)
+	if err := extractArchive(configFile, utils.ProxyConfigDir, flags.SkipVerify); err != nil {
+		return err
+	}
+
+	if !flags.SkipImages {
+		log.Info().Msg(L("Restoring the container images"))
+		if err := restoreImages(path.Join(inputDir, backupImagesDir), flags.SkipVerify); err != nil {
+			return err
+		}
+	}
+
+	if !flags.SkipVolumes {
+		if err := restoreVolumes(path.Join(inputDir, backupVolumesDir), flags); err != nil {
+			return err
+		}
+	}
+
+	log.Info().Msg(L("Restoring the systemd services"))
+	systemdFile := path.Join(inputDir, backupSystemdFile)
+	if err := restoreBackupServices(systemdFile, servicesDir(), flags.SkipVerify); err != nil {
+		return err
+	}
+	if err := systemd.ReloadDaemon(false); err != nil {
+		return err
+	}
+
+	if flags.Restart {
+		// EnableService doesn't start an already enabled service
+		if err := systemd.EnableService(podman.ProxyService); err != nil {
+			return err
+		}
+		return systemd.StartService(podman.ProxyService)
+	}
+	log.Info().Msg(L("Proxy restored, start it with mgrpxy start"))
+	return nil
+}
+
+// archiveDir writes the content of dir in a tarball and its checksum.
+func archiveDir(dir string, tarballPath string) error {
+	tarball, err := shared_utils.NewTarGz(tarballPath)
+	if err != nil {
+		return err
+	}
+	err = tarball.AddDir(dir, "")
+	tarball.Close()
+	if err != nil {
+		return shared_utils.Errorf(err, L("failed to archive %s"), dir)
+	}
+	return shared_utils.CreateChecksum(tarballPath)
+}
+
+// extractArchive validates the checksum of a tarball and extracts it in dir.
+func extractArchive(tarballPath string, dir string, skipVerify bool) error {
+	if !skipVerify {
+		if err := shared_utils.ValidateChecksum(tarballPath); err != nil {
+			return err
+		}
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return shared_utils.Errorf(err, L("failed to create folder %s"), dir)
+	}
+	if err := shared_utils.ExtractTarGz(tarballPath, dir); err != nil {
+		return shared_utils.Errorf(err, L("failed to extract %s"), tarballPath)
+	}
+	return nil
+}
+
+// backupServices archives the proxy services and returns the images they use.
+func backupServices(srcDir string, tarballPath string) ([]string, error) {
+	tmpDir, cleaner, err := shared_utils.TempDir()
+	if err != nil {
+		return nil, err
+	}
+	defer cleaner()
+
+	servicesBackup := path.Join(tmpDir, "systemd")
+	if err := saveServices(srcDir, servicesBackup); err != nil {
+		return nil, err
+	}
+	if err := archiveDir(servicesBackup, tarballPath); err != nil {
+		return nil, err
+	}
+
+	servicesImages, err := readRollbackImages(servicesBackup)
+	if err != nil {
+		return nil, err
+	}
+	images := []string{}
+	for _, image := range servicesImages {
+		if !shared_utils.Contains(images, image) {
+			images = append(images, image)
+		}
+	}
+	return images, nil
+}
+
+// restoreBackupServices replaces the proxy services with those of a backup tarball.
+func restoreBackupServices(tarballPath string, dstDir string, skipVerify bool) error {
+	tmpDir, cleaner, err := shared_utils.TempDir()
+	if err != nil {
+		return err
+	}
+	defer cleaner()
+
+	if err := extractArchive(tarballPath, tmpDir, skipVerify); err != nil {
+		return err
+	}
+	return restoreServices(tmpDir, dstDir)
+}
+
+// checksumFiles creates the checksum files of the tarballs in dir.
+func checksumFiles(dir string) error {
+	for _, file := range listTarballs(dir) {
+		if err := shared_utils.CreateChecksum(file); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// listTarballs returns the paths of the .tar files in dir.
+func listTarballs(dir string) []string {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return []string{}
+	}
+	files := []string{}
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasSuffix(entry.Name(), ".tar") {
+			files = append(files, path.Join(dir, entry.Name()))
+		}
+	}
+	return files
+}
+
+func restoreImages(dir string, skipVerify bool) error {
+	for _, image := range listTarballs(dir) {
+		if !skipVerify {
+			if err := shared_utils.ValidateChecksum(image); err != nil {
+				return err
+			}
+		}
+		if err := podman.RestoreImage(image, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func restoreVolumes(dir string, flags *RestoreFlags) error {
+	for _, file := range listTarballs(dir) {
+		volume := strings.TrimSuffix(path.Base(file), ".tar")
+		if podman.IsVolumePresent(volume) {
+			if !flags.Force {
+				return fmt.Errorf(L("volume %s already exists. Use --force to overwrite it"), volume)
+			}
+			// Extracting over the current content would keep the files missing in the backup
+			if err := emptyVolume(volume); err != nil {
+				return err
+			}
+		}
+		log.Info().Msgf(L("Restoring volume %s"), volume)
+		if err := podman.ImportVolume(volume, file, flags.SkipVerify, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// emptyVolume removes the content of a volume.
+func emptyVolume(volume string) error {
+	mountPoint, err := podman.GetVolumeMountPoint(volume)
+	if err != nil {
+		return shared_utils.Errorf(err, L("failed to get the mount point of volume %s"), volume)
+	}
+	entries, err := os.ReadDir(mountPoint)
+	if err != nil {
+		return shared_utils.Errorf(err, L("failed to read %s"), mountPoint)
+	}
+	for _, entry := range entries {
+		if err := os.RemoveAll(path.Join(mountPoint, entry.Name())); err != nil {
+			return shared_utils.Errorf(err, L("failed to empty volume %s"), volume)
+		}
+	}
+	return nil
+}
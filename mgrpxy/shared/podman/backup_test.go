@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package podman
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/uyuni-project/uyuni-tools/shared/testutils"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+func TestBackupAndRestoreServices(t *testing.T) {
+	servicesDir := t.TempDir()
+	backupDir := t.TempDir()
+	tarball := path.Join(backupDir, backupSystemdFile)
+
+	httpdConfDir := path.Join(servicesDir, "uyuni-proxy-httpd.service.d")
+	if err := os.Mkdir(httpdConfDir, 0750); err != nil {
+		t.Fatalf("failed to create fake service configuration directory: %s", err)
+	}
+	testutils.WriteFile(t, path.Join(servicesDir, "uyuni-proxy-pod.service"), "pod unit")
+	testutils.WriteFile(t, path.Join(servicesDir, "uyuni-proxy-httpd.service"), "httpd unit")
+	testutils.WriteFile(t, path.Join(servicesDir, "uyuni-proxy-squid.service"), "squid unit")
+	testutils.WriteFile(t, path.Join(httpdConfDir, "generated.conf"), "[Service]\nEnvironment=UYUNI_IMAGE=httpd:1.0\n")
+	fakeImages(t, map[string]string{"uyuni-proxy-httpd": "httpd:1.0"}, map[string]string{"httpd:1.0": "1111"})
+
+	images, err := backupServices(servicesDir, tarball)
+	if err != nil {
+		t.Fatalf("failed to backup the services: %s", err)
+	}
+	testutils.AssertEquals(t, "Unexpected images", []string{"1111"}, images)
+	testutils.AssertTrue(t, "Missing checksum file", utils.FileExists(tarball+".sha256sum"))
+
+	// Restore on a fresh host
+	restoredDir := t.TempDir()
+	if err := restoreBackupServices(tarball, restoredDir, false); err != nil {
+		t.Fatalf("failed to restore the services: %s", err)
+	}
+	testutils.AssertEquals(t, "Unexpected httpd unit", "httpd unit",
+		testutils.ReadFile(t, path.Join(restoredDir, "uyuni-proxy-httpd.service")),
+	)
+	testutils.AssertEquals(t, "Unexpected image", "[Service]\nEnvironment=UYUNI_IMAGE=1111\n",
+		testutils.ReadFile(t, path.Join(restoredDir, "uyuni-proxy-httpd.service.d", "generated.conf")),
+	)
+	testutils.AssertTrue(t, "The squid service should be restored",
+		utils.FileExists(path.Join(restoredDir, "uyuni-proxy-squid.service")),
+	)
+}
+
+func TestArchiveCorrupted(t *testing.T) {
+	configDir := t.TempDir()
+	testutils.WriteFile(t, path.Join(configDir, "config.yaml"), "server: uyuni.example.com\n")
+	tarball := path.Join(t.TempDir(), backupConfigFile)
+
+	if err := archiveDir(configDir, tarball); err != nil {
+		t.Fatalf("failed to archive the configuration: %s", err)
+	}
+	if err := extractArchive(tarball, path.Join(t.TempDir(), "proxy"), false); err != nil {
+		t.Errorf("failed to extract the configuration: %s", err)
+	}
+
+	testutils.WriteFile(t, tarball, "corrupted")
+	if err := extractArchive(tarball, t.TempDir(), false); err == nil {
+		t.Error("Expected a checksum error")
+	}
+	if err := extractArchive(tarball, t.TempDir(), true); err == nil {
+		t.Error("Expected an extraction error for an invalid archive")
+	}
+}
@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package podman
+
+import (
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/uyuni-project/uyuni-tools/shared"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/podman"
+	shared_utils "github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+// squidContainer is the name of the proxy squid container.
+const squidContainer = "uyuni-proxy-squid"
+
+// containerCachePath is where the squid cache tarball is copied in the squid container.
+const containerCachePath = "/tmp/squid-cache.tar.gz"
+
+// ImportSquidCache extracts a gzipped squid cache tarball in the squid container and restarts the proxy to index it.
+//
+// The files are owned by the squid user of the container since the tarball comes with the IDs of another host.
+func ImportSquidCache(systemd podman.Systemd, tarballPath string) error {
+	log.Info().Msg(L("Importing the squid cache"))
+	if err := shared_utils.RunCmdStdMapping(zerolog.DebugLevel, "podman", "cp",
+		tarballPath, squidContainer+":"+containerCachePath,
+	); err != nil {
+		return shared_utils.Errorf(err, L("failed to copy the squid cache to the proxy container"))
+	}
+
+	cnx := shared.NewConnection("podman", squidContainer, "")
+	cacheDir := shared_utils.ProxySquidVolumes[0].MountPath
+	_, err := cnx.Exec("sh", "-c", "tar xzf "+containerCachePath+" -C "+cacheDir+" && chown -R squid:squid "+cacheDir)
+	if _, rmErr := cnx.Exec("rm", "-f", containerCachePath); rmErr != nil {
+		log.Warn().Err(rmErr).Msgf(L("failed to remove %s from the proxy container"), containerCachePath)
+	}
+	if err != nil {
+		return shared_utils.Errorf(err, L("failed to extract the squid cache"))
+	}
+	return systemd.RestartService(podman.ProxyService)
+}
@@ -0,0 +1,216 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package podman
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/uyuni-project/uyuni-tools/mgrpxy/shared/utils"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/podman"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	shared_utils "github.com/uyuni-project/uyuni-tools/shared/utils"
+	"gopkg.in/yaml.v2"
+)
+
+// imagesManifestFile lists the images saved in an images export folder.
+const imagesManifestFile = "manifest.yaml"
+
+// exportedImage describes an image saved in an images export folder.
+type exportedImage struct {
+	Component string `yaml:"component"`
+	Image     string `yaml:"image"`
+	ID        string `yaml:"id"`
+	File      string `yaml:"file"`
+	SHA256    string `yaml:"sha256"`
+}
+
+type imagesManifest struct {
+	Images []exportedImage `yaml:"images"`
+}
+
+// ImagesExportFlags are the flags of the mgrpxy images export command.
+type ImagesExportFlags struct {
+	utils.ProxyImageFlags `mapstructure:",squash"`
+	SCC                   types.SCCCredentials
+}
+
+// ExportImages pulls the images of the enabled proxy components and saves them with a manifest in outputDir.
+func ExportImages(outputDir string, flags *ImagesExportFlags) error {
+	if err := flags.ValidateDisabled(); err != nil {
+		return err
+	}
+	if shared_utils.FileExists(outputDir) && !shared_utils.IsEmptyDirectory(outputDir) {
+		return fmt.Errorf(L("output directory %s already exists and is not empty"), outputDir)
+	}
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		return shared_utils.Errorf(err, L("failed to create folder %s"), outputDir)
+	}
+
+	hostData, err := podman.InspectHost()
+	if err != nil {
+		return err
+	}
+	authFile, cleaner, err := podman.PodmanLogin(hostData, flags.SCC)
+	if err != nil {
+		return shared_utils.Errorf(err, L("failed to login to registry.suse.com"))
+	}
+	defer cleaner()
+
+	manifest := imagesManifest{}
+	for _, component := range utils.EnabledComponents(flags.Disable) {
+		image, err := GetContainerImage(authFile, &flags.ProxyImageFlags, component)
+		if err != nil {
+			return err
+		}
+		entry := exportedImage{Component: component, Image: image, File: component + ".tar"}
+		if entry.ID, err = imageID(image); err != nil {
+			return err
+		}
+
+		log.Info().Msgf(L("Saving image %s"), image)
+		filePath := path.Join(outputDir, entry.File)
+		if err := shared_utils.RunCmd("podman", "image", "save", "--quiet", "-o", filePath, image); err != nil {
+			return shared_utils.Errorf(err, L("failed to save image %s"), image)
+		}
+		if entry.SHA256, err = fileChecksum(filePath); err != nil {
+			return err
+		}
+		manifest.Images = append(manifest.Images, entry)
+	}
+
+	if err := writeImagesManifest(outputDir, &manifest); err != nil {
+		return err
+	}
+	log.Info().Msgf(L("Proxy images exported into %s"), outputDir)
+	return nil
+}
+
+// LoadImages loads the images of the proxy components from an images export folder.
+//
+// The images are verified against the manifest and a map of the image name for each component is returned.
+func LoadImages(dir string, components []string) (map[string]string, error) {
+	manifest, err := readImagesManifest(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	images := map[string]string{}
+	for _, component := range components {
+		entry := manifest.find(component)
+		if entry == nil {
+			return nil, fmt.Errorf(L("no %[1]s image in %[2]s"), component, dir)
+		}
+
+		filePath, err := imageFilePath(dir, entry.File)
+		if err != nil {
+			return nil, err
+		}
+		checksum, err := fileChecksum(filePath)
+		if err != nil {
+			return nil, err
+		}
+		if checksum != entry.SHA256 {
+			return nil, fmt.Errorf(L("checksum of %s does not match"), filePath)
+		}
+
+		if err := podman.RestoreImage(filePath, false); err != nil {
+			return nil, err
+		}
+		id, err := imageID(entry.Image)
+		if err != nil {
+			return nil, err
+		}
+		if id != entry.ID {
+			return nil, fmt.Errorf(L("loaded image %[1]s has ID %[2]s instead of %[3]s"), entry.Image, id, entry.ID)
+		}
+		images[component] = entry.Image
+	}
+	return images, nil
+}
+
+// imageFilePath returns the path of an image file of the manifest, refusing the files outside of dir.
+func imageFilePath(dir string, file string) (string, error) {
+	filePath := filepath.Join(dir, filepath.FromSlash(file))
+	rel, err := filepath.Rel(dir, filePath)
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf(L("image file %[1]s is outside of %[2]s"), file, dir)
+	}
+	return filePath, nil
+}
+
+func (m *imagesManifest) find(component string) *exportedImage {
+	for i, entry := range m.Images {
+		if entry.Component == component {
+			return &m.Images[i]
+		}
+	}
+	return nil
+}
+
+func writeImagesManifest(dir string, manifest *imagesManifest) error {
+	data, err := yaml.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+	manifestPath := path.Join(dir, imagesManifestFile)
+	if err := os.WriteFile(manifestPath, data, 0644); err != nil {
+		return shared_utils.Errorf(err, L("failed to write %s"), manifestPath)
+	}
+	return nil
+}
+
+func readImagesManifest(dir string) (*imagesManifest, error) {
+	manifestPath := path.Join(dir, imagesManifestFile)
+	data, err := os.ReadFile(manifestPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf(L("%s is not a proxy images export"), dir)
+	} else if err != nil {
+		return nil, shared_utils.Errorf(err, L("failed to read %s"), manifestPath)
+	}
+	var manifest imagesManifest
+	if err := yaml.Unmarshal(data, &manifest); err != nil {
+		return nil, shared_utils.Errorf(err, L("failed to parse %s"), manifestPath)
+	}
+	return &manifest, nil
+}
+
+// imageID returns the ID of a local image.
+func imageID(image string) (string, error) {
+	out, err := shared_utils.RunCmdOutput(
+		zerolog.DebugLevel, "podman", "image", "inspect", "--format", "{{.Id}}", image,
+	)
+	if err != nil {
+		return "", shared_utils.Errorf(err, L("failed to inspect image %s"), image)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// fileChecksum returns the hexadecimal SHA256 sum of a file.
+//
+// The sum is computed here since sha256sum files contain the path of the file, which changes on the target host.
+func fileChecksum(file string) (string, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return "", shared_utils.Errorf(err, L("failed to read %s"), file)
+	}
+	defer f.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return "", shared_utils.Errorf(err, L("failed to read %s"), file)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package podman
+
+import (
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/uyuni-project/uyuni-tools/shared/testutils"
+)
+
+func TestImagesManifest(t *testing.T) {
+	dir := t.TempDir()
+	manifest := imagesManifest{
+		Images: []exportedImage{
+			{Component: "httpd", Image: "registry/proxy-httpd:latest", ID: "abc", File: "httpd.tar", SHA256: "123"},
+			{Component: "squid", Image: "registry/proxy-squid:latest", ID: "def", File: "squid.tar", SHA256: "456"},
+		},
+	}
+	if err := writeImagesManifest(dir, &manifest); err != nil {
+		t.Fatalf("failed to write the manifest: %s", err)
+	}
+
+	actual, err := readImagesManifest(dir)
+	if err != nil {
+		t.Fatalf("failed to read the manifest: %s", err)
+	}
+	testutils.AssertEquals(t, "wrong number of images", 2, len(actual.Images))
+	testutils.AssertEquals(t, "wrong squid image", manifest.Images[1], *actual.find("squid"))
+	testutils.AssertTrue(t, "unexpected ssh image", actual.find("ssh") == nil)
+
+	_, err = readImagesManifest(t.TempDir())
+	testutils.AssertTrue(t, "missing manifest not detected", err != nil)
+}
+
+func TestLoadImagesErrors(t *testing.T) {
+	dir := t.TempDir()
+	imageFile := path.Join(dir, "httpd.tar")
+	testutils.WriteFile(t, imageFile, "image content")
+
+	checksum, err := fileChecksum(imageFile)
+	if err != nil {
+		t.Fatalf("failed to compute the checksum: %s", err)
+	}
+	testutils.AssertEquals(t, "wrong checksum",
+		"b78f9dfd81d9bc073cad0a0e3acb1d6b164ede188bd71beb775b8004d7237117", checksum,
+	)
+
+	manifest := imagesManifest{
+		Images: []exportedImage{
+			{Component: "httpd", Image: "registry/proxy-httpd:latest", ID: "abc", File: "httpd.tar", SHA256: "wrong"},
+		},
+	}
+	if err := writeImagesManifest(dir, &manifest); err != nil {
+		t.Fatalf("failed to write the manifest: %s", err)
+	}
+
+	_, err = LoadImages(dir, []string{"httpd"})
+	testutils.AssertTrue(t, "checksum mismatch not detected", err != nil && strings.Contains(err.Error(), "checksum"))
+
+	_, err = LoadImages(dir, []string{"squid"})
+	testutils.AssertTrue(t, "missing component not detected", err != nil && strings.Contains(err.Error(), "squid"))
+
+	for _, file := range []string{"../httpd.tar", "sub/../../httpd.tar", ".", ""} {
+		manifest.Images[0].File = file
+		if err := writeImagesManifest(dir, &manifest); err != nil {
+			t.Fatalf("failed to write the manifest: %s", err)
+		}
+		_, err = LoadImages(dir, []string{"httpd"})
+		testutils.AssertTrue(t, "file outside of the export not refused: "+file,
+			err != nil && strings.Contains(err.Error(), "outside"),
+		)
+	}
+}
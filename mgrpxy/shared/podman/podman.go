@@ -28,6 +28,10 @@ type PodmanProxyFlags struct {
 	utils.ProxyImageFlags `mapstructure:",squash"`
 	SCC                   types.SCCCredentials
 	Podman                podman.PodmanFlags
+	// Images.From is the folder of an images export to load the images from instead of pulling them.
+	Images struct {
+		From string
+	}
 }
 
 // GenerateSystemdService generates all the systemd files required by proxy.
@@ -54,9 +58,24 @@ func GenerateSystemdService(
 	ports = append(ports, shared_utils.ProxyTCPPorts...)
 	ports = append(ports, shared_utils.ProxyPodmanPorts...)
 	ports = append(ports, shared_utils.TftpPorts...)
+	ports = utils.EnabledPorts(ports, flags.Disable)
+
+	services := []string{}
+	for _, component := range utils.EnabledComponents(flags.Disable) {
+		services = append(services, componentService(component))
+	}
+
+	// The services of the components disabled since the last deployment are removed
+	for _, component := range flags.Disable {
+		if service := componentService(component); systemd.HasService(service) {
+			log.Info().Msgf(L("Removing the disabled %s service"), service)
+			systemd.UninstallService(service, false)
+		}
+	}
 
 	// Pod
 	dataPod := templates.PodTemplateData{
+		Services:      services,
 		Ports:         ports,
 		HTTPProxyFile: httpProxyConfig,
 		Network:       podman.UyuniNetwork,
@@ -121,7 +140,7 @@ func GenerateSystemdService(
 		}
 	}
 	// SSH
-	{
+	if flags.IsEnabled("ssh") {
 		dataSSH := templates.SSHTemplateData{
 			HTTPProxyFile: httpProxyConfig,
 		}
@@ -130,7 +149,7 @@ func GenerateSystemdService(
 		}
 	}
 	// Tftpd
-	{
+	if flags.IsEnabled("tftpd") {
 		dataTftpd := templates.TFTPDTemplateData{
 			Volumes:       shared_utils.ProxyTftpdVolumes,
 			HTTPProxyFile: httpProxyConfig,
@@ -142,6 +161,22 @@ func GenerateSystemdService(
 	return systemd.ReloadDaemon(false)
 }
 
+// componentService returns the name of the systemd service of a proxy component.
+func componentService(component string) string {
+	return "uyuni-proxy-" + component
+}
+
+// DisabledComponents returns the optional components without installed service.
+func DisabledComponents(systemd podman.Systemd) []string {
+	disabled := []string{}
+	for _, component := range utils.OptionalComponents {
+		if !systemd.HasService(componentService(component)) {
+			disabled = append(disabled, component)
+		}
+	}
+	return disabled
+}
+
 func generateSystemdFile(template shared_utils.Template, service string, image string, config string) error {
 	name := fmt.Sprintf("uyuni-proxy-%s.service", service)
 
@@ -190,7 +225,7 @@ func GetContainerImage(authFile string, flags *utils.ProxyImageFlags, name strin
 
 // UnpackConfig uncompress the config.tar.gz containing proxy configuration.
 func UnpackConfig(configPath string) error {
-	const proxyConfigDir = "/etc/uyuni/proxy"
+	proxyConfigDir := utils.ProxyConfigDir
 
 	// Create dir if it doesn't exist & check perms
 	if err := os.MkdirAll(proxyConfigDir, 0755); err != nil {
@@ -248,17 +283,89 @@ func validateInstallYamlFiles(dir string) error {
 	return nil
 }
 
+// Install pulls or loads the proxy images, generates the systemd services and starts the proxy.
+//
+// The proxy configuration files need to be in the proxy configuration folder.
+func Install(systemd podman.Systemd, flags *PodmanProxyFlags) error {
+	if err := flags.ValidateDisabled(); err != nil {
+		return err
+	}
+
+	var images map[string]string
+	var err error
+	if flags.Images.From != "" {
+		images, err = LoadImages(flags.Images.From, utils.EnabledComponents(flags.Disable))
+	} else {
+		images, err = pullImages(flags)
+	}
+	if err != nil {
+		return err
+	}
+
+	// Setup the systemd service configuration options
+	err = GenerateSystemdService(
+		systemd, images["httpd"], images["salt-broker"], images["squid"], images["ssh"], images["tftpd"], flags,
+	)
+	if err != nil {
+		return err
+	}
+
+	return startPod(systemd)
+}
+
+// pullImages pulls the images of the enabled proxy components and returns them by component.
+func pullImages(flags *PodmanProxyFlags) (map[string]string, error) {
+	hostData, err := podman.InspectHost()
+	if err != nil {
+		return nil, err
+	}
+
+	authFile, cleaner, err := podman.PodmanLogin(hostData, flags.SCC)
+	if err != nil {
+		return nil, shared_utils.Errorf(err, L("failed to login to registry.suse.com"))
+	}
+	defer cleaner()
+
+	images := map[string]string{}
+	for _, component := range utils.EnabledComponents(flags.Disable) {
+		if images[component], err = GetContainerImage(authFile, &flags.ProxyImageFlags, component); err != nil {
+			return nil, err
+		}
+	}
+	return images, nil
+}
+
 // Upgrade will upgrade the proxy podman deploy.
 func Upgrade(
 	systemd podman.Systemd, _ *types.GlobalFlags, flags *PodmanProxyFlags,
-	_ *cobra.Command, _ []string,
-) error {
+	cmd *cobra.Command, _ []string,
+) (err error) {
 	if _, err := exec.LookPath("podman"); err != nil {
 		return errors.New(L("install podman before running this command"))
 	}
+	// Keep the components disabled at installation time unless requested otherwise
+	if !cmd.Flags().Changed("disable") {
+		flags.Disable = DisabledComponents(systemd)
+	}
+	if err := flags.ValidateDisabled(); err != nil {
+		return err
+	}
+
+	if err := SaveRollbackState(); err != nil {
+		return shared_utils.Errorf(err, L("failed to save the proxy services before upgrading"))
+	}
 	if err := systemd.StopService(podman.ProxyService); err != nil {
 		return err
 	}
+	generated := false
+	defer func() {
+		// The services are still the previous ones until they are generated
+		if err != nil && !generated {
+			if err := systemd.StartService(podman.ProxyService); err != nil {
+				log.Error().Err(err).Msg(L("failed to restart the proxy services"))
+			}
+		}
+	}()
 
 	hostData, err := podman.InspectHost()
 	if err != nil {
@@ -283,16 +390,21 @@ func Upgrade(
 	if err != nil {
 		log.Warn().Msgf(L("cannot find squid image: it will no be upgraded"))
 	}
-	sshImage, err := GetContainerImage(authFile, &flags.ProxyImageFlags, "ssh")
-	if err != nil {
-		log.Warn().Msgf(L("cannot find ssh image: it will no be upgraded"))
+	sshImage := ""
+	if flags.IsEnabled("ssh") {
+		if sshImage, err = GetContainerImage(authFile, &flags.ProxyImageFlags, "ssh"); err != nil {
+			log.Warn().Msgf(L("cannot find ssh image: it will no be upgraded"))
+		}
 	}
-	tftpdImage, err := GetContainerImage(authFile, &flags.ProxyImageFlags, "tftpd")
-	if err != nil {
-		log.Warn().Msgf(L("cannot find tftpd image: it will no be upgraded"))
+	tftpdImage := ""
+	if flags.IsEnabled("tftpd") {
+		if tftpdImage, err = GetContainerImage(authFile, &flags.ProxyImageFlags, "tftpd"); err != nil {
+			log.Warn().Msgf(L("cannot find tftpd image: it will no be upgraded"))
+		}
 	}
 
 	// Setup the systemd service configuration options
+	generated = true
 	err = GenerateSystemdService(systemd, httpdImage, saltBrokerImage, squidImage, sshImage, tftpdImage, flags)
 	if err != nil {
 		return err
@@ -0,0 +1,233 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package podman
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/podman"
+	shared_utils "github.com/uyuni-project/uyuni-tools/shared/utils"
+	"gopkg.in/yaml.v2"
+)
+
+// rollbackDir is the folder where the proxy services are saved before an upgrade.
+var rollbackDir = "/var/lib/uyuni-tools/proxy-rollback"
+
+// rollbackImagesFile lists the ID of the image used by each service before the upgrade.
+const rollbackImagesFile = "images.yaml"
+
+// getServiceImage is a variable to be replaced in the tests.
+var getServiceImage = podman.GetServiceImage
+
+// inspectImageID returns the ID of an image.
+//
+// The image references are tags that the upgrade moves to the new images: the IDs are needed to roll back.
+var inspectImageID = func(image string) (string, error) {
+	out, err := shared_utils.RunCmdOutput(zerolog.DebugLevel,
+		"podman", "image", "inspect", "--format", "{{.Id}}", image,
+	)
+	if err != nil {
+		return "", shared_utils.Errorf(err, L("failed to get the ID of image %s"), image)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// imageExists is a variable to be replaced in the tests.
+var imageExists = func(image string) bool {
+	return shared_utils.RunCmd("podman", "image", "exists", image) == nil
+}
+
+// proxyServices returns the names of the systemd services of the proxy.
+func proxyServices() []string {
+	return append([]string{podman.ProxyService}, podman.ProxyContainerNames...)
+}
+
+func servicesDir() string {
+	return path.Dir(podman.GetServicePath(podman.ProxyService))
+}
+
+// SaveRollbackState saves the systemd services of the proxy and their configuration before an upgrade.
+//
+// The previously saved state is replaced.
+func SaveRollbackState() error {
+	log.Info().Msgf(L("Saving the proxy services in %s"), rollbackDir)
+	return saveServices(servicesDir(), rollbackDir)
+}
+
+// Rollback restores the systemd services saved before the last upgrade and restarts the proxy.
+func Rollback(systemd podman.Systemd) error {
+	images, err := readRollbackImages(rollbackDir)
+	if err != nil {
+		return err
+	}
+	for service, image := range images {
+		if !imageExists(image) {
+			return fmt.Errorf(L("image %[1]s of %[2]s is not available locally anymore"), image, service)
+		}
+	}
+
+	if err := systemd.StopService(podman.ProxyService); err != nil {
+		return err
+	}
+	if err := restoreServices(rollbackDir, servicesDir()); err != nil {
+		return err
+	}
+	if err := systemd.ReloadDaemon(false); err != nil {
+		return err
+	}
+
+	for service, image := range images {
+		log.Info().Msgf(L("Restoring %[1]s with image %[2]s"), service, image)
+	}
+
+	if err := systemd.StartService(podman.ProxyService); err != nil {
+		return err
+	}
+	return os.RemoveAll(rollbackDir)
+}
+
+// saveServices copies the proxy services from srcDir to dstDir with their images pinned to their IDs.
+func saveServices(srcDir string, dstDir string) error {
+	if err := os.RemoveAll(dstDir); err != nil {
+		return shared_utils.Errorf(err, L("failed to remove %s"), dstDir)
+	}
+	if err := os.MkdirAll(dstDir, 0700); err != nil {
+		return shared_utils.Errorf(err, L("failed to create folder %s"), dstDir)
+	}
+
+	images := map[string]string{}
+	for _, service := range proxyServices() {
+		unit := service + ".service"
+		if !shared_utils.FileExists(path.Join(srcDir, unit)) {
+			continue
+		}
+		if err := copyServiceFiles(srcDir, dstDir, service); err != nil {
+			return err
+		}
+		image := getServiceImage(service)
+		if image == "" {
+			continue
+		}
+		imageID, err := inspectImageID(image)
+		if err != nil {
+			return err
+		}
+		if err := writeServiceImage(serviceConfPath(dstDir, service), imageID); err != nil {
+			return err
+		}
+		images[service] = imageID
+	}
+
+	data, err := yaml.Marshal(images)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path.Join(dstDir, rollbackImagesFile), data, 0600)
+}
+
+// restoreServices replaces the proxy services with the saved ones.
+//
+// The services that were not saved are removed.
+func restoreServices(srcDir string, dstDir string) error {
+	for _, service := range proxyServices() {
+		unit := service + ".service"
+		if err := os.RemoveAll(path.Join(dstDir, unit+".d")); err != nil {
+			return shared_utils.Errorf(err, L("failed to remove %s"), unit+".d")
+		}
+		if err := os.RemoveAll(path.Join(dstDir, unit)); err != nil {
+			return shared_utils.Errorf(err, L("failed to remove %s"), unit)
+		}
+		if !shared_utils.FileExists(path.Join(srcDir, unit)) {
+			continue
+		}
+		if err := copyServiceFiles(srcDir, dstDir, service); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readRollbackImages(dir string) (map[string]string, error) {
+	data, err := os.ReadFile(path.Join(dir, rollbackImagesFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, errors.New(L("no proxy upgrade to roll back"))
+	} else if err != nil {
+		return nil, err
+	}
+	images := map[string]string{}
+	if err := yaml.Unmarshal(data, &images); err != nil {
+		return nil, shared_utils.Errorf(err, L("failed to parse %s"), rollbackImagesFile)
+	}
+	return images, nil
+}
+
+// copyServiceFiles copies the unit file of a service and its drop-in configuration files.
+func copyServiceFiles(srcDir string, dstDir string, service string) error {
+	unit := service + ".service"
+	if err := copyFile(path.Join(srcDir, unit), path.Join(dstDir, unit)); err != nil {
+		return err
+	}
+
+	confDir := unit + ".d"
+	entries, err := os.ReadDir(path.Join(srcDir, confDir))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(path.Join(dstDir, confDir), 0750); err != nil {
+		return shared_utils.Errorf(err, L("failed to create folder %s"), confDir)
+	}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		src := path.Join(srcDir, confDir, entry.Name())
+		if err := copyFile(src, path.Join(dstDir, confDir, entry.Name())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func copyFile(src string, dst string) error {
+	info, err := os.Stat(src)
+	if err != nil {
+		return err
+	}
+	data, err := os.ReadFile(src)
+	if err != nil {
+		return shared_utils.Errorf(err, L("failed to read %s file"), src)
+	}
+	if err := os.WriteFile(dst, data, info.Mode().Perm()); err != nil {
+		return shared_utils.Errorf(err, L("failed to write %s file"), dst)
+	}
+	return nil
+}
+
+// serviceConfPath returns the path of the generated.conf file of a service in the dir folder.
+func serviceConfPath(dir string, service string) string {
+	confPath := podman.GetServiceConfPath(service)
+	return path.Join(dir, path.Base(path.Dir(confPath)), path.Base(confPath))
+}
+
+// writeServiceImage writes a generated.conf file setting the image of a service like generateSystemdFile.
+func writeServiceImage(confPath string, image string) error {
+	if err := os.MkdirAll(path.Dir(confPath), 0750); err != nil {
+		return shared_utils.Errorf(err, L("failed to create folder %s"), path.Dir(confPath))
+	}
+	content := fmt.Sprintf("[Service]\nEnvironment=UYUNI_IMAGE=%s\n", image)
+	if err := os.WriteFile(confPath, []byte(content), 0640); err != nil {
+		return shared_utils.Errorf(err, L("failed to write %s file"), confPath)
+	}
+	return nil
+}
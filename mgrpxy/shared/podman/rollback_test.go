@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package podman
+
+import (
+	"errors"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/uyuni-project/uyuni-tools/shared/testutils"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+// fakeImages replaces the services image lookups with the images values.
+//
+// Each image is resolved to its ID in ids.
+func fakeImages(t *testing.T, images map[string]string, ids map[string]string) {
+	oldGetServiceImage := getServiceImage
+	oldInspectImageID := inspectImageID
+	t.Cleanup(func() {
+		getServiceImage = oldGetServiceImage
+		inspectImageID = oldInspectImageID
+	})
+
+	getServiceImage = func(service string) string {
+		return images[service]
+	}
+	inspectImageID = func(image string) (string, error) {
+		id, ok := ids[image]
+		if !ok {
+			return "", errors.New("no such image: " + image)
+		}
+		return id, nil
+	}
+}
+
+func TestSaveAndRestoreServices(t *testing.T) {
+	const generatedConf = "[Service]\nEnvironment=UYUNI_IMAGE=httpd:latest\n"
+	servicesDir := t.TempDir()
+	saveDir := path.Join(t.TempDir(), "rollback")
+
+	httpdConfDir := path.Join(servicesDir, "uyuni-proxy-httpd.service.d")
+	if err := os.Mkdir(httpdConfDir, 0750); err != nil {
+		t.Fatalf("failed to create fake service configuration directory: %s", err)
+	}
+	testutils.WriteFile(t, path.Join(servicesDir, "uyuni-proxy-pod.service"), "pod unit")
+	testutils.WriteFile(t, path.Join(servicesDir, "uyuni-proxy-httpd.service"), "httpd unit")
+	testutils.WriteFile(t, path.Join(httpdConfDir, "generated.conf"), generatedConf)
+	testutils.WriteFile(t, path.Join(httpdConfDir, "custom.conf"), "[Service]\nEnvironment=TZ=Europe/Berlin\n")
+	fakeImages(t, map[string]string{"uyuni-proxy-httpd": "httpd:latest"}, map[string]string{"httpd:latest": "1111"})
+
+	if err := saveServices(servicesDir, saveDir); err != nil {
+		t.Fatalf("failed to save the services: %s", err)
+	}
+
+	// Upgrade the services, the tag now points to the new image
+	testutils.WriteFile(t, path.Join(servicesDir, "uyuni-proxy-httpd.service"), "new httpd unit")
+	testutils.WriteFile(t, path.Join(httpdConfDir, "generated.conf"), generatedConf)
+	testutils.WriteFile(t, path.Join(servicesDir, "uyuni-proxy-tftpd.service"), "tftpd unit")
+
+	images, err := readRollbackImages(saveDir)
+	if err != nil {
+		t.Fatalf("failed to read the saved images: %s", err)
+	}
+	testutils.AssertEquals(t, "Unexpected saved images", map[string]string{"uyuni-proxy-httpd": "1111"}, images)
+
+	if err := restoreServices(saveDir, servicesDir); err != nil {
+		t.Fatalf("failed to restore the services: %s", err)
+	}
+	testutils.AssertEquals(t, "Unexpected httpd unit", "httpd unit",
+		testutils.ReadFile(t, path.Join(servicesDir, "uyuni-proxy-httpd.service")),
+	)
+	testutils.AssertEquals(t, "The image should be pinned to its ID", "[Service]\nEnvironment=UYUNI_IMAGE=1111\n",
+		testutils.ReadFile(t, path.Join(httpdConfDir, "generated.conf")),
+	)
+	testutils.AssertEquals(t, "Unexpected custom configuration", "[Service]\nEnvironment=TZ=Europe/Berlin\n",
+		testutils.ReadFile(t, path.Join(httpdConfDir, "custom.conf")),
+	)
+	testutils.AssertTrue(t, "The new service should be removed",
+		!utils.FileExists(path.Join(servicesDir, "uyuni-proxy-tftpd.service")),
+	)
+}
+
+func TestSaveServicesMissingImage(t *testing.T) {
+	servicesDir := t.TempDir()
+	testutils.WriteFile(t, path.Join(servicesDir, "uyuni-proxy-httpd.service"), "httpd unit")
+	fakeImages(t, map[string]string{"uyuni-proxy-httpd": "httpd:latest"}, map[string]string{})
+
+	err := saveServices(servicesDir, path.Join(t.TempDir(), "rollback"))
+	testutils.AssertTrue(t, "Saving a service with an unknown image should fail", err != nil)
+}
+
+func TestReadRollbackImagesMissing(t *testing.T) {
+	_, err := readRollbackImages(t.TempDir())
+	testutils.AssertTrue(t, "Rolling back without saved state should fail", err != nil)
+}
@@ -17,16 +17,12 @@ const podTemplate = `# uyuni-proxy-pod.service, generated by mgrpxy
 Description=Podman uyuni-proxy-pod.service
 Wants=network.target
 After=network-online.target
-Requires=uyuni-proxy-httpd.service
-Requires=uyuni-proxy-salt-broker.service
-Requires=uyuni-proxy-squid.service
-Requires=uyuni-proxy-ssh.service
-Requires=uyuni-proxy-tftpd.service
-Before=uyuni-proxy-httpd.service
-Before=uyuni-proxy-salt-broker.service
-Before=uyuni-proxy-squid.service
-Before=uyuni-proxy-ssh.service
-Before=uyuni-proxy-tftpd.service
+{{- range .Services }}
+Requires={{ . }}.service
+{{- end }}
+{{- range .Services }}
+Before={{ . }}.service
+{{- end }}
 
 [Service]
 Environment=PODMAN_SYSTEMD_UNIT=%n
@@ -61,6 +57,8 @@ WantedBy=multi-user.target default.target
 
 // PodTemplateData POD information to create systemd file.
 type PodTemplateData struct {
+	// Services are the names of the systemd services of the pod containers.
+	Services      []string
 	Ports         []types.PortMap
 	HTTPProxyFile string
 	Network       string
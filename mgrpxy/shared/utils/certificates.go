@@ -0,0 +1,168 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/ssl"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+// CertificateExpiryWarning is how long before its expiry a warning is shown for the proxy certificate.
+const CertificateExpiryWarning = 30 * 24 * time.Hour
+
+// ValidateProxyConfig checks the SSL material of a proxy configuration at the given time.
+//
+// The proxy certificate is verified against the CA chain, its validity period,
+// its names against the proxy FQDN and its key.
+// Returns the description of the problems found.
+func ValidateProxyConfig(config *ProxyConfig, now time.Time) ([]string, error) {
+	problems := []string{}
+	if config.Server == "" {
+		problems = append(problems, L("missing server FQDN in config.yaml"))
+	}
+	if config.ProxyFQDN == "" {
+		problems = append(problems, L("missing proxy FQDN in config.yaml"))
+	}
+	if config.CaCrt == "" {
+		problems = append(problems, L("missing CA certificate in config.yaml"))
+	}
+	if config.ServerCrt == "" {
+		problems = append(problems, L("missing proxy certificate in httpd.yaml"))
+	}
+	if config.ServerKey == "" {
+		problems = append(problems, L("missing proxy key in httpd.yaml"))
+	}
+	if config.CaCrt == "" || config.ServerCrt == "" || config.ServerKey == "" {
+		return problems, nil
+	}
+
+	tmpDir, cleaner, err := utils.TempDir()
+	if err != nil {
+		return nil, err
+	}
+	defer cleaner()
+
+	// ssl.OrderCas works on files
+	caPath := path.Join(tmpDir, "ca.crt")
+	certPath := path.Join(tmpDir, "server.crt")
+	keyPath := path.Join(tmpDir, "server.key")
+	for file, content := range map[string]string{
+		caPath: config.CaCrt, certPath: config.ServerCrt, keyPath: config.ServerKey,
+	} {
+		if err := os.WriteFile(file, []byte(content), 0600); err != nil {
+			return nil, err
+		}
+	}
+
+	chain, err := orderCertificates(caPath, certPath, keyPath)
+	if err != nil {
+		return append(problems, fmt.Sprintf(L("invalid proxy certificate chain: %s"), err)), nil
+	}
+	leaf := chain[0]
+
+	// The signatures are verified at a time all the certificates are valid to report the dates separately
+	verifyTime := now
+	for _, cert := range chain {
+		if now.After(cert.NotAfter) {
+			problems = append(problems, fmt.Sprintf(L("certificate %[1]s expired on %[2]s"),
+				cert.Subject.CommonName, cert.NotAfter.Format(time.RFC3339),
+			))
+			verifyTime = latestStart(chain)
+		} else if now.Before(cert.NotBefore) {
+			problems = append(problems, fmt.Sprintf(L("certificate %[1]s is not valid before %[2]s, check the clock"),
+				cert.Subject.CommonName, cert.NotBefore.Format(time.RFC3339),
+			))
+			verifyTime = latestStart(chain)
+		}
+	}
+	if leaf.NotAfter.After(now) && leaf.NotAfter.Sub(now) < CertificateExpiryWarning {
+		log.Warn().Msgf(L("The proxy certificate expires on %s"), leaf.NotAfter.Format(time.RFC3339))
+	}
+
+	roots := x509.NewCertPool()
+	roots.AddCert(chain[len(chain)-1])
+	intermediates := x509.NewCertPool()
+	for _, cert := range chain[1 : len(chain)-1] {
+		intermediates.AddCert(cert)
+	}
+	if _, err := leaf.Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		CurrentTime:   verifyTime,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	}); err != nil {
+		problems = append(problems, fmt.Sprintf(L("the proxy certificate is not signed by the CA: %s"), err))
+	}
+
+	if config.ProxyFQDN != "" {
+		if err := leaf.VerifyHostname(config.ProxyFQDN); err != nil {
+			problems = append(problems, fmt.Sprintf(L("the proxy certificate is not valid for %[1]s: %[2]s"),
+				config.ProxyFQDN, err,
+			))
+		}
+	}
+
+	leafPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: leaf.Raw})
+	if _, err := tls.X509KeyPair(leafPEM, []byte(config.ServerKey)); err != nil {
+		problems = append(problems, fmt.Sprintf(L("the proxy key doesn't match the certificate: %s"), err))
+	}
+	return problems, nil
+}
+
+// latestStart returns the latest start of validity of the certificates.
+func latestStart(certs []*x509.Certificate) time.Time {
+	start := certs[0].NotBefore
+	for _, cert := range certs[1:] {
+		if cert.NotBefore.After(start) {
+			start = cert.NotBefore
+		}
+	}
+	return start
+}
+
+// orderCertificates sorts the certificates from the proxy one to the root CA.
+func orderCertificates(caPath string, certPath string, keyPath string) ([]*x509.Certificate, error) {
+	ordered, rootCA, err := ssl.OrderCas(&types.CaChain{Root: caPath}, &types.SSLPair{Cert: certPath, Key: keyPath})
+	if err != nil {
+		return nil, err
+	}
+	return parseCertificates(append(ordered, rootCA...))
+}
+
+// parseCertificates parses all the certificates of PEM data.
+func parseCertificates(data []byte) ([]*x509.Certificate, error) {
+	certs := []*x509.Certificate{}
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, errors.New(L("no certificate found"))
+	}
+	return certs, nil
+}
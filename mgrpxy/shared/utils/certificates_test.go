@@ -0,0 +1,208 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/uyuni-project/uyuni-tools/shared/testutils"
+)
+
+var testNow = time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  string
+}
+
+// newTestCert creates a certificate signed by parent, or self-signed if parent is nil.
+func newTestCert(t *testing.T, name string, parent *testCert, isCA bool, notAfter time.Time) *testCert {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %s", err)
+	}
+	serial, _ := rand.Int(rand.Reader, big.NewInt(1<<62))
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: name, Organization: []string{"Uyuni"}},
+		NotBefore:             testNow.Add(-24 * time.Hour),
+		NotAfter:              notAfter,
+		IsCA:                  isCA,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	if !isCA {
+		template.DNSNames = []string{name}
+	}
+	signer := &testCert{cert: template, key: key}
+	if parent != nil {
+		signer = parent
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, signer.cert, &key.PublicKey, signer.key)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %s", err)
+	}
+	cert, _ := x509.ParseCertificate(der)
+	return &testCert{
+		cert: cert,
+		key:  key,
+		pem:  string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+	}
+}
+
+func (c *testCert) keyPEM(t *testing.T) string {
+	der, err := x509.MarshalPKCS8PrivateKey(c.key)
+	if err != nil {
+		t.Fatalf("failed to marshal key: %s", err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+}
+
+func TestReadProxyConfig(t *testing.T) {
+	dir := t.TempDir()
+	testutils.WriteFile(t, path.Join(dir, "config.yaml"),
+		"server: uyuni.example.com\nproxy_fqdn: pxy.example.com\nmax_cache_size_mb: 2048\nca_crt: |\n  CA\n",
+	)
+	testutils.WriteFile(t, path.Join(dir, "httpd.yaml"), "httpd:\n  system_id: <xml/>\n  server_crt: "+
+		base64.StdEncoding.EncodeToString([]byte("CERT"))+"\n  server_key: KEY\n",
+	)
+	testutils.WriteFile(t, path.Join(dir, "ssh.yaml"), "ssh:\n  server_ssh_key_pub: ssh-rsa AAAA root@uyuni\n")
+
+	config, err := ReadProxyConfig(dir)
+	if err != nil {
+		t.Fatalf("failed to read the configuration: %s", err)
+	}
+	testutils.AssertEquals(t, "Unexpected proxy FQDN", "pxy.example.com", config.ProxyFQDN)
+	testutils.AssertEquals(t, "Unexpected max cache", 2048, config.MaxCache)
+	testutils.AssertEquals(t, "Unexpected CA", "CA\n", config.CaCrt)
+	testutils.AssertEquals(t, "The certificate should be decoded", "CERT", config.ServerCrt)
+	testutils.AssertEquals(t, "Unexpected key", "KEY", config.ServerKey)
+	testutils.AssertEquals(t, "Unexpected SSH key", "ssh-rsa AAAA root@uyuni", config.SSHKeyPub)
+}
+
+func TestWriteProxyConfig(t *testing.T) {
+	dir := path.Join(t.TempDir(), "proxy")
+	config := ProxyConfig{
+		Server:        "uyuni.example.com",
+		ProxyFQDN:     "pxy.example.com",
+		MaxCache:      15000,
+		Email:         "admin@example.com",
+		CaCrt:         "CA\n",
+		SystemID:      "<xml/>\n",
+		ServerCrt:     "CERT\n",
+		ServerKey:     "KEY\n",
+		SSHKeyPub:     "ssh-rsa AAAA root@uyuni",
+		SSHPushKey:    "PUSHKEY\n",
+		SSHPushKeyPub: "ssh-rsa BBBB mgrsshtunnel@pxy",
+	}
+	if err := WriteProxyConfig(dir, &config); err != nil {
+		t.Fatalf("failed to write the configuration: %s", err)
+	}
+
+	httpd := testutils.ReadFile(t, path.Join(dir, "httpd.yaml"))
+	testutils.AssertTrue(t, "The certificate should be base64 encoded",
+		strings.Contains(httpd, base64.StdEncoding.EncodeToString([]byte("CERT\n"))),
+	)
+
+	read, err := ReadProxyConfig(dir)
+	if err != nil {
+		t.Fatalf("failed to read the written configuration: %s", err)
+	}
+	testutils.AssertEquals(t, "The configuration should be read back unchanged", config, *read)
+}
+
+func TestReplaceSSLMaterial(t *testing.T) {
+	dir := t.TempDir()
+	newDir := t.TempDir()
+	testutils.WriteFile(t, path.Join(dir, "config.yaml"),
+		"server: uyuni.example.com\nca_crt: OLDCA\nproxy_fqdn: pxy.example.com\n",
+	)
+	testutils.WriteFile(t, path.Join(dir, "httpd.yaml"),
+		"httpd:\n  system_id: <xml/>\n  server_crt: OLDCERT\n  server_key: OLDKEY\n",
+	)
+	testutils.WriteFile(t, path.Join(newDir, "config.yaml"),
+		"server: other.example.com\nca_crt: NEWCA\nproxy_fqdn: pxy.example.com\n",
+	)
+	testutils.WriteFile(t, path.Join(newDir, "httpd.yaml"),
+		"httpd:\n  system_id: <other/>\n  server_crt: NEWCERT\n  server_key: NEWKEY\n",
+	)
+
+	if err := ReplaceSSLMaterial(dir, newDir); err != nil {
+		t.Fatalf("failed to replace the SSL material: %s", err)
+	}
+	testutils.AssertEquals(t, "Only the CA should be replaced in config.yaml",
+		"server: uyuni.example.com\nca_crt: NEWCA\nproxy_fqdn: pxy.example.com\n",
+		testutils.ReadFile(t, path.Join(dir, "config.yaml")),
+	)
+	testutils.AssertEquals(t, "Only the certificate and key should be replaced in httpd.yaml",
+		"httpd:\n  system_id: <xml/>\n  server_crt: NEWCERT\n  server_key: NEWKEY\n",
+		testutils.ReadFile(t, path.Join(dir, "httpd.yaml")),
+	)
+}
+
+func TestValidateProxyConfig(t *testing.T) {
+	expiry := testNow.Add(365 * 24 * time.Hour)
+	root := newTestCert(t, "RootCA", nil, true, expiry)
+	intermediate := newTestCert(t, "TeamCA", root, true, expiry)
+	proxy := newTestCert(t, "pxy.example.com", intermediate, false, expiry)
+
+	config := ProxyConfig{
+		Server:    "uyuni.example.com",
+		ProxyFQDN: "pxy.example.com",
+		CaCrt:     root.pem,
+		ServerCrt: proxy.pem + intermediate.pem,
+		ServerKey: proxy.keyPEM(t),
+	}
+
+	assertProblems := func(message string, expected ...string) {
+		problems, err := ValidateProxyConfig(&config, testNow)
+		if err != nil {
+			t.Fatalf("failed to validate the configuration: %s", err)
+		}
+		testutils.AssertEquals(t, message+": "+strings.Join(problems, ", "), len(expected), len(problems))
+		for i, value := range expected {
+			if i < len(problems) && !strings.Contains(problems[i], value) {
+				t.Errorf("%s: expected problem containing %s, got: %s", message, value, problems[i])
+			}
+		}
+	}
+
+	assertProblems("Valid configuration")
+
+	config.ProxyFQDN = "other.example.com"
+	assertProblems("Wrong proxy name", "not valid for other.example.com")
+	config.ProxyFQDN = "pxy.example.com"
+
+	config.ServerKey = root.keyPEM(t)
+	assertProblems("Wrong key", "doesn't match")
+	config.ServerKey = proxy.keyPEM(t)
+
+	config.ServerCrt = proxy.pem
+	assertProblems("Missing intermediate CA", "certificate chain")
+
+	otherRoot := newTestCert(t, "RootCA", nil, true, expiry)
+	config.ServerCrt = proxy.pem + intermediate.pem
+	config.CaCrt = otherRoot.pem
+	assertProblems("Wrong CA", "not signed by the CA")
+	config.CaCrt = root.pem
+
+	expired := newTestCert(t, "pxy.example.com", intermediate, false, testNow.Add(-time.Hour))
+	config.ServerCrt = expired.pem + intermediate.pem
+	config.ServerKey = expired.keyPEM(t)
+	assertProblems("Expired certificate", "expired on")
+}
@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"fmt"
+	"strings"
+
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+// ProxyComponents are all the components of the proxy.
+var ProxyComponents = []string{"httpd", "salt-broker", "squid", "ssh", "tftpd"}
+
+// OptionalComponents are the proxy components that can be disabled.
+var OptionalComponents = []string{"ssh", "tftpd"}
+
+// componentPorts are the names of the ports published only for an optional component.
+var componentPorts = map[string]string{
+	"ssh":   "ssh",
+	"tftpd": "tftp",
+}
+
+// ValidateDisabled checks that only optional components are disabled.
+func (f *ProxyImageFlags) ValidateDisabled() error {
+	for _, component := range f.Disable {
+		if !utils.Contains(OptionalComponents, component) {
+			return fmt.Errorf(L("%[1]s cannot be disabled, only %[2]s can"),
+				component, strings.Join(OptionalComponents, ", "),
+			)
+		}
+	}
+	return nil
+}
+
+// IsEnabled returns whether a proxy component is to be deployed.
+func (f *ProxyImageFlags) IsEnabled(component string) bool {
+	return !utils.Contains(f.Disable, component)
+}
+
+// EnabledComponents returns the proxy components which are not disabled.
+func EnabledComponents(disabled []string) []string {
+	components := []string{}
+	for _, component := range ProxyComponents {
+		if !utils.Contains(disabled, component) {
+			components = append(components, component)
+		}
+	}
+	return components
+}
+
+// EnabledPorts returns the ports without those of the disabled components.
+func EnabledPorts(ports []types.PortMap, disabled []string) []types.PortMap {
+	enabled := []types.PortMap{}
+	for _, port := range ports {
+		keep := true
+		for _, component := range disabled {
+			if componentPorts[component] == port.Name {
+				keep = false
+			}
+		}
+		if keep {
+			enabled = append(enabled, port)
+		}
+	}
+	return enabled
+}
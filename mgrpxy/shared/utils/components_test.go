@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/uyuni-project/uyuni-tools/shared/testutils"
+	"github.com/uyuni-project/uyuni-tools/shared/types"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+func TestValidateDisabled(t *testing.T) {
+	flags := ProxyImageFlags{Disable: []string{"tftpd", "ssh"}}
+	if err := flags.ValidateDisabled(); err != nil {
+		t.Errorf("Unexpected error for optional components: %s", err)
+	}
+	testutils.AssertTrue(t, "httpd should be enabled", flags.IsEnabled("httpd"))
+	testutils.AssertTrue(t, "ssh should be disabled", !flags.IsEnabled("ssh"))
+
+	flags.Disable = []string{"squid"}
+	if err := flags.ValidateDisabled(); err == nil {
+		t.Error("squid should not be allowed to be disabled")
+	}
+}
+
+func TestEnabledComponents(t *testing.T) {
+	testutils.AssertEquals(t, "All components should be enabled by default", ProxyComponents, EnabledComponents(nil))
+	testutils.AssertEquals(t, "Unexpected enabled components", []string{"httpd", "salt-broker", "squid", "ssh"},
+		EnabledComponents([]string{"tftpd"}),
+	)
+}
+
+func TestEnabledPorts(t *testing.T) {
+	ports := []types.PortMap{}
+	ports = append(ports, utils.ProxyTCPPorts...)
+	ports = append(ports, utils.TftpPorts...)
+
+	names := func(ports []types.PortMap) []string {
+		result := []string{}
+		for _, port := range ports {
+			result = append(result, port.Name)
+		}
+		return result
+	}
+
+	testutils.AssertEquals(t, "No port should be removed", names(ports), names(EnabledPorts(ports, []string{})))
+	testutils.AssertEquals(t, "The ssh and tftp ports should be removed", []string{"publish", "request"},
+		names(EnabledPorts(ports, []string{"ssh", "tftpd"})),
+	)
+}
@@ -0,0 +1,239 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path"
+
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+	"gopkg.in/yaml.v2"
+)
+
+// ProxyConfigDir is the folder containing the configuration files of the installed proxy.
+const ProxyConfigDir = "/etc/uyuni/proxy"
+
+// ProxyConfig is the content of the configuration files generated by the server for a proxy.
+//
+// The certificates and key are in PEM format.
+type ProxyConfig struct {
+	Server    string
+	ProxyFQDN string
+	MaxCache  int
+	Email     string
+	CaCrt     string
+	SystemID  string
+	ServerCrt string
+	ServerKey string
+	// SSHKeyPub is the public key the server uses to connect to the proxy SSH container.
+	SSHKeyPub string
+	// SSHPushKey and SSHPushKeyPub are the key pair the proxy uses to connect to the SSH push clients.
+	SSHPushKey    string
+	SSHPushKeyPub string
+}
+
+type configYaml struct {
+	Server    string `yaml:"server"`
+	ProxyFQDN string `yaml:"proxy_fqdn"`
+	MaxCache  int    `yaml:"max_cache_size_mb"`
+	Email     string `yaml:"email"`
+	CaCrt     string `yaml:"ca_crt"`
+}
+
+type httpdYaml struct {
+	Httpd struct {
+		SystemID  string `yaml:"system_id"`
+		ServerCrt string `yaml:"server_crt"`
+		ServerKey string `yaml:"server_key"`
+	}
+}
+
+type sshYaml struct {
+	SSH struct {
+		ServerSSHKeyPub  string `yaml:"server_ssh_key_pub"`
+		ServerSSHPush    string `yaml:"server_ssh_push,omitempty"`
+		ServerSSHPushPub string `yaml:"server_ssh_push_pub,omitempty"`
+	}
+}
+
+// ReadProxyConfig reads the config.yaml, httpd.yaml and ssh.yaml files of a proxy configuration folder.
+//
+// The ssh.yaml file is optional.
+func ReadProxyConfig(dir string) (*ProxyConfig, error) {
+	var config configYaml
+	if err := readYaml(path.Join(dir, "config.yaml"), &config); err != nil {
+		return nil, err
+	}
+	var httpd httpdYaml
+	if err := readYaml(path.Join(dir, "httpd.yaml"), &httpd); err != nil {
+		return nil, err
+	}
+	var ssh sshYaml
+	if sshPath := path.Join(dir, "ssh.yaml"); utils.FileExists(sshPath) {
+		if err := readYaml(sshPath, &ssh); err != nil {
+			return nil, err
+		}
+	}
+
+	return &ProxyConfig{
+		Server:    config.Server,
+		ProxyFQDN: config.ProxyFQDN,
+		MaxCache:  config.MaxCache,
+		Email:     config.Email,
+		CaCrt:     config.CaCrt,
+		SystemID:  httpd.Httpd.SystemID,
+		ServerCrt: decodePEM(httpd.Httpd.ServerCrt),
+		ServerKey: decodePEM(httpd.Httpd.ServerKey),
+		SSHKeyPub: ssh.SSH.ServerSSHKeyPub,
+
+		SSHPushKey:    ssh.SSH.ServerSSHPush,
+		SSHPushKeyPub: ssh.SSH.ServerSSHPushPub,
+	}, nil
+}
+
+// WriteProxyConfig writes the config.yaml, httpd.yaml and ssh.yaml files of a proxy configuration in dir.
+//
+// The files have the same format as those generated by the server.
+func WriteProxyConfig(dir string, config *ProxyConfig) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return utils.Errorf(err, L("failed to create folder %s"), dir)
+	}
+
+	configData := configYaml{
+		Server:    config.Server,
+		ProxyFQDN: config.ProxyFQDN,
+		MaxCache:  config.MaxCache,
+		Email:     config.Email,
+		CaCrt:     config.CaCrt,
+	}
+	if err := writeYaml(path.Join(dir, "config.yaml"), configData, 0644); err != nil {
+		return err
+	}
+
+	var httpd httpdYaml
+	httpd.Httpd.SystemID = config.SystemID
+	httpd.Httpd.ServerCrt = base64.StdEncoding.EncodeToString([]byte(config.ServerCrt))
+	httpd.Httpd.ServerKey = base64.StdEncoding.EncodeToString([]byte(config.ServerKey))
+	if err := writeYaml(path.Join(dir, "httpd.yaml"), httpd, 0600); err != nil {
+		return err
+	}
+
+	var ssh sshYaml
+	ssh.SSH.ServerSSHKeyPub = config.SSHKeyPub
+	ssh.SSH.ServerSSHPush = config.SSHPushKey
+	ssh.SSH.ServerSSHPushPub = config.SSHPushKeyPub
+	return writeYaml(path.Join(dir, "ssh.yaml"), ssh, 0600)
+}
+
+// ReplaceSSLMaterial replaces the CA, proxy certificate and key of the configuration in dir by those in newDir.
+//
+// The other values of the configuration files in dir are kept.
+func ReplaceSSLMaterial(dir string, newDir string) error {
+	var config configYaml
+	if err := readYaml(path.Join(newDir, "config.yaml"), &config); err != nil {
+		return err
+	}
+	var httpd httpdYaml
+	if err := readYaml(path.Join(newDir, "httpd.yaml"), &httpd); err != nil {
+		return err
+	}
+
+	if err := updateYaml(path.Join(dir, "config.yaml"), "", map[string]string{"ca_crt": config.CaCrt}); err != nil {
+		return err
+	}
+	return updateYaml(path.Join(dir, "httpd.yaml"), "httpd", map[string]string{
+		"server_crt": httpd.Httpd.ServerCrt,
+		"server_key": httpd.Httpd.ServerKey,
+	})
+}
+
+// updateYaml sets values in a YAML file, keeping the other values and their order.
+//
+// If section is not empty, the values are set in the mapping with this key.
+func updateYaml(file string, section string, values map[string]string) error {
+	info, err := os.Stat(file)
+	if err != nil {
+		return err
+	}
+	var content yaml.MapSlice
+	if err := readYaml(file, &content); err != nil {
+		return err
+	}
+
+	if section == "" {
+		content = setYamlValues(content, values)
+	} else {
+		found := false
+		for i, item := range content {
+			if mapping, ok := item.Value.(yaml.MapSlice); ok && item.Key == section {
+				content[i].Value = setYamlValues(mapping, values)
+				found = true
+			}
+		}
+		if !found {
+			return fmt.Errorf(L("no %[1]s section in %[2]s"), section, file)
+		}
+	}
+
+	data, err := yaml.Marshal(content)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(file, data, info.Mode().Perm()); err != nil {
+		return utils.Errorf(err, L("failed to write %s"), file)
+	}
+	return nil
+}
+
+func setYamlValues(mapping yaml.MapSlice, values map[string]string) yaml.MapSlice {
+	for key, value := range values {
+		found := false
+		for i, item := range mapping {
+			if item.Key == key {
+				mapping[i].Value = value
+				found = true
+			}
+		}
+		if !found {
+			mapping = append(mapping, yaml.MapItem{Key: key, Value: value})
+		}
+	}
+	return mapping
+}
+
+func writeYaml(file string, content interface{}, perm os.FileMode) error {
+	data, err := yaml.Marshal(content)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(file, data, perm); err != nil {
+		return utils.Errorf(err, L("failed to write %s"), file)
+	}
+	return nil
+}
+
+func readYaml(file string, out interface{}) error {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return utils.Errorf(err, L("failed to read %s"), file)
+	}
+	if err := yaml.Unmarshal(data, out); err != nil {
+		return utils.Errorf(err, L("failed to parse %s"), file)
+	}
+	return nil
+}
+
+// decodePEM returns the PEM content of the httpd.yaml values.
+//
+// The values are base64 encoded to be usable in kubernetes secrets.
+func decodePEM(value string) string {
+	if decoded, err := base64.StdEncoding.DecodeString(value); err == nil {
+		return string(decoded)
+	}
+	return value
+}
@@ -7,6 +7,7 @@ package utils
 import (
 	"fmt"
 	"path"
+	"strings"
 
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
@@ -26,6 +27,8 @@ type ProxyImageFlags struct {
 	SSH        types.ImageFlags `mapstructure:"ssh"`
 	Tftpd      types.ImageFlags `mapstructure:"tftpd"`
 	Tuning     Tuning           `mapstructure:"tuning"`
+	// Disable lists the optional components not to deploy.
+	Disable []string `mapstructure:"disable"`
 }
 
 // Tuning are the custom configuration file provide by users.
@@ -87,6 +90,12 @@ func AddImageFlags(cmd *cobra.Command) {
 
 	cmd.Flags().String("tuning-httpd", "", L("HTTPD tuning configuration file"))
 	cmd.Flags().String("tuning-squid", "", L("Squid tuning configuration file"))
+
+	cmd.Flags().StringSlice("disable", []string{},
+		fmt.Sprintf(L("Comma-separated list of components not to deploy, among: %s"),
+			strings.Join(OptionalComponents, ", "),
+		),
+	)
 }
 
 func addContainerImageFlags(cmd *cobra.Command, paramName string, imageName string) {
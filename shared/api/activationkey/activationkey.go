@@ -0,0 +1,181 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package activationkey
+
+import (
+	"errors"
+	"net/url"
+
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/types"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+// NoBaseChannel is the base channel label of the keys using the default base channel.
+const NoBaseChannel = "none"
+
+// ListActivationKeys returns the activation keys visible to the user.
+func ListActivationKeys(client *api.APIClient) ([]types.ActivationKey, error) {
+	res, err := api.Get[[]types.ActivationKey](client, "activationkey/listActivationKeys")
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to list the activation keys"))
+	}
+	if !res.Success {
+		return nil, errors.New(res.Message)
+	}
+	return res.Result, nil
+}
+
+// GetDetails returns the details of an activation key.
+func GetDetails(client *api.APIClient, key string) (*types.ActivationKey, error) {
+	res, err := api.Get[types.ActivationKey](client, "activationkey/getDetails?key="+url.QueryEscape(key))
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to get the details of activation key %s"), key)
+	}
+	if !res.Success {
+		return nil, errors.New(res.Message)
+	}
+	return &res.Result, nil
+}
+
+// Create creates an activation key.
+//
+// The server prefixes the key with the organization ID. A zero usageLimit means unlimited.
+// returns the created key.
+func Create(
+	client *api.APIClient,
+	key string,
+	description string,
+	baseChannel string,
+	usageLimit int,
+	entitlements []string,
+	universalDefault bool,
+) (string, error) {
+	// The API refuses null for the entitlements array
+	if entitlements == nil {
+		entitlements = []string{}
+	}
+	data := map[string]interface{}{
+		"key":              key,
+		"description":      description,
+		"baseChannelLabel": baseChannel,
+		"entitlements":     entitlements,
+		"universalDefault": universalDefault,
+	}
+	if usageLimit > 0 {
+		data["usageLimit"] = usageLimit
+	}
+	res, err := api.Post[string](client, "activationkey/create", data)
+	if err != nil {
+		return "", utils.Errorf(err, L("failed to create activation key %s"), key)
+	}
+	if !res.Success {
+		return "", errors.New(res.Message)
+	}
+	return res.Result, nil
+}
+
+// Delete deletes an activation key.
+func Delete(client *api.APIClient, key string) error {
+	return api.PostNoResult(client, "activationkey/delete", map[string]interface{}{"key": key},
+		L("failed to delete activation key %s"), key,
+	)
+}
+
+// SetDetails updates the details of an activation key.
+//
+// See the activationkey.setDetails API documentation for the keys of details.
+func SetDetails(client *api.APIClient, key string, details map[string]interface{}) error {
+	data := map[string]interface{}{
+		"key":     key,
+		"details": details,
+	}
+	return api.PostNoResult(client, "activationkey/setDetails", data, L("failed to update activation key %s"), key)
+}
+
+// AddChildChannels adds child channels to an activation key.
+func AddChildChannels(client *api.APIClient, key string, labels []string) error {
+	data := map[string]interface{}{"key": key, "childChannelLabels": labels}
+	return api.PostNoResult(client, "activationkey/addChildChannels", data,
+		L("failed to add child channels to activation key %s"), key,
+	)
+}
+
+// RemoveChildChannels removes child channels from an activation key.
+func RemoveChildChannels(client *api.APIClient, key string, labels []string) error {
+	data := map[string]interface{}{"key": key, "childChannelLabels": labels}
+	return api.PostNoResult(client, "activationkey/removeChildChannels", data,
+		L("failed to remove child channels from activation key %s"), key,
+	)
+}
+
+// AddEntitlements adds entitlements to an activation key.
+func AddEntitlements(client *api.APIClient, key string, entitlements []string) error {
+	data := map[string]interface{}{"key": key, "entitlements": entitlements}
+	return api.PostNoResult(client, "activationkey/addEntitlements", data,
+		L("failed to add entitlements to activation key %s"), key,
+	)
+}
+
+// RemoveEntitlements removes entitlements from an activation key.
+func RemoveEntitlements(client *api.APIClient, key string, entitlements []string) error {
+	data := map[string]interface{}{"key": key, "entitlements": entitlements}
+	return api.PostNoResult(client, "activationkey/removeEntitlements", data,
+		L("failed to remove entitlements from activation key %s"), key,
+	)
+}
+
+// AddServerGroups adds system groups to an activation key.
+func AddServerGroups(client *api.APIClient, key string, groupIDs []int) error {
+	data := map[string]interface{}{"key": key, "serverGroupIds": groupIDs}
+	return api.PostNoResult(client, "activationkey/addServerGroups", data,
+		L("failed to add system groups to activation key %s"), key,
+	)
+}
+
+// RemoveServerGroups removes system groups from an activation key.
+func RemoveServerGroups(client *api.APIClient, key string, groupIDs []int) error {
+	data := map[string]interface{}{"key": key, "serverGroupIds": groupIDs}
+	return api.PostNoResult(client, "activationkey/removeServerGroups", data,
+		L("failed to remove system groups from activation key %s"), key,
+	)
+}
+
+// AddPackages adds packages to install to an activation key.
+func AddPackages(client *api.APIClient, key string, packages []types.PackageArch) error {
+	data := map[string]interface{}{"key": key, "packages": packages}
+	return api.PostNoResult(client, "activationkey/addPackages", data,
+		L("failed to add packages to activation key %s"), key,
+	)
+}
+
+// RemovePackages removes packages to install from an activation key.
+func RemovePackages(client *api.APIClient, key string, packages []types.PackageArch) error {
+	data := map[string]interface{}{"key": key, "packages": packages}
+	return api.PostNoResult(client, "activationkey/removePackages", data,
+		L("failed to remove packages from activation key %s"), key,
+	)
+}
+
+// ListConfigChannels returns the configuration channels of an activation key, ordered by rank.
+func ListConfigChannels(client *api.APIClient, key string) ([]types.ConfigChannel, error) {
+	res, err := api.Get[[]types.ConfigChannel](client, "activationkey/listConfigChannels?key="+url.QueryEscape(key))
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to list the configuration channels of activation key %s"), key)
+	}
+	if !res.Success {
+		return nil, errors.New(res.Message)
+	}
+	return res.Result, nil
+}
+
+// SetConfigChannels replaces the configuration channels of an activation key.
+func SetConfigChannels(client *api.APIClient, key string, labels []string) error {
+	data := map[string]interface{}{"keys": []string{key}, "configChannelLabels": labels}
+	return api.PostNoResult(client, "activationkey/setConfigChannels", data,
+		L("failed to set the configuration channels of activation key %s"), key,
+	)
+}
@@ -92,18 +92,10 @@ func (c *APIClient) sendRequest(req *http.Request) (*http.Response, error) {
 	return res, nil
 }
 
-// Init returns a HTTPClient object for further API use.
+// newHTTPClient creates the HTTP client with the TLS configuration from the connection details.
 //
-// Provided connectionDetails must have Server specified with FQDN to the
-// target host.
-//
-// Optionaly connectionDetails can have user name and password set and Init
-// will try to login to the host.
-// caCert can be set to use custom CA certificate to validate target host.
-func Init(conn *ConnectionDetails) (*APIClient, error) {
-	// Load stored credentials as it also loads up server URL and CApath
-	getStoredConnectionDetails(conn)
-
+// The returned error is the one from loading the system certificates pool, the client is still usable.
+func newHTTPClient(conn *ConnectionDetails) (*http.Client, error) {
 	caCertPool, err := x509.SystemCertPool()
 	if err != nil {
 		log.Warn().Msg(err.Error())
@@ -116,21 +108,38 @@ func Init(conn *ConnectionDetails) (*APIClient, error) {
 		caCertPool.AppendCertsFromPEM(caCert)
 	}
 
+	return &http.Client{
+		Timeout: time.Minute,
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				RootCAs:            caCertPool,
+				InsecureSkipVerify: conn.Insecure,
+			},
+		},
+	}, err
+}
+
+// Init returns a HTTPClient object for further API use.
+//
+// Provided connectionDetails must have Server specified with FQDN to the
+// target host.
+//
+// Optionaly connectionDetails can have user name and password set and Init
+// will try to login to the host.
+// caCert can be set to use custom CA certificate to validate target host.
+func Init(conn *ConnectionDetails) (*APIClient, error) {
+	// Load stored credentials as it also loads up server URL and CApath
+	getStoredConnectionDetails(conn)
+
+	httpClient, err := newHTTPClient(conn)
+
 	if conn.Server == "" {
 		return nil, errors.New(L("server URL is not provided"))
 	}
 	client := &APIClient{
 		Details: conn,
 		BaseURL: fmt.Sprintf("https://%s%s", conn.Server, rootPathApiv1),
-		Client: &http.Client{
-			Timeout: time.Minute,
-			Transport: &http.Transport{
-				TLSClientConfig: &tls.Config{
-					RootCAs:            caCertPool,
-					InsecureSkipVerify: conn.Insecure,
-				},
-			},
-		},
+		Client:  httpClient,
 	}
 	if conn.Cookie != "" {
 		client.AuthCookie = &http.Cookie{
@@ -322,3 +331,19 @@ func Get[T interface{}](client *APIClient, path string) (*APIResponse[T], error)
 
 	return &response, nil
 }
+
+// PostNoResult issues a POST HTTP request to the API target ignoring the result of the call.
+//
+// The errors are wrapped with the message formatted with args.
+func PostNoResult(
+	client *APIClient, path string, data map[string]interface{}, message string, args ...interface{},
+) error {
+	res, err := Post[interface{}](client, path, data)
+	if err != nil {
+		return utils.Errorf(err, message, args...)
+	}
+	if !res.Success {
+		return errors.New(res.Message)
+	}
+	return nil
+}
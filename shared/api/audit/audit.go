@@ -0,0 +1,39 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"errors"
+	"net/url"
+
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/types"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+// PatchStatuses are the statuses of a system for a CVE, from the most to the least critical.
+var PatchStatuses = []string{
+	"AFFECTED_PATCH_INAPPLICABLE",
+	"AFFECTED_PATCH_INAPPLICABLE_SUCCESSOR_PRODUCT",
+	"AFFECTED_PARTIAL_PATCH_APPLICABLE",
+	"AFFECTED_PATCH_APPLICABLE",
+	"NOT_AFFECTED",
+	"PATCHED",
+}
+
+// ListSystemsByPatchStatus returns the status of all the systems for a CVE.
+func ListSystemsByPatchStatus(client *api.APIClient, cve string) ([]types.CVEAuditSystem, error) {
+	res, err := api.Get[[]types.CVEAuditSystem](client,
+		"audit/listSystemsByPatchStatus?cveIdentifier="+url.QueryEscape(cve),
+	)
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to audit the systems for %s"), cve)
+	}
+	if !res.Success {
+		return nil, errors.New(res.Message)
+	}
+	return res.Result, nil
+}
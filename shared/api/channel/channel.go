@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package channel
+
+import (
+	"errors"
+	"net/url"
+
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/types"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+// ListAllChannels returns all the software channels visible to the user.
+func ListAllChannels(client *api.APIClient) ([]types.ChannelOverview, error) {
+	res, err := api.Get[[]types.ChannelOverview](client, "channel/listAllChannels")
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to list the channels"))
+	}
+	if !res.Success {
+		return nil, errors.New(res.Message)
+	}
+	return res.Result, nil
+}
+
+// GetDetails returns the details of a software channel.
+func GetDetails(client *api.APIClient, label string) (*types.ChannelDetails, error) {
+	res, err := api.Get[types.ChannelDetails](client,
+		"channel/software/getDetails?channelLabel="+url.QueryEscape(label),
+	)
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to get the details of channel %s"), label)
+	}
+	if !res.Success {
+		return nil, errors.New(res.Message)
+	}
+	return &res.Result, nil
+}
+
+// Clone clones a software channel.
+//
+// If originalState is true, the errata and packages of the channel are cloned without the later updates.
+// returns the ID of the new channel.
+func Clone(
+	client *api.APIClient,
+	original string,
+	details types.ChannelCloneDetails,
+	originalState bool,
+) (int, error) {
+	data := map[string]interface{}{
+		"originalLabel":  original,
+		"channelDetails": details,
+		"originalState":  originalState,
+	}
+	res, err := api.Post[int](client, "channel/software/clone", data)
+	if err != nil {
+		return 0, utils.Errorf(err, L("failed to clone channel %s"), original)
+	}
+	if !res.Success {
+		return 0, errors.New(res.Message)
+	}
+	return res.Result, nil
+}
+
+// SyncRepo schedules the synchronization of the repositories of software channels.
+func SyncRepo(client *api.APIClient, labels []string) error {
+	data := map[string]interface{}{
+		"channelLabels": labels,
+	}
+	return api.PostNoResult(client, "channel/software/syncRepo", data,
+		L("failed to schedule the channels synchronization"),
+	)
+}
+
+// ListChannelRepos returns the repositories of a software channel.
+func ListChannelRepos(client *api.APIClient, label string) ([]types.ContentSource, error) {
+	res, err := api.Get[[]types.ContentSource](client,
+		"channel/software/listChannelRepos?channelLabel="+url.QueryEscape(label),
+	)
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to list the repositories of channel %s"), label)
+	}
+	if !res.Success {
+		return nil, errors.New(res.Message)
+	}
+	return res.Result, nil
+}
+
+// FindUserRepo returns the repository of the user organization with the label or nil if there is none.
+func FindUserRepo(client *api.APIClient, label string) (*types.ContentSource, error) {
+	repos, err := ListUserRepos(client)
+	if err != nil {
+		return nil, err
+	}
+	for _, repo := range repos {
+		if repo.Label == label {
+			return &repo, nil
+		}
+	}
+	return nil, nil
+}
+
+// ListUserRepos returns the repositories of the user organization.
+func ListUserRepos(client *api.APIClient) ([]types.ContentSource, error) {
+	res, err := api.Get[[]types.ContentSource](client, "channel/software/listUserRepos")
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to list the repositories"))
+	}
+	if !res.Success {
+		return nil, errors.New(res.Message)
+	}
+	return res.Result, nil
+}
+
+// CreateRepo creates a repository.
+//
+// repoType is the type of the repository like yum, uln or deb.
+func CreateRepo(client *api.APIClient, label string, repoType string, sourceURL string) error {
+	data := map[string]interface{}{
+		"label": label,
+		"type":  repoType,
+		"url":   sourceURL,
+	}
+	return api.PostNoResult(client, "channel/software/createRepo", data, L("failed to create repository %s"), label)
+}
+
+// RemoveRepo deletes a repository.
+func RemoveRepo(client *api.APIClient, label string) error {
+	data := map[string]interface{}{
+		"label": label,
+	}
+	return api.PostNoResult(client, "channel/software/removeRepo", data, L("failed to remove repository %s"), label)
+}
+
+// AssociateRepo adds a repository to a software channel.
+func AssociateRepo(client *api.APIClient, channelLabel string, repoLabel string) error {
+	return postRepoAssociation(client, "channel/software/associateRepo", channelLabel, repoLabel)
+}
+
+// DisassociateRepo removes a repository from a software channel.
+func DisassociateRepo(client *api.APIClient, channelLabel string, repoLabel string) error {
+	return postRepoAssociation(client, "channel/software/disassociateRepo", channelLabel, repoLabel)
+}
+
+func postRepoAssociation(client *api.APIClient, path string, channelLabel string, repoLabel string) error {
+	data := map[string]interface{}{
+		"channelLabel": channelLabel,
+		"repoLabel":    repoLabel,
+	}
+	return api.PostNoResult(client, path, data, L("failed to update the repositories of channel %s"), channelLabel)
+}
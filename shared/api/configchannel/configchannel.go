@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package configchannel
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/types"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+// Types of configuration channels and files.
+const (
+	NormalChannel = "normal"
+	StateChannel  = "state"
+	FileType      = "file"
+	DirectoryType = "directory"
+	SymlinkType   = "symlink"
+)
+
+// ListGlobals returns the configuration channels of the organization.
+func ListGlobals(client *api.APIClient) ([]types.ConfigChannel, error) {
+	res, err := api.Get[[]types.ConfigChannel](client, "configchannel/listGlobals")
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to list the configuration channels"))
+	}
+	if !res.Success {
+		return nil, errors.New(res.Message)
+	}
+	return res.Result, nil
+}
+
+// Create creates a configuration channel of type NormalChannel or StateChannel.
+func Create(client *api.APIClient, label string, name string, description string, channelType string) error {
+	data := map[string]interface{}{
+		"label":       label,
+		"name":        name,
+		"description": description,
+		"type":        channelType,
+	}
+	res, err := api.Post[types.ConfigChannel](client, "configchannel/create", data)
+	if err != nil {
+		return utils.Errorf(err, L("failed to create configuration channel %s"), label)
+	}
+	if !res.Success {
+		return errors.New(res.Message)
+	}
+	return nil
+}
+
+// ListFiles returns the files, directories and symlinks of a configuration channel.
+func ListFiles(client *api.APIClient, label string) ([]types.ConfigFile, error) {
+	res, err := api.Get[[]types.ConfigFile](client, "configchannel/listFiles?label="+url.QueryEscape(label))
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to list the files of configuration channel %s"), label)
+	}
+	if !res.Success {
+		return nil, errors.New(res.Message)
+	}
+	return res.Result, nil
+}
+
+// LookupFileInfo returns the latest revision of configuration files, including their contents.
+func LookupFileInfo(client *api.APIClient, label string, paths []string) ([]types.ConfigFileInfo, error) {
+	query := url.Values{"label": []string{label}, "paths": paths}
+	res, err := api.Get[[]types.ConfigFileInfo](client, "configchannel/lookupFileInfo?"+query.Encode())
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to get the files of configuration channel %s"), label)
+	}
+	if !res.Success {
+		return nil, errors.New(res.Message)
+	}
+	return res.Result, nil
+}
+
+// GetContents returns the decoded contents of a file.
+func GetContents(info *types.ConfigFileInfo) ([]byte, error) {
+	if !info.Base64 {
+		return []byte(info.Contents), nil
+	}
+	contents, err := base64.StdEncoding.DecodeString(info.Contents)
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to decode the contents of %s"), info.Path)
+	}
+	return contents, nil
+}
+
+// CreateOrUpdateFile stores a new revision of a file with the given contents and permissions.
+func CreateOrUpdateFile(client *api.APIClient, label string, path string, contents []byte, permissions int) error {
+	data := map[string]interface{}{
+		"label": label,
+		"path":  path,
+		"isDir": false,
+		"pathInfo": map[string]interface{}{
+			"contents":       base64.StdEncoding.EncodeToString(contents),
+			"contents_enc64": true,
+			"owner":          "root",
+			"group":          "root",
+			"permissions":    fmt.Sprintf("%o", permissions),
+		},
+	}
+	return api.PostNoResult(client, "configchannel/createOrUpdatePath", data,
+		L("failed to store %[1]s in configuration channel %[2]s"), path, label,
+	)
+}
+
+// CreateOrUpdateDirectory creates a directory in a configuration channel.
+func CreateOrUpdateDirectory(client *api.APIClient, label string, path string, permissions int) error {
+	data := map[string]interface{}{
+		"label": label,
+		"path":  path,
+		"isDir": true,
+		"pathInfo": map[string]interface{}{
+			"owner":       "root",
+			"group":       "root",
+			"permissions": fmt.Sprintf("%o", permissions),
+		},
+	}
+	return api.PostNoResult(client, "configchannel/createOrUpdatePath", data,
+		L("failed to store %[1]s in configuration channel %[2]s"), path, label,
+	)
+}
+
+// CreateOrUpdateSymlink creates a symbolic link in a configuration channel.
+func CreateOrUpdateSymlink(client *api.APIClient, label string, path string, target string) error {
+	data := map[string]interface{}{
+		"label":    label,
+		"path":     path,
+		"pathInfo": map[string]interface{}{"target_path": target},
+	}
+	return api.PostNoResult(client, "configchannel/createOrUpdateSymlink", data,
+		L("failed to store %[1]s in configuration channel %[2]s"), path, label,
+	)
+}
+
+// DeleteFiles removes files from a configuration channel.
+func DeleteFiles(client *api.APIClient, label string, paths []string) error {
+	data := map[string]interface{}{"label": label, "paths": paths}
+	res, err := api.Post[int](client, "configchannel/deleteFiles", data)
+	if err != nil {
+		return utils.Errorf(err, L("failed to remove files from configuration channel %s"), label)
+	}
+	if !res.Success {
+		return errors.New(res.Message)
+	}
+	return nil
+}
@@ -0,0 +1,296 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package contentmanagement
+
+import (
+	"errors"
+	"net/url"
+
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/types"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+// SoftwareSource is the type of the software channel sources.
+const SoftwareSource = "software"
+
+// Statuses of the environments.
+const (
+	StatusBuilt  = "built"
+	StatusFailed = "failed"
+)
+
+// ListProjects returns the content lifecycle management projects.
+func ListProjects(client *api.APIClient) ([]types.ContentProject, error) {
+	res, err := api.Get[[]types.ContentProject](client, "contentmanagement/listProjects")
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to list the content projects"))
+	}
+	if !res.Success {
+		return nil, errors.New(res.Message)
+	}
+	return res.Result, nil
+}
+
+// CreateProject creates a content lifecycle management project.
+func CreateProject(client *api.APIClient, label string, name string, description string) error {
+	data := map[string]interface{}{
+		"projectLabel": label,
+		"name":         name,
+		"description":  description,
+	}
+	res, err := api.Post[types.ContentProject](client, "contentmanagement/createProject", data)
+	if err != nil {
+		return utils.Errorf(err, L("failed to create content project %s"), label)
+	}
+	if !res.Success {
+		return errors.New(res.Message)
+	}
+	return nil
+}
+
+// UpdateProject changes the name and description of a project.
+func UpdateProject(client *api.APIClient, label string, name string, description string) error {
+	data := map[string]interface{}{
+		"projectLabel": label,
+		"props":        map[string]string{"name": name, "description": description},
+	}
+	res, err := api.Post[types.ContentProject](client, "contentmanagement/updateProject", data)
+	if err != nil {
+		return utils.Errorf(err, L("failed to update content project %s"), label)
+	}
+	if !res.Success {
+		return errors.New(res.Message)
+	}
+	return nil
+}
+
+// ListEnvironments returns the environments of a project in promotion order.
+func ListEnvironments(client *api.APIClient, project string) ([]types.ContentEnvironment, error) {
+	res, err := api.Get[[]types.ContentEnvironment](client,
+		"contentmanagement/listProjectEnvironments?projectLabel="+url.QueryEscape(project),
+	)
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to list the environments of content project %s"), project)
+	}
+	if !res.Success {
+		return nil, errors.New(res.Message)
+	}
+	return res.Result, nil
+}
+
+// LookupEnvironment returns an environment of a project.
+func LookupEnvironment(client *api.APIClient, project string, env string) (*types.ContentEnvironment, error) {
+	res, err := api.Get[types.ContentEnvironment](client, "contentmanagement/lookupEnvironment?projectLabel="+
+		url.QueryEscape(project)+"&envLabel="+url.QueryEscape(env),
+	)
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to get environment %[1]s of content project %[2]s"), env, project)
+	}
+	if !res.Success {
+		return nil, errors.New(res.Message)
+	}
+	return &res.Result, nil
+}
+
+// CreateEnvironment creates an environment after the predecessor one.
+//
+// An empty predecessor inserts the environment at the start of the project.
+func CreateEnvironment(
+	client *api.APIClient,
+	project string,
+	predecessor string,
+	label string,
+	name string,
+	description string,
+) error {
+	data := map[string]interface{}{
+		"projectLabel":     project,
+		"predecessorLabel": predecessor,
+		"envLabel":         label,
+		"name":             name,
+		"description":      description,
+	}
+	res, err := api.Post[types.ContentEnvironment](client, "contentmanagement/createEnvironment", data)
+	if err != nil {
+		return utils.Errorf(err, L("failed to create environment %[1]s of content project %[2]s"), label, project)
+	}
+	if !res.Success {
+		return errors.New(res.Message)
+	}
+	return nil
+}
+
+// UpdateEnvironment changes the name and description of an environment.
+func UpdateEnvironment(client *api.APIClient, project string, label string, name string, description string) error {
+	data := map[string]interface{}{
+		"projectLabel": project,
+		"envLabel":     label,
+		"props":        map[string]string{"name": name, "description": description},
+	}
+	res, err := api.Post[types.ContentEnvironment](client, "contentmanagement/updateEnvironment", data)
+	if err != nil {
+		return utils.Errorf(err, L("failed to update environment %[1]s of content project %[2]s"), label, project)
+	}
+	if !res.Success {
+		return errors.New(res.Message)
+	}
+	return nil
+}
+
+// RemoveEnvironment removes an environment and its channels.
+func RemoveEnvironment(client *api.APIClient, project string, label string) error {
+	data := map[string]interface{}{"projectLabel": project, "envLabel": label}
+	return api.PostNoResult(client, "contentmanagement/removeEnvironment", data,
+		L("failed to remove environment %[1]s of content project %[2]s"), label, project,
+	)
+}
+
+// ListSources returns the sources of a project.
+func ListSources(client *api.APIClient, project string) ([]types.ContentProjectSource, error) {
+	res, err := api.Get[[]types.ContentProjectSource](client,
+		"contentmanagement/listProjectSources?projectLabel="+url.QueryEscape(project),
+	)
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to list the sources of content project %s"), project)
+	}
+	if !res.Success {
+		return nil, errors.New(res.Message)
+	}
+	return res.Result, nil
+}
+
+// AttachSource adds a software channel source to a project.
+func AttachSource(client *api.APIClient, project string, channel string) error {
+	data := map[string]interface{}{
+		"projectLabel": project,
+		"sourceType":   SoftwareSource,
+		"sourceLabel":  channel,
+	}
+	res, err := api.Post[types.ContentProjectSource](client, "contentmanagement/attachSource", data)
+	if err != nil {
+		return utils.Errorf(err, L("failed to attach source %[1]s to content project %[2]s"), channel, project)
+	}
+	if !res.Success {
+		return errors.New(res.Message)
+	}
+	return nil
+}
+
+// DetachSource removes a software channel source from a project.
+func DetachSource(client *api.APIClient, project string, channel string) error {
+	data := map[string]interface{}{
+		"projectLabel": project,
+		"sourceType":   SoftwareSource,
+		"sourceLabel":  channel,
+	}
+	return api.PostNoResult(client, "contentmanagement/detachSource", data,
+		L("failed to detach source %[1]s from content project %[2]s"), channel, project,
+	)
+}
+
+// ListFilters returns the content filters of the organization.
+func ListFilters(client *api.APIClient) ([]types.ContentFilter, error) {
+	res, err := api.Get[[]types.ContentFilter](client, "contentmanagement/listFilters")
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to list the content filters"))
+	}
+	if !res.Success {
+		return nil, errors.New(res.Message)
+	}
+	return res.Result, nil
+}
+
+// CreateFilter creates a content filter.
+//
+// returns the created filter.
+func CreateFilter(client *api.APIClient, filter *types.ContentFilter) (*types.ContentFilter, error) {
+	data := map[string]interface{}{
+		"name":       filter.Name,
+		"rule":       filter.Rule,
+		"entityType": filter.EntityType,
+		"criteria":   filter.Criteria,
+	}
+	res, err := api.Post[types.ContentFilter](client, "contentmanagement/createFilter", data)
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to create content filter %s"), filter.Name)
+	}
+	if !res.Success {
+		return nil, errors.New(res.Message)
+	}
+	return &res.Result, nil
+}
+
+// UpdateFilter changes the name, rule and criteria of a content filter.
+//
+// The entity type of a filter cannot be changed.
+func UpdateFilter(client *api.APIClient, filter *types.ContentFilter) error {
+	data := map[string]interface{}{
+		"filterId": filter.ID,
+		"name":     filter.Name,
+		"rule":     filter.Rule,
+		"criteria": filter.Criteria,
+	}
+	res, err := api.Post[types.ContentFilter](client, "contentmanagement/updateFilter", data)
+	if err != nil {
+		return utils.Errorf(err, L("failed to update content filter %s"), filter.Name)
+	}
+	if !res.Success {
+		return errors.New(res.Message)
+	}
+	return nil
+}
+
+// ListProjectFilters returns the filters attached to a project.
+func ListProjectFilters(client *api.APIClient, project string) ([]types.ContentProjectFilter, error) {
+	res, err := api.Get[[]types.ContentProjectFilter](client,
+		"contentmanagement/listProjectFilters?projectLabel="+url.QueryEscape(project),
+	)
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to list the filters of content project %s"), project)
+	}
+	if !res.Success {
+		return nil, errors.New(res.Message)
+	}
+	return res.Result, nil
+}
+
+// AttachFilter adds a filter to a project.
+func AttachFilter(client *api.APIClient, project string, filterID int) error {
+	data := map[string]interface{}{"projectLabel": project, "filterId": filterID}
+	res, err := api.Post[types.ContentFilter](client, "contentmanagement/attachFilter", data)
+	if err != nil {
+		return utils.Errorf(err, L("failed to attach filter %[1]d to content project %[2]s"), filterID, project)
+	}
+	if !res.Success {
+		return errors.New(res.Message)
+	}
+	return nil
+}
+
+// DetachFilter removes a filter from a project.
+func DetachFilter(client *api.APIClient, project string, filterID int) error {
+	data := map[string]interface{}{"projectLabel": project, "filterId": filterID}
+	return api.PostNoResult(client, "contentmanagement/detachFilter", data,
+		L("failed to detach filter %[1]d from content project %[2]s"), filterID, project,
+	)
+}
+
+// BuildProject builds the first environment of a project from its sources and filters.
+func BuildProject(client *api.APIClient, project string, message string) error {
+	data := map[string]interface{}{"projectLabel": project, "message": message}
+	return api.PostNoResult(client, "contentmanagement/buildProject", data,
+		L("failed to build content project %s"), project,
+	)
+}
+
+// PromoteProject promotes the content of an environment to the next one.
+func PromoteProject(client *api.APIClient, project string, env string) error {
+	data := map[string]interface{}{"projectLabel": project, "envLabel": env}
+	return api.PostNoResult(client, "contentmanagement/promoteProject", data,
+		L("failed to promote environment %[1]s of content project %[2]s"), env, project,
+	)
+}
@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package contentsync
+
+import (
+	"errors"
+
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/types"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+// InstalledChannel is the status of the vendor channels already added to the server.
+const InstalledChannel = "installed"
+
+// ListCredentials returns the SCC organization credentials.
+func ListCredentials(client *api.APIClient) ([]types.ContentSyncCredentials, error) {
+	res, err := api.Get[[]types.ContentSyncCredentials](client, "sync/content/listCredentials")
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to list the SCC credentials"))
+	}
+	if !res.Success {
+		return nil, errors.New(res.Message)
+	}
+	return res.Result, nil
+}
+
+// AddCredentials adds SCC organization credentials.
+func AddCredentials(client *api.APIClient, user string, password string, primary bool) error {
+	data := map[string]interface{}{
+		"username": user,
+		"password": password,
+		"primary":  primary,
+	}
+	return api.PostNoResult(client, "sync/content/addCredentials", data,
+		L("failed to add the SCC credentials of %s"), user,
+	)
+}
+
+// ListChannels returns the vendor channels available from the SCC credentials.
+func ListChannels(client *api.APIClient) ([]types.ContentSyncChannel, error) {
+	res, err := api.Get[[]types.ContentSyncChannel](client, "sync/content/listChannels")
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to list the vendor channels"))
+	}
+	if !res.Success {
+		return nil, errors.New(res.Message)
+	}
+	return res.Result, nil
+}
+
+// AddChannels adds a vendor channel with its mandatory children.
+//
+// returns the labels of the added channels.
+func AddChannels(client *api.APIClient, label string) ([]string, error) {
+	data := map[string]interface{}{
+		"channelLabel": label,
+		"mirrorUrl":    "",
+	}
+	res, err := api.Post[[]string](client, "sync/content/addChannels", data)
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to add vendor channel %s"), label)
+	}
+	if !res.Success {
+		return nil, errors.New(res.Message)
+	}
+	return res.Result, nil
+}
+
+// Refresh synchronizes the channel families, products, repositories and subscriptions from SCC.
+func Refresh(client *api.APIClient) error {
+	steps := []string{
+		"synchronizeChannelFamilies",
+		"synchronizeProducts",
+		"synchronizeRepositories",
+		"synchronizeSubscriptions",
+	}
+	for _, step := range steps {
+		data := map[string]interface{}{}
+		if step == "synchronizeRepositories" {
+			data["mirrorUrl"] = ""
+		}
+		err := api.PostNoResult(client, "sync/content/"+step, data, L("failed to refresh the products from SCC"))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
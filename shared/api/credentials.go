@@ -20,12 +20,30 @@ func StoreLoginCreds(client *APIClient) error {
 	if client.AuthCookie.Value == "" {
 		return errors.New(L("not logged in, session cookie is missing"))
 	}
+	return storeSession(client.AuthCookie.Value, client.Details)
+}
+
+// StoreXMLRPCLoginCreds stores the XML-RPC session for future API use.
+//
+// The server XML-RPC session key is the same as the JSON API session cookie,
+// but Hub sessions are only valid on the Hub XML-RPC API and cannot be stored.
+func StoreXMLRPCLoginCreds(client *XMLRPCClient) error {
+	if client.Hub {
+		return errors.New(L("Hub XML-RPC API sessions cannot be stored"))
+	}
+	if client.SessionKey == "" {
+		return errors.New(L("not logged in, session key is missing"))
+	}
+	return storeSession(client.SessionKey, client.Details)
+}
+
+func storeSession(session string, details *ConnectionDetails) error {
 	// Future: Add support for more servers if needed in the future
 	auth := []authStorage{
 		{
-			Session: client.AuthCookie.Value,
-			Server:  client.Details.Server,
-			CApath:  client.Details.CApath,
+			Session: session,
+			Server:  details.Server,
+			CApath:  details.CApath,
 		},
 	}
 
@@ -0,0 +1,26 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package errata
+
+import (
+	"errors"
+	"net/url"
+
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+// ListCves returns the CVEs fixed by a patch.
+func ListCves(client *api.APIClient, advisory string) ([]string, error) {
+	res, err := api.Get[[]string](client, "errata/listCves?advisoryName="+url.QueryEscape(advisory))
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to list the CVEs of %s"), advisory)
+	}
+	if !res.Success {
+		return nil, errors.New(res.Message)
+	}
+	return res.Result, nil
+}
@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fake
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// Fixture is a canned API response stored in a JSON file.
+//
+// A fixture file looks like this:
+//
+//	{
+//	  "method": "GET",
+//	  "path": "system/listSystems",
+//	  "params": {"sid": "1000010000"},
+//	  "response": {"success": true, "result": []}
+//	}
+//
+// The params are optional: when set, the fixture only matches calls having those parameters.
+// The status defaults to 200.
+type Fixture struct {
+	Method   string                 `json:"method"`
+	Path     string                 `json:"path"`
+	Params   map[string]interface{} `json:"params,omitempty"`
+	Status   int                    `json:"status,omitempty"`
+	Response json.RawMessage        `json:"response"`
+}
+
+// ReadFixture reads a fixture from a JSON file.
+func ReadFixture(path string) (*Fixture, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var fixture Fixture
+	if err := json.Unmarshal(data, &fixture); err != nil {
+		return nil, fmt.Errorf("failed to parse fixture %s: %w", path, err)
+	}
+	if fixture.Method == "" || fixture.Path == "" {
+		return nil, fmt.Errorf("fixture %s requires a method and a path", path)
+	}
+	return &fixture, nil
+}
+
+// LoadFixtures registers all the JSON fixture files from a folder.
+//
+// Files are loaded in alphabetical order: on conflict the last one wins.
+func (s *Server) LoadFixtures(dir string) error {
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return errors.New("no fixture found in " + dir)
+	}
+	sort.Strings(files)
+
+	for _, file := range files {
+		fixture, err := ReadFixture(file)
+		if err != nil {
+			return err
+		}
+		s.HandleFixture(fixture)
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fake
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+)
+
+const redacted = "<REDACTED>"
+
+// Recorder is an api.HTTPClient capturing the traffic with a real server into fixture files.
+//
+// Use it by replacing the Client of an api.APIClient:
+//
+//	client.Client = fake.NewRecorder(client.Client, "testdata/fixtures")
+//
+// Authentication calls are not recorded since the fake server handles them.
+// Parameters containing passwords are redacted.
+type Recorder struct {
+	client api.HTTPClient
+	dir    string
+	mutex  sync.Mutex
+	names  map[string]int
+}
+
+// NewRecorder creates a recorder wrapping an HTTP client and writing the fixtures in dir.
+func NewRecorder(client api.HTTPClient, dir string) *Recorder {
+	return &Recorder{
+		client: client,
+		dir:    dir,
+		names:  map[string]int{},
+	}
+}
+
+// Do fulfills the api.HTTPClient interface.
+func (r *Recorder) Do(req *http.Request) (*http.Response, error) {
+	var reqBody []byte
+	if req.Body != nil {
+		var err error
+		if reqBody, err = io.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(reqBody))
+	}
+
+	res, err := r.client.Do(req)
+	if err != nil || !strings.HasPrefix(req.URL.Path, APIRoot) {
+		return res, err
+	}
+
+	path := strings.Trim(strings.TrimPrefix(req.URL.Path, APIRoot), "/")
+	if strings.HasPrefix(path, "auth/") {
+		return res, err
+	}
+
+	var resBody []byte
+	if res.Body != nil {
+		if resBody, err = io.ReadAll(res.Body); err != nil {
+			return nil, err
+		}
+		res.Body.Close()
+		res.Body = io.NopCloser(bytes.NewReader(resBody))
+	}
+
+	fixture := Fixture{
+		Method: req.Method,
+		Path:   path,
+		Params: queryToParams(req.URL.Query()),
+		Status: res.StatusCode,
+	}
+	if len(reqBody) > 0 {
+		// Non JSON bodies are not supported by the API anyway
+		_ = json.Unmarshal(reqBody, &fixture.Params)
+	}
+	redactParams(fixture.Params)
+	if len(fixture.Params) == 0 {
+		fixture.Params = nil
+	}
+	if json.Valid(resBody) {
+		fixture.Response = resBody
+	} else {
+		fixture.Response, _ = json.Marshal(string(resBody))
+	}
+
+	if err := r.write(&fixture); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (r *Recorder) write(fixture *Fixture) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	name := strings.ToLower(fixture.Method) + "_" + strings.ReplaceAll(fixture.Path, "/", "_")
+	count := r.names[name]
+	r.names[name] = count + 1
+	if count > 0 {
+		name = fmt.Sprintf("%s-%d", name, count)
+	}
+
+	data, err := json.MarshalIndent(fixture, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(r.dir, 0700); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(r.dir, name+".json"), data, 0600)
+}
+
+func redactParams(params map[string]interface{}) {
+	for key := range params {
+		if strings.Contains(strings.ToLower(key), "password") {
+			params[key] = redacted
+		}
+	}
+}
@@ -0,0 +1,345 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package fake provides an in-process Uyuni API server to run commands against in tests.
+package fake
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+)
+
+// APIRoot is the path under which the fake server exposes the API.
+const APIRoot = "/rhn/manager/api/"
+
+const sessionCookieName = "pxt-session-cookie"
+
+// Request is an API call received by the fake server.
+type Request struct {
+	Method string
+
+	// Path is the API path relative to the API root, like system/listSystems.
+	Path string
+
+	// Params contains the query parameters for GET requests and the decoded JSON body for POST requests.
+	Params map[string]interface{}
+}
+
+// Response is the answer of a handler.
+type Response struct {
+	Status int
+	Body   interface{}
+}
+
+// HandlerFunc computes the response of an API call.
+type HandlerFunc func(req *Request) *Response
+
+// Success returns a successful API response with the given result.
+func Success(result interface{}) *Response {
+	return &Response{
+		Status: http.StatusOK,
+		Body:   map[string]interface{}{"success": true, "result": result},
+	}
+}
+
+// Failure returns a failed API response with the given message.
+func Failure(message string) *Response {
+	return &Response{
+		Status: http.StatusOK,
+		Body:   map[string]interface{}{"success": false, "message": message},
+	}
+}
+
+type route struct {
+	params    map[string]interface{}
+	anonymous bool
+	handler   HandlerFunc
+}
+
+// Server is a fake Uyuni API server running in the current process.
+type Server struct {
+	// User is the login accepted by auth/login.
+	User string
+
+	// Password is the password accepted by auth/login.
+	Password string
+
+	server   *httptest.Server
+	mutex    sync.Mutex
+	routes   map[string][]route
+	sessions map[string]bool
+	calls    []Request
+}
+
+// NewServer starts a fake API server accepting the given credentials.
+//
+// The auth/login, auth/logout and user/listAssignableRoles endpoints are handled out of the box.
+// The server needs to be closed after usage.
+func NewServer(user string, password string) *Server {
+	s := &Server{
+		User:     user,
+		Password: password,
+		routes:   map[string][]route{},
+		sessions: map[string]bool{},
+	}
+	s.server = httptest.NewTLSServer(http.HandlerFunc(s.serveHTTP))
+
+	s.Handle("GET", "user/listAssignableRoles", func(_ *Request) *Response {
+		return Success([]string{"org_admin"})
+	})
+	return s
+}
+
+// Close shuts the server down.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// URL returns the base URL of the server.
+func (s *Server) URL() string {
+	return s.server.URL
+}
+
+// ConnectionDetails returns the API connection details to use to reach the server.
+//
+// The server uses a self-signed certificate so the connection is insecure.
+func (s *Server) ConnectionDetails() *api.ConnectionDetails {
+	return &api.ConnectionDetails{
+		Server:   strings.TrimPrefix(s.server.URL, "https://"),
+		User:     s.User,
+		Password: s.Password,
+		Insecure: true,
+	}
+}
+
+// Handle registers a handler for the API calls matching the HTTP method and path.
+//
+// Handlers registered later take precedence over the previous ones for the same method and path.
+func (s *Server) Handle(method string, path string, handler HandlerFunc) {
+	s.addRoute(method, path, route{handler: handler})
+}
+
+// HandleAnonymous registers a handler for a call that doesn't require to be logged in, like org/createFirst.
+func (s *Server) HandleAnonymous(method string, path string, handler HandlerFunc) {
+	s.addRoute(method, path, route{handler: handler, anonymous: true})
+}
+
+// HandleFixture registers a canned response.
+func (s *Server) HandleFixture(fixture *Fixture) {
+	status := fixture.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	response := &Response{Status: status, Body: fixture.Response}
+	s.addRoute(fixture.Method, fixture.Path, route{
+		params: fixture.Params,
+		handler: func(_ *Request) *Response {
+			return response
+		},
+	})
+}
+
+// Calls returns the API calls received by the server, excluding the authentication ones.
+func (s *Server) Calls() []Request {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return append([]Request{}, s.calls...)
+}
+
+// CallsTo returns the calls received by the server for a given API path.
+func (s *Server) CallsTo(path string) []Request {
+	calls := []Request{}
+	for _, call := range s.Calls() {
+		if call.Path == path {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+func (s *Server) addRoute(method string, path string, r route) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	key := routeKey(method, path)
+	s.routes[key] = append([]route{r}, s.routes[key]...)
+}
+
+func routeKey(method string, path string) string {
+	return strings.ToUpper(method) + " " + strings.Trim(path, "/")
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.URL.Path, APIRoot) {
+		http.NotFound(w, r)
+		return
+	}
+
+	req, err := parseRequest(r)
+	if err != nil {
+		writeJSON(w, nil, &Response{
+			Status: http.StatusBadRequest,
+			Body:   map[string]string{"message": err.Error()},
+		})
+		return
+	}
+
+	switch req.Path {
+	case "auth/login":
+		s.login(w, req)
+		return
+	case "auth/logout":
+		s.logout(w, r)
+		return
+	}
+
+	route := s.findRoute(req)
+	if route == nil {
+		writeJSON(w, nil, &Response{
+			Status: http.StatusNotFound,
+			Body:   map[string]string{"message": fmt.Sprintf("no handler for %s %s", req.Method, req.Path)},
+		})
+		return
+	}
+
+	if !route.anonymous && !s.isLoggedIn(r) {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	s.mutex.Lock()
+	s.calls = append(s.calls, *req)
+	s.mutex.Unlock()
+
+	writeJSON(w, nil, route.handler(req))
+}
+
+func (s *Server) findRoute(req *Request) *route {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for _, r := range s.routes[routeKey(req.Method, req.Path)] {
+		if matchParams(r.params, req.Params) {
+			return &r
+		}
+	}
+	return nil
+}
+
+// matchParams returns true if all the expected parameters are in the actual ones.
+//
+// Redacted expected values match any actual value.
+func matchParams(expected map[string]interface{}, actual map[string]interface{}) bool {
+	for key, value := range expected {
+		actualValue, ok := actual[key]
+		if !ok {
+			return false
+		}
+		if value != redacted && fmt.Sprint(actualValue) != fmt.Sprint(value) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Server) login(w http.ResponseWriter, req *Request) {
+	if req.Params["login"] != s.User || req.Params["password"] != s.Password {
+		writeJSON(w, nil, Failure("Either the password or username is incorrect."))
+		return
+	}
+
+	session := make([]byte, 16)
+	if _, err := rand.Read(session); err != nil {
+		writeJSON(w, nil, &Response{Status: http.StatusInternalServerError})
+		return
+	}
+	cookie := &http.Cookie{
+		Name:     sessionCookieName,
+		Value:    hex.EncodeToString(session),
+		MaxAge:   3600,
+		Path:     "/",
+		Secure:   true,
+		HttpOnly: true,
+	}
+
+	s.mutex.Lock()
+	s.sessions[cookie.Value] = true
+	s.mutex.Unlock()
+
+	writeJSON(w, cookie, &Response{
+		Status: http.StatusOK,
+		Body:   map[string]interface{}{"success": true},
+	})
+}
+
+func (s *Server) logout(w http.ResponseWriter, r *http.Request) {
+	if cookie, err := r.Cookie(sessionCookieName); err == nil {
+		s.mutex.Lock()
+		delete(s.sessions, cookie.Value)
+		s.mutex.Unlock()
+	}
+	writeJSON(w, nil, Success(1))
+}
+
+func (s *Server) isLoggedIn(r *http.Request) bool {
+	cookie, err := r.Cookie(sessionCookieName)
+	if err != nil {
+		return false
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.sessions[cookie.Value]
+}
+
+func parseRequest(r *http.Request) (*Request, error) {
+	req := &Request{
+		Method: r.Method,
+		Path:   strings.Trim(strings.TrimPrefix(r.URL.Path, APIRoot), "/"),
+		Params: queryToParams(r.URL.Query()),
+	}
+
+	if r.Body != nil {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			return nil, err
+		}
+		if len(body) > 0 && string(body) != "null" {
+			if err := json.Unmarshal(body, &req.Params); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return req, nil
+}
+
+func queryToParams(query url.Values) map[string]interface{} {
+	params := map[string]interface{}{}
+	for key, values := range query {
+		if len(values) == 1 {
+			params[key] = values[0]
+		} else {
+			params[key] = values
+		}
+	}
+	return params
+}
+
+func writeJSON(w http.ResponseWriter, cookie *http.Cookie, res *Response) {
+	if cookie != nil {
+		http.SetCookie(w, cookie)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(res.Status)
+	if res.Body != nil {
+		_ = json.NewEncoder(w).Encode(res.Body)
+	}
+}
@@ -0,0 +1,204 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fake
+
+import (
+	"path"
+	"testing"
+
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/testutils"
+)
+
+func newLoggedClient(t *testing.T, server *Server) *api.APIClient {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	client, err := api.Init(server.ConnectionDetails())
+	if err != nil {
+		t.Fatalf("failed to initialize client: %s", err)
+	}
+	if err := client.Login(); err != nil {
+		t.Fatalf("failed to login: %s", err)
+	}
+	return client
+}
+
+func TestLogin(t *testing.T) {
+	server := NewServer("admin", "secret")
+	defer server.Close()
+
+	client := newLoggedClient(t, server)
+	testutils.AssertEquals(t, "Unexpected cookie name", "pxt-session-cookie", client.AuthCookie.Name)
+
+	if _, err := api.Get[[]string](client, "user/listAssignableRoles"); err != nil {
+		t.Errorf("failed to call API after login: %s", err)
+	}
+}
+
+func TestWrongCredentials(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	server := NewServer("admin", "secret")
+	defer server.Close()
+
+	details := server.ConnectionDetails()
+	details.Password = "wrong"
+	client, err := api.Init(details)
+	if err != nil {
+		t.Fatalf("failed to initialize client: %s", err)
+	}
+	if err := client.Login(); err == nil {
+		t.Error("login should have failed with wrong password")
+	}
+}
+
+func TestNotLoggedIn(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	server := NewServer("admin", "secret")
+	defer server.Close()
+
+	client, err := api.Init(server.ConnectionDetails())
+	if err != nil {
+		t.Fatalf("failed to initialize client: %s", err)
+	}
+	_, err = api.Get[[]string](client, "user/listAssignableRoles")
+	testutils.AssertTrue(t, "Call without session should fail", err != nil)
+}
+
+func TestLogout(t *testing.T) {
+	server := NewServer("admin", "secret")
+	defer server.Close()
+
+	client := newLoggedClient(t, server)
+	if _, err := client.Post("auth/logout", nil); err != nil {
+		t.Fatalf("failed to logout: %s", err)
+	}
+	_, err := api.Get[[]string](client, "user/listAssignableRoles")
+	testutils.AssertTrue(t, "Call after logout should fail", err != nil)
+}
+
+func TestHandlers(t *testing.T) {
+	server := NewServer("admin", "secret")
+	defer server.Close()
+
+	server.Handle("POST", "system/deleteSystem", func(req *Request) *Response {
+		if req.Params["sid"] != float64(1000010000) {
+			return Failure("no such system")
+		}
+		return Success(1)
+	})
+	server.HandleAnonymous("GET", "api/getVersion", func(_ *Request) *Response {
+		return Success("25")
+	})
+
+	client := newLoggedClient(t, server)
+
+	res, err := api.Post[int](client, "system/deleteSystem", map[string]interface{}{"sid": 1000010000})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	testutils.AssertTrue(t, "Call should be successful", res.Success)
+
+	res, err = api.Post[int](client, "system/deleteSystem", map[string]interface{}{"sid": 123})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	testutils.AssertEquals(t, "Unexpected failure message", "no such system", res.Message)
+
+	calls := server.CallsTo("system/deleteSystem")
+	testutils.AssertEquals(t, "Unexpected number of calls", 2, len(calls))
+
+	client.AuthCookie = nil
+	version, err := api.Get[string](client, "api/getVersion")
+	if err != nil {
+		t.Fatalf("anonymous call failed: %s", err)
+	}
+	testutils.AssertEquals(t, "Unexpected version", "25", version.Result)
+
+	if _, err := client.Get("system/listSystems"); err == nil {
+		t.Error("call to a non registered path should fail")
+	}
+}
+
+func TestFixtures(t *testing.T) {
+	server := NewServer("admin", "secret")
+	defer server.Close()
+
+	dir := t.TempDir()
+	testutils.WriteFile(t, path.Join(dir, "any.json"), `{
+  "method": "GET",
+  "path": "system/getName",
+  "response": {"success": true, "result": {"name": "any"}}
+}`)
+	testutils.WriteFile(t, path.Join(dir, "specific.json"), `{
+  "method": "GET",
+  "path": "system/getName",
+  "params": {"sid": 1234},
+  "response": {"success": true, "result": {"name": "specific"}}
+}`)
+	if err := server.LoadFixtures(dir); err != nil {
+		t.Fatalf("failed to load fixtures: %s", err)
+	}
+
+	client := newLoggedClient(t, server)
+
+	type name struct {
+		Name string
+	}
+	res, err := api.Get[name](client, "system/getName?sid=1234")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	testutils.AssertEquals(t, "Unexpected matching fixture", "specific", res.Result.Name)
+
+	res, err = api.Get[name](client, "system/getName?sid=1")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	testutils.AssertEquals(t, "Unexpected matching fixture", "any", res.Result.Name)
+}
+
+func TestRecorder(t *testing.T) {
+	server := NewServer("admin", "secret")
+	defer server.Close()
+	server.Handle("POST", "user/create", func(_ *Request) *Response {
+		return Success(1)
+	})
+
+	dir := t.TempDir()
+	client := newLoggedClient(t, server)
+	client.Client = NewRecorder(client.Client, dir)
+
+	data := map[string]interface{}{"login": "foo", "password": "bar"}
+	for i := 0; i < 2; i++ {
+		if _, err := api.Post[int](client, "user/create", data); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	fixture, err := ReadFixture(path.Join(dir, "post_user_create.json"))
+	if err != nil {
+		t.Fatalf("failed to read recorded fixture: %s", err)
+	}
+	testutils.AssertEquals(t, "Unexpected recorded path", "user/create", fixture.Path)
+	testutils.AssertEquals(t, "Unexpected recorded login", "foo", fixture.Params["login"])
+	testutils.AssertEquals(t, "Password should be redacted", redacted, fixture.Params["password"])
+	testutils.AssertEquals(t, "Unexpected recorded status", 200, fixture.Status)
+
+	if _, err := ReadFixture(path.Join(dir, "post_user_create-1.json")); err != nil {
+		t.Errorf("second call should be recorded in another file: %s", err)
+	}
+
+	// The recorded fixtures can be replayed
+	replay := NewServer("admin", "secret")
+	defer replay.Close()
+	if err := replay.LoadFixtures(dir); err != nil {
+		t.Fatalf("failed to load recorded fixtures: %s", err)
+	}
+	replayClient := newLoggedClient(t, replay)
+	res, err := api.Post[int](replayClient, "user/create", map[string]interface{}{"login": "foo", "password": "baz"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	testutils.AssertEquals(t, "Unexpected replayed result", 1, res.Result)
+}
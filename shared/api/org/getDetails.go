@@ -6,7 +6,7 @@ package org
 
 import (
 	"errors"
-	"fmt"
+	"net/url"
 
 	"github.com/uyuni-project/uyuni-tools/shared/api"
 	"github.com/uyuni-project/uyuni-tools/shared/api/types"
@@ -23,7 +23,12 @@ func GetOrganizationDetails(cnxDetails *api.ConnectionDetails, orgName string) (
 	if err != nil {
 		return nil, utils.Errorf(err, L("failed to connect to the server"))
 	}
-	res, err := api.Get[types.Organization](client, fmt.Sprintf("org/getDetails?name=%s", orgName))
+	return GetDetails(client, orgName)
+}
+
+// GetDetails gets details of organization based on organization name.
+func GetDetails(client *api.APIClient, orgName string) (*types.Organization, error) {
+	res, err := api.Get[types.Organization](client, "org/getDetails?name="+url.QueryEscape(orgName))
 	if err != nil {
 		return nil, utils.Errorf(err, L("failed to get organization details"))
 	}
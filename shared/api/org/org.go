@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package org
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/types"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+// ListOrgs returns all the organizations.
+func ListOrgs(client *api.APIClient) ([]types.Organization, error) {
+	res, err := api.Get[[]types.Organization](client, "org/listOrgs")
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to list the organizations"))
+	}
+	if !res.Success {
+		return nil, errors.New(res.Message)
+	}
+	return res.Result, nil
+}
+
+// Create creates an organization with its administrator.
+//
+// If usePam is true, the administrator authenticates using PAM and the password is ignored.
+func Create(client *api.APIClient, orgName string, admin *types.User, usePam bool) (*types.Organization, error) {
+	data := map[string]interface{}{
+		"orgName":       orgName,
+		"adminLogin":    admin.Login,
+		"adminPassword": admin.Password,
+		// The prefix is mandatory, but can be empty
+		"prefix":     " ",
+		"firstName":  admin.FirstName,
+		"lastName":   admin.LastName,
+		"email":      admin.Email,
+		"usePamAuth": usePam,
+	}
+	res, err := api.Post[types.Organization](client, "org/create", data)
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to create organization %s"), orgName)
+	}
+	if !res.Success {
+		return nil, errors.New(res.Message)
+	}
+	return &res.Result, nil
+}
+
+// Delete deletes an organization.
+func Delete(client *api.APIClient, orgID int) error {
+	res, err := api.Post[int](client, "org/delete", map[string]interface{}{"orgId": orgID})
+	if err != nil {
+		return utils.Errorf(err, L("failed to delete organization %d"), orgID)
+	}
+	if !res.Success {
+		return errors.New(res.Message)
+	}
+	return nil
+}
+
+// ListTrusts returns the other organizations and whether they are trusted by an organization.
+func ListTrusts(client *api.APIClient, orgID int) ([]types.OrganizationTrust, error) {
+	res, err := api.Get[[]types.OrganizationTrust](client, fmt.Sprintf("org/trusts/listTrusts?orgId=%d", orgID))
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to list the trusts of organization %d"), orgID)
+	}
+	if !res.Success {
+		return nil, errors.New(res.Message)
+	}
+	return res.Result, nil
+}
+
+// AddTrust adds a trust between two organizations.
+func AddTrust(client *api.APIClient, orgID int, trustOrgID int) error {
+	return postTrust(client, "org/trusts/addTrust", orgID, trustOrgID)
+}
+
+// RemoveTrust removes the trust between two organizations.
+func RemoveTrust(client *api.APIClient, orgID int, trustOrgID int) error {
+	return postTrust(client, "org/trusts/removeTrust", orgID, trustOrgID)
+}
+
+func postTrust(client *api.APIClient, path string, orgID int, trustOrgID int) error {
+	data := map[string]interface{}{
+		"orgId":      orgID,
+		"trustOrgId": trustOrgID,
+	}
+	res, err := api.Post[int](client, path, data)
+	if err != nil {
+		return utils.Errorf(err, L("failed to update the trust between organizations %[1]d and %[2]d"),
+			orgID, trustOrgID,
+		)
+	}
+	if !res.Success {
+		return errors.New(res.Message)
+	}
+	return nil
+}
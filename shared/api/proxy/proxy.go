@@ -0,0 +1,39 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package proxy
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/types"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+// ListProxies returns the proxies registered in the organization.
+func ListProxies(client *api.APIClient) ([]types.SystemOverview, error) {
+	res, err := api.Get[[]types.SystemOverview](client, "proxy/listProxies")
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to list the proxies"))
+	}
+	if !res.Success {
+		return nil, errors.New(res.Message)
+	}
+	return res.Result, nil
+}
+
+// ListProxyClients returns the IDs of the systems connected through a proxy.
+func ListProxyClients(client *api.APIClient, proxyID int) ([]int, error) {
+	res, err := api.Get[[]int](client, fmt.Sprintf("proxy/listProxyClients?proxyId=%d", proxyID))
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to list the clients of proxy %d"), proxyID)
+	}
+	if !res.Success {
+		return nil, errors.New(res.Message)
+	}
+	return res.Result, nil
+}
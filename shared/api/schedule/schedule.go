@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package schedule
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/types"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+// Action lists to pass to ListActions.
+const (
+	AllActions        = "listAllActions"
+	InProgressActions = "listInProgressActions"
+	CompletedActions  = "listCompletedActions"
+	FailedActions     = "listFailedActions"
+	ArchivedActions   = "listArchivedActions"
+)
+
+// Systems lists to pass to ListSystems.
+const (
+	InProgressSystems = "listInProgressSystems"
+	CompletedSystems  = "listCompletedSystems"
+	FailedSystems     = "listFailedSystems"
+)
+
+// ListActions returns the actions of a list, like InProgressActions.
+//
+// AllActions doesn't contain the archived actions.
+func ListActions(client *api.APIClient, list string) ([]types.Action, error) {
+	res, err := api.Get[[]types.Action](client, "schedule/"+list)
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to list the actions"))
+	}
+	if !res.Success {
+		return nil, errors.New(res.Message)
+	}
+	return res.Result, nil
+}
+
+// ListSystems returns the systems of an action from a list, like CompletedSystems.
+func ListSystems(client *api.APIClient, actionID int, list string) ([]types.ActionSystem, error) {
+	res, err := api.Get[[]types.ActionSystem](client, fmt.Sprintf("schedule/%s?actionId=%d", list, actionID))
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to list the systems of action %d"), actionID)
+	}
+	if !res.Success {
+		return nil, errors.New(res.Message)
+	}
+	return res.Result, nil
+}
+
+// CancelActions cancels actions.
+func CancelActions(client *api.APIClient, actionIDs []int) error {
+	res, err := api.Post[int](client, "schedule/cancelActions", map[string]interface{}{"actionIds": actionIDs})
+	if err != nil {
+		return utils.Errorf(err, L("failed to cancel the actions"))
+	}
+	if !res.Success {
+		return errors.New(res.Message)
+	}
+	return nil
+}
+
+// RescheduleActions schedules actions again, either for all their systems or only the failed ones.
+func RescheduleActions(client *api.APIClient, actionIDs []int, onlyFailed bool) error {
+	data := map[string]interface{}{
+		"actionIds":  actionIDs,
+		"onlyFailed": onlyFailed,
+	}
+	res, err := api.Post[int](client, "schedule/rescheduleActions", data)
+	if err != nil {
+		return utils.Errorf(err, L("failed to reschedule the actions"))
+	}
+	if !res.Success {
+		return errors.New(res.Message)
+	}
+	return nil
+}
@@ -0,0 +1,252 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package system
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/types"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+// Cleanup types to use when deleting systems.
+const (
+	FailOnCleanupErr = "FAIL_ON_CLEANUP_ERR"
+	NoCleanup        = "NO_CLEANUP"
+	ForceDelete      = "FORCE_DELETE"
+)
+
+// ListSystems returns all the systems visible to the user.
+func ListSystems(client *api.APIClient) ([]types.SystemOverview, error) {
+	res, err := api.Get[[]types.SystemOverview](client, "system/listSystems")
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to list the systems"))
+	}
+	if !res.Success {
+		return nil, errors.New(res.Message)
+	}
+	return res.Result, nil
+}
+
+// ListSystemsWithEntitlement returns the systems having the entitlement.
+func ListSystemsWithEntitlement(client *api.APIClient, entitlement string) ([]types.SystemOverview, error) {
+	res, err := api.Get[[]types.SystemOverview](client,
+		"system/listSystemsWithEntitlement?entitlementName="+url.QueryEscape(entitlement),
+	)
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to list the systems with entitlement %s"), entitlement)
+	}
+	if !res.Success {
+		return nil, errors.New(res.Message)
+	}
+	return res.Result, nil
+}
+
+// GetID returns the systems matching the profile name.
+func GetID(client *api.APIClient, name string) ([]types.SystemOverview, error) {
+	res, err := api.Get[[]types.SystemOverview](client, "system/getId?name="+url.QueryEscape(name))
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to get the ID of system %s"), name)
+	}
+	if !res.Success {
+		return nil, errors.New(res.Message)
+	}
+	return res.Result, nil
+}
+
+// LookupID returns the ID of a system given either its ID or its profile name.
+func LookupID(client *api.APIClient, system string) (int, error) {
+	if id, err := strconv.Atoi(system); err == nil {
+		return id, nil
+	}
+	systems, err := GetID(client, system)
+	if err != nil {
+		return 0, err
+	}
+	switch len(systems) {
+	case 0:
+		return 0, fmt.Errorf(L("no system named %s"), system)
+	case 1:
+		return systems[0].ID, nil
+	}
+	return 0, fmt.Errorf(L("%[1]d systems are named %[2]s, use the system ID instead"), len(systems), system)
+}
+
+// GetDetails returns the details of a system.
+func GetDetails(client *api.APIClient, sid int) (*types.SystemDetails, error) {
+	res, err := api.Get[types.SystemDetails](client, fmt.Sprintf("system/getDetails?sid=%d", sid))
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to get the details of system %d"), sid)
+	}
+	if !res.Success {
+		return nil, errors.New(res.Message)
+	}
+	return &res.Result, nil
+}
+
+// GetEntitlements returns the entitlements of a system.
+func GetEntitlements(client *api.APIClient, sid int) ([]string, error) {
+	res, err := api.Get[[]string](client, fmt.Sprintf("system/getEntitlements?sid=%d", sid))
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to get the entitlements of system %d"), sid)
+	}
+	if !res.Success {
+		return nil, errors.New(res.Message)
+	}
+	return res.Result, nil
+}
+
+// ListGroups returns the groups a system is or could be subscribed to.
+func ListGroups(client *api.APIClient, sid int) ([]types.SystemGroupMembership, error) {
+	res, err := api.Get[[]types.SystemGroupMembership](client, fmt.Sprintf("system/listGroups?sid=%d", sid))
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to list the groups of system %d"), sid)
+	}
+	if !res.Success {
+		return nil, errors.New(res.Message)
+	}
+	return res.Result, nil
+}
+
+// GetNetwork returns the network information of a system.
+func GetNetwork(client *api.APIClient, sid int) (*types.SystemNetwork, error) {
+	res, err := api.Get[types.SystemNetwork](client, fmt.Sprintf("system/getNetwork?sid=%d", sid))
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to get the network of system %d"), sid)
+	}
+	if !res.Success {
+		return nil, errors.New(res.Message)
+	}
+	return &res.Result, nil
+}
+
+// ListInstalledProducts returns the products installed on a system.
+func ListInstalledProducts(client *api.APIClient, sid int) ([]types.InstalledProduct, error) {
+	res, err := api.Get[[]types.InstalledProduct](client, fmt.Sprintf("system/listInstalledProducts?sid=%d", sid))
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to list the products installed on system %d"), sid)
+	}
+	if !res.Success {
+		return nil, errors.New(res.Message)
+	}
+	return res.Result, nil
+}
+
+// DeleteSystems deletes systems.
+//
+// cleanupType is one of FailOnCleanupErr, NoCleanup or ForceDelete.
+func DeleteSystems(client *api.APIClient, sids []int, cleanupType string) error {
+	data := map[string]interface{}{
+		"sids":        sids,
+		"cleanupType": cleanupType,
+	}
+	return api.PostNoResult(client, "system/deleteSystems", data, L("failed to delete the systems"))
+}
+
+// ScheduleReboot schedules a reboot of the system.
+//
+// returns the ID of the scheduled action.
+func ScheduleReboot(client *api.APIClient, sid int, earliest time.Time) (int, error) {
+	data := map[string]interface{}{
+		"sid":                sid,
+		"earliestOccurrence": earliest.Format(time.RFC3339),
+	}
+	res, err := api.Post[int](client, "system/scheduleReboot", data)
+	if err != nil {
+		return 0, utils.Errorf(err, L("failed to schedule the reboot of system %d"), sid)
+	}
+	if !res.Success {
+		return 0, errors.New(res.Message)
+	}
+	return res.Result, nil
+}
+
+// TagLatestSnapshot tags the latest snapshot of a system.
+func TagLatestSnapshot(client *api.APIClient, sid int, tag string) error {
+	data := map[string]interface{}{
+		"sid":     sid,
+		"tagName": tag,
+	}
+	return api.PostNoResult(client, "system/tagLatestSnapshot", data,
+		L("failed to tag the latest snapshot of system %d"), sid,
+	)
+}
+
+// GetScriptResults returns the results of a script action on its systems.
+func GetScriptResults(client *api.APIClient, actionID int) ([]types.ScriptResult, error) {
+	res, err := api.Get[[]types.ScriptResult](client, fmt.Sprintf("system/getScriptResults?actionId=%d", actionID))
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to get the script results of action %d"), actionID)
+	}
+	if !res.Success {
+		return nil, errors.New(res.Message)
+	}
+	return res.Result, nil
+}
+
+// GetRelevantErrata returns the patches applicable to a system.
+func GetRelevantErrata(client *api.APIClient, sid int) ([]types.Erratum, error) {
+	res, err := api.Get[[]types.Erratum](client, fmt.Sprintf("system/getRelevantErrata?sid=%d", sid))
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to list the relevant patches of system %d"), sid)
+	}
+	if !res.Success {
+		return nil, errors.New(res.Message)
+	}
+	return res.Result, nil
+}
+
+// Bootstrap registers a system by connecting to it over SSH.
+//
+// The call only returns once the bootstrap is finished.
+func Bootstrap(client *api.APIClient, params *types.BootstrapParams) error {
+	data := map[string]interface{}{
+		"host":          params.Host,
+		"sshPort":       params.SSHPort,
+		"sshUser":       params.SSHUser,
+		"activationKey": params.ActivationKey,
+		"saltSSH":       params.SaltSSH,
+	}
+	method := "system/bootstrap"
+	if params.SSHPrivKey != "" {
+		method = "system/bootstrapWithPrivateSshKey"
+		data["sshPrivKey"] = params.SSHPrivKey
+		data["sshPrivKeyPass"] = params.SSHPrivKeyPass
+	} else {
+		data["sshPassword"] = params.SSHPassword
+	}
+	if params.ProxyID != 0 {
+		data["proxyId"] = params.ProxyID
+	}
+	if params.ReactivationKey != "" {
+		data["reactivationKey"] = params.ReactivationKey
+	}
+
+	return api.PostNoResult(client, method, data, L("failed to bootstrap %s"), params.Host)
+}
+
+// ChangeProxy schedules the connection of systems through another proxy.
+//
+// returns the IDs of the scheduled actions.
+func ChangeProxy(client *api.APIClient, sids []int, proxyID int) ([]int, error) {
+	data := map[string]interface{}{
+		"sids":    sids,
+		"proxyId": proxyID,
+	}
+	res, err := api.Post[[]int](client, "system/changeProxy", data)
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to change the proxy of the systems to %d"), proxyID)
+	}
+	if !res.Success {
+		return nil, errors.New(res.Message)
+	}
+	return res.Result, nil
+}
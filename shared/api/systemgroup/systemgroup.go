@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package systemgroup
+
+import (
+	"errors"
+	"net/url"
+
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/types"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+// ListSystemsMinimal returns the systems in a group.
+func ListSystemsMinimal(client *api.APIClient, group string) ([]types.SystemOverview, error) {
+	res, err := api.Get[[]types.SystemOverview](client,
+		"systemgroup/listSystemsMinimal?systemGroupName="+url.QueryEscape(group),
+	)
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to list the systems of group %s"), group)
+	}
+	if !res.Success {
+		return nil, errors.New(res.Message)
+	}
+	return res.Result, nil
+}
+
+// ListAllGroups returns all the system groups visible to the user.
+func ListAllGroups(client *api.APIClient) ([]types.SystemGroup, error) {
+	res, err := api.Get[[]types.SystemGroup](client, "systemgroup/listAllGroups")
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to list the system groups"))
+	}
+	if !res.Success {
+		return nil, errors.New(res.Message)
+	}
+	return res.Result, nil
+}
+
+// Create creates a system group.
+func Create(client *api.APIClient, name string, description string) error {
+	data := map[string]interface{}{
+		"name":        name,
+		"description": description,
+	}
+	res, err := api.Post[types.SystemGroup](client, "systemgroup/create", data)
+	if err != nil {
+		return utils.Errorf(err, L("failed to create system group %s"), name)
+	}
+	if !res.Success {
+		return errors.New(res.Message)
+	}
+	return nil
+}
+
+// Update changes the description of a system group.
+func Update(client *api.APIClient, name string, description string) error {
+	data := map[string]interface{}{
+		"systemGroupName": name,
+		"description":     description,
+	}
+	res, err := api.Post[types.SystemGroup](client, "systemgroup/update", data)
+	if err != nil {
+		return utils.Errorf(err, L("failed to update system group %s"), name)
+	}
+	if !res.Success {
+		return errors.New(res.Message)
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package taskomatic
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/types"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+// RepoSyncBunch is the taskomatic bunch running the repositories synchronizations.
+const RepoSyncBunch = "repo-sync-bunch"
+
+// Statuses of the taskomatic runs.
+const (
+	RunReady       = "READY_TO_RUN"
+	RunRunning     = "RUNNING"
+	RunFinished    = "FINISHED"
+	RunFailed      = "FAILED"
+	RunSkipped     = "SKIPPED"
+	RunInterrupted = "INTERRUPTED"
+)
+
+// ListActiveSchedulesByBunch returns the schedules of a satellite bunch which are pending or running.
+//
+// Single runs like the ones scheduled by channel.SyncRepo are no longer active once they have finished.
+func ListActiveSchedulesByBunch(client *api.APIClient, bunch string) ([]types.TaskomaticSchedule, error) {
+	res, err := api.Get[[]types.TaskomaticSchedule](client,
+		"taskomatic/listActiveSchedulesByBunch?bunchName="+url.QueryEscape(bunch),
+	)
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to list the active schedules of %s"), bunch)
+	}
+	if !res.Success {
+		return nil, errors.New(res.Message)
+	}
+	return res.Result, nil
+}
+
+// ListScheduleRuns returns the runs of a satellite schedule.
+func ListScheduleRuns(client *api.APIClient, scheduleID int) ([]types.TaskomaticRun, error) {
+	res, err := api.Get[[]types.TaskomaticRun](client,
+		fmt.Sprintf("taskomatic/listScheduleRuns?scheduleId=%d", scheduleID),
+	)
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to list the runs of schedule %d"), scheduleID)
+	}
+	if !res.Success {
+		return nil, errors.New(res.Message)
+	}
+	return res.Result, nil
+}
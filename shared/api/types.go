@@ -7,6 +7,9 @@ package api
 import "net/http"
 
 const rootPathApiv1 = "/rhn/manager/api"
+const rootPathXMLRPC = "/rpc/api"
+const rootPathHubXMLRPC = "/hub/rpc/api"
+const hubXMLRPCPort = 2830
 const apiCredentialsStore = ".uyuni-api.json"
 
 // APIClient is the API entrypoint structure.
@@ -25,6 +28,25 @@ type APIClient struct {
 	Details *ConnectionDetails
 }
 
+// XMLRPCClient is the XML-RPC API entrypoint structure.
+type XMLRPCClient struct {
+
+	// URL to the XML-RPC endpoint of the target host
+	URL string
+
+	// net/http client
+	Client HTTPClient
+
+	// Session key to pass to the calls
+	SessionKey string
+
+	// Connection details
+	Details *ConnectionDetails
+
+	// Indicates if the client targets the Hub XML-RPC API
+	Hub bool
+}
+
 // HTTPClient is a minimal HTTPClient interface primarily for unit testing.
 type HTTPClient interface {
 	Do(req *http.Request) (*http.Response, error)
@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+// Action describes a scheduled action in the API lists.
+type Action struct {
+	ID                int     `json:"id"`
+	Name              string  `json:"name"`
+	Type              string  `json:"type"`
+	Scheduler         string  `json:"scheduler"`
+	Earliest          APITime `json:"earliest"`
+	CompletedSystems  int     `json:"completedSystems"`
+	FailedSystems     int     `json:"failedSystems"`
+	InProgressSystems int     `json:"inProgressSystems"`
+}
+
+// ActionSystem describes the status of an action on a system.
+type ActionSystem struct {
+	ServerID    int     `json:"server_id"`
+	ServerName  string  `json:"server_name"`
+	BaseChannel string  `json:"base_channel"`
+	Timestamp   APITime `json:"timestamp"`
+	Message     string  `json:"message"`
+}
+
+// ScriptResult describes the result of a script action on a system.
+type ScriptResult struct {
+	ServerID   int     `json:"serverId"`
+	StartDate  APITime `json:"startDate"`
+	StopDate   APITime `json:"stopDate"`
+	ReturnCode int     `json:"returnCode"`
+	Output     string  `json:"output"`
+}
@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+// ActivationKey describes an activation key in the API.
+type ActivationKey struct {
+	Key                string        `json:"key"`
+	Description        string        `json:"description"`
+	UsageLimit         int           `json:"usage_limit"`
+	BaseChannelLabel   string        `json:"base_channel_label"`
+	ChildChannelLabels []string      `json:"child_channel_labels"`
+	Entitlements       []string      `json:"entitlements"`
+	ServerGroupIDs     []int         `json:"server_group_ids"`
+	Packages           []PackageArch `json:"packages"`
+	UniversalDefault   bool          `json:"universal_default"`
+	Disabled           bool          `json:"disabled"`
+	ContactMethod      string        `json:"contact_method"`
+}
+
+// PackageArch is a package name with an optional architecture.
+type PackageArch struct {
+	Name string `json:"name" yaml:"name"`
+	Arch string `json:"arch,omitempty" yaml:"arch,omitempty"`
+}
+
+// ConfigChannel describes a configuration channel in the API lists.
+type ConfigChannel struct {
+	ID          int    `json:"id"`
+	Label       string `json:"label"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	// Type is only set in the configuration channels lists
+	Type string `json:"type"`
+}
+
+// SystemGroup describes a system group in the API.
+type SystemGroup struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	SystemCount int    `json:"system_count"`
+}
@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+// ChannelOverview describes a software channel in the API lists.
+type ChannelOverview struct {
+	ID           int    `json:"id"`
+	Label        string `json:"label"`
+	Name         string `json:"name"`
+	ParentLabel  string `json:"parent_label"`
+	ProviderName string `json:"provider_name"`
+	ArchName     string `json:"arch_name"`
+	Packages     int    `json:"packages"`
+	Systems      int    `json:"systems"`
+}
+
+// ChannelDetails describes the details of a software channel.
+type ChannelDetails struct {
+	ID                 int             `json:"id"`
+	Label              string          `json:"label"`
+	Name               string          `json:"name"`
+	Summary            string          `json:"summary"`
+	Description        string          `json:"description"`
+	ArchName           string          `json:"arch_name"`
+	ArchLabel          string          `json:"arch_label"`
+	ChecksumLabel      string          `json:"checksum_label"`
+	ParentChannelLabel string          `json:"parent_channel_label"`
+	CloneOriginal      string          `json:"clone_original"`
+	LastModified       APITime         `json:"last_modified"`
+	LastSync           APITime         `json:"yumrepo_last_sync"`
+	ContentSources     []ContentSource `json:"contentSources"`
+}
+
+// ContentSource describes a repository of a software channel.
+type ContentSource struct {
+	ID        int    `json:"id"`
+	Label     string `json:"label"`
+	SourceURL string `json:"sourceUrl"`
+	Type      string `json:"type"`
+}
+
+// ChannelCloneDetails are the details of the channel to create by cloning.
+type ChannelCloneDetails struct {
+	Name        string `json:"name"`
+	Label       string `json:"label"`
+	Summary     string `json:"summary"`
+	ParentLabel string `json:"parent_label,omitempty"`
+	Description string `json:"description,omitempty"`
+}
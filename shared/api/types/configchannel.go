@@ -0,0 +1,26 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+// ConfigFile describes a file of a configuration channel as listed by the API.
+type ConfigFile struct {
+	Type         string  `json:"type"`
+	Path         string  `json:"path"`
+	LastModified APITime `json:"last_modified"`
+}
+
+// ConfigFileInfo describes the latest revision of a configuration file.
+type ConfigFileInfo struct {
+	Type        string `json:"type"`
+	Path        string `json:"path"`
+	Revision    int    `json:"revision"`
+	Contents    string `json:"contents"`
+	Base64      bool   `json:"contents_enc64"`
+	Binary      bool   `json:"binary"`
+	Owner       string `json:"owner"`
+	Group       string `json:"group"`
+	Permissions int    `json:"permissions"`
+	TargetPath  string `json:"target_path"`
+}
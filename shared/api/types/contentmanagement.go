@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+// ContentProject describes a content lifecycle management project.
+type ContentProject struct {
+	ID               int     `json:"id"`
+	Label            string  `json:"label"`
+	Name             string  `json:"name"`
+	Description      string  `json:"description"`
+	LastBuildDate    APITime `json:"lastBuildDate"`
+	FirstEnvironment string  `json:"firstEnvironment"`
+}
+
+// ContentEnvironment describes an environment of a content lifecycle management project.
+type ContentEnvironment struct {
+	ID                       int     `json:"id"`
+	Label                    string  `json:"label"`
+	Name                     string  `json:"name"`
+	Description              string  `json:"description"`
+	Status                   string  `json:"status"`
+	Version                  int     `json:"version"`
+	LastBuildDate            APITime `json:"lastBuildDate"`
+	ContentProjectLabel      string  `json:"contentProjectLabel"`
+	PreviousEnvironmentLabel string  `json:"previousEnvironmentLabel"`
+	NextEnvironmentLabel     string  `json:"nextEnvironmentLabel"`
+}
+
+// ContentProjectSource describes a source of a content lifecycle management project.
+type ContentProjectSource struct {
+	ContentProjectLabel string `json:"contentProjectLabel"`
+	Type                string `json:"type"`
+	State               string `json:"state"`
+	ChannelLabel        string `json:"channelLabel"`
+}
+
+// ContentFilterCriteria describes what a content filter matches.
+type ContentFilterCriteria struct {
+	Matcher string `json:"matcher" yaml:"matcher"`
+	Field   string `json:"field" yaml:"field"`
+	Value   string `json:"value" yaml:"value"`
+}
+
+// ContentFilter describes a content lifecycle management filter.
+type ContentFilter struct {
+	ID         int                   `json:"id"`
+	Name       string                `json:"name"`
+	EntityType string                `json:"entityType"`
+	Rule       string                `json:"rule"`
+	Criteria   ContentFilterCriteria `json:"criteria"`
+}
+
+// ContentProjectFilter describes a filter attached to a content lifecycle management project.
+type ContentProjectFilter struct {
+	Filter ContentFilter `json:"filter"`
+	State  string        `json:"state"`
+}
@@ -0,0 +1,19 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+// ContentSyncCredentials describes the SCC organization credentials of the server.
+type ContentSyncCredentials struct {
+	User    string `json:"user"`
+	Primary bool   `json:"isPrimary"`
+}
+
+// ContentSyncChannel describes a vendor channel available for synchronization.
+type ContentSyncChannel struct {
+	Label  string `json:"label"`
+	Name   string `json:"name"`
+	Parent string `json:"parent"`
+	Status string `json:"status"`
+}
@@ -0,0 +1,24 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+// Erratum describes a patch.
+type Erratum struct {
+	ID         int     `json:"id"`
+	Advisory   string  `json:"advisory_name"`
+	Type       string  `json:"advisory_type"`
+	Status     string  `json:"advisory_status"`
+	Synopsis   string  `json:"advisory_synopsis"`
+	IssueDate  APITime `json:"issue_date"`
+	UpdateDate APITime `json:"update_date"`
+}
+
+// CVEAuditSystem describes the status of a system for a CVE.
+type CVEAuditSystem struct {
+	SystemID      int      `json:"system_id"`
+	PatchStatus   string   `json:"patch_status"`
+	ChannelLabels []string `json:"channel_labels"`
+	Advisories    []string `json:"errata_advisories"`
+}
@@ -6,14 +6,21 @@ package types
 
 // Organization describe an organization in the API.
 type Organization struct {
-	ID                    int
-	Name                  string
-	ActiveUsers           int `mapstructure:"active_users"`
-	Systems               int
-	Trusts                int
-	SystemGroups          int  `mapstructure:"system_groups"`
-	ActivationKeys        int  `mapstructure:"activation_keys"`
-	KickstartProfiles     int  `mapstructure:"kickstart_profiles"`
-	ConfigurationChannels int  `mapstructure:"configuration_channels"`
-	StagingContentEnabled bool `mapstructure:"staging_content_enabled"`
+	ID                    int    `json:"id"`
+	Name                  string `json:"name"`
+	ActiveUsers           int    `mapstructure:"active_users" json:"active_users"`
+	Systems               int    `json:"systems"`
+	Trusts                int    `json:"trusts"`
+	SystemGroups          int    `mapstructure:"system_groups" json:"system_groups"`
+	ActivationKeys        int    `mapstructure:"activation_keys" json:"activation_keys"`
+	KickstartProfiles     int    `mapstructure:"kickstart_profiles" json:"kickstart_profiles"`
+	ConfigurationChannels int    `mapstructure:"configuration_channels" json:"configuration_channels"`
+	StagingContentEnabled bool   `mapstructure:"staging_content_enabled" json:"staging_content_enabled"`
+}
+
+// OrganizationTrust describes the trust of an organization with another one.
+type OrganizationTrust struct {
+	OrgID        int    `json:"orgId"`
+	OrgName      string `json:"orgName"`
+	TrustEnabled bool   `json:"trustEnabled"`
 }
@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+// SystemOverview describes a system in the API lists.
+type SystemOverview struct {
+	ID               int     `json:"id"`
+	Name             string  `json:"name"`
+	LastCheckin      APITime `json:"last_checkin"`
+	Created          APITime `json:"created"`
+	LastBoot         APITime `json:"last_boot"`
+	ExtraPkgCount    int     `json:"extra_pkg_count"`
+	OutdatedPkgCount int     `json:"outdated_pkg_count"`
+}
+
+// SystemDetails describes the details of a system.
+type SystemDetails struct {
+	ID                int      `json:"id"`
+	ProfileName       string   `json:"profile_name"`
+	MachineID         string   `json:"machine_id"`
+	MinionID          string   `json:"minion_id"`
+	BaseEntitlement   string   `json:"base_entitlement"`
+	AddonEntitlements []string `json:"addon_entitlements"`
+	AutoUpdate        bool     `json:"auto_update"`
+	Release           string   `json:"release"`
+	Hostname          string   `json:"hostname"`
+	Description       string   `json:"description"`
+	LockStatus        bool     `json:"lock_status"`
+	Virtualization    string   `json:"virtualization"`
+	ContactMethod     string   `json:"contact_method"`
+	LastBoot          APITime  `json:"last_boot"`
+}
+
+// SystemGroupMembership describes whether a system is subscribed to a group.
+type SystemGroupMembership struct {
+	ID         int    `json:"id"`
+	Subscribed int    `json:"subscribed"`
+	Name       string `json:"system_group_name"`
+}
+
+// SystemNetwork describes the network information of a system.
+type SystemNetwork struct {
+	IP       string `json:"ip"`
+	IP6      string `json:"ip6"`
+	Hostname string `json:"hostname"`
+}
+
+// InstalledProduct describes a product installed on a system.
+type InstalledProduct struct {
+	Name          string `json:"name"`
+	Version       string `json:"version"`
+	Release       string `json:"release"`
+	Arch          string `json:"arch"`
+	FriendlyName  string `json:"friendlyName"`
+	IsBaseProduct bool   `json:"isBaseProduct"`
+}
+
+// BootstrapParams describes how to bootstrap a system over SSH.
+//
+// Either the SSH password or the private key is used for the authentication.
+type BootstrapParams struct {
+	Host            string
+	SSHPort         int
+	SSHUser         string
+	SSHPassword     string
+	SSHPrivKey      string
+	SSHPrivKeyPass  string
+	ActivationKey   string
+	ReactivationKey string
+	ProxyID         int
+	SaltSSH         bool
+}
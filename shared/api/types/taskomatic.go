@@ -0,0 +1,19 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+// TaskomaticSchedule describes a schedule of a taskomatic bunch.
+type TaskomaticSchedule struct {
+	ID       int    `json:"id"`
+	JobLabel string `json:"job_label"`
+	Bunch    string `json:"bunch"`
+}
+
+// TaskomaticRun describes a run of a taskomatic schedule.
+type TaskomaticRun struct {
+	ID         int    `json:"id"`
+	ScheduleID int    `json:"schedule_id"`
+	Status     string `json:"status"`
+}
@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+)
+
+// apiDateFormats are the date formats the server may use in its responses.
+var apiDateFormats = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"Jan 2, 2006, 3:04:05 PM",
+	"Jan 2, 2006 3:04:05 PM",
+}
+
+// APITime is a date returned by the API.
+//
+// Depending on the server version and API flavor, dates are serialized in different formats.
+type APITime struct {
+	time.Time
+}
+
+// UnmarshalJSON parses the date in any of the known formats.
+func (t *APITime) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	if value == "" {
+		t.Time = time.Time{}
+		return nil
+	}
+
+	// Recent Java versions use a narrow no-break space before AM / PM
+	value = strings.ReplaceAll(value, "\u202f", " ")
+	for _, format := range apiDateFormats {
+		if date, err := time.Parse(format, value); err == nil {
+			t.Time = date
+			return nil
+		}
+	}
+	return fmt.Errorf(L("invalid date: %s"), value)
+}
@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/uyuni-project/uyuni-tools/shared/testutils"
+)
+
+func TestAPITimeUnmarshal(t *testing.T) {
+	expected := time.Date(2025, 3, 14, 15, 9, 26, 0, time.UTC)
+	data := []string{
+		`"2025-03-14T15:09:26Z"`,
+		`"2025-03-14T15:09:26"`,
+		`"Mar 14, 2025, 3:09:26 PM"`,
+		"\"Mar 14, 2025, 3:09:26\u202fPM\"",
+	}
+	for _, value := range data {
+		var actual APITime
+		if err := json.Unmarshal([]byte(value), &actual); err != nil {
+			t.Errorf("failed to parse %s: %s", value, err)
+			continue
+		}
+		testutils.AssertEquals(t, "Unexpected date for "+value, expected, actual.Time)
+	}
+
+	var empty APITime
+	if err := json.Unmarshal([]byte(`""`), &empty); err != nil {
+		t.Errorf("failed to parse empty date: %s", err)
+	}
+	testutils.AssertTrue(t, "Empty date should be zero", empty.IsZero())
+
+	var invalid APITime
+	testutils.AssertTrue(t, "Invalid dates should fail", json.Unmarshal([]byte(`"tomorrow"`), &invalid) != nil)
+}
@@ -6,9 +6,16 @@ package types
 
 // User describes an Uyuni user in the API.
 type User struct {
-	Login     string
-	Password  string
-	FirstName string
-	LastName  string
-	Email     string
+	Login     string `yaml:"login"`
+	Password  string `yaml:"password,omitempty"`
+	FirstName string `yaml:"firstName"`
+	LastName  string `yaml:"lastName"`
+	Email     string `yaml:"email"`
+}
+
+// UserOverview describes a user in the API lists.
+type UserOverview struct {
+	ID      int    `json:"id"`
+	Login   string `json:"login"`
+	Enabled bool   `json:"enabled"`
 }
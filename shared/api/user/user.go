@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package user
+
+import (
+	"errors"
+	"net/url"
+
+	"github.com/uyuni-project/uyuni-tools/shared/api"
+	"github.com/uyuni-project/uyuni-tools/shared/api/types"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+// ListUsers returns the users of the organization.
+func ListUsers(client *api.APIClient) ([]types.UserOverview, error) {
+	res, err := api.Get[[]types.UserOverview](client, "user/listUsers")
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to list the users"))
+	}
+	if !res.Success {
+		return nil, errors.New(res.Message)
+	}
+	return res.Result, nil
+}
+
+// Create creates a user in the organization.
+//
+// If usePam is true, the user authenticates using PAM and the password is ignored.
+func Create(client *api.APIClient, user *types.User, usePam bool) error {
+	data := map[string]interface{}{
+		"login":      user.Login,
+		"password":   user.Password,
+		"firstName":  user.FirstName,
+		"lastName":   user.LastName,
+		"email":      user.Email,
+		"usePamAuth": usePam,
+	}
+	return api.PostNoResult(client, "user/create", data, L("failed to create user %s"), user.Login)
+}
+
+// Disable disables a user.
+func Disable(client *api.APIClient, login string) error {
+	return api.PostNoResult(client, "user/disable", map[string]interface{}{"login": login},
+		L("failed to disable user %s"), login,
+	)
+}
+
+// ListRoles returns the roles of a user.
+func ListRoles(client *api.APIClient, login string) ([]string, error) {
+	res, err := api.Get[[]string](client, "user/listRoles?login="+url.QueryEscape(login))
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to list the roles of user %s"), login)
+	}
+	if !res.Success {
+		return nil, errors.New(res.Message)
+	}
+	return res.Result, nil
+}
+
+// AddRole adds a role to a user.
+func AddRole(client *api.APIClient, login string, role string) error {
+	data := map[string]interface{}{"login": login, "role": role}
+	return api.PostNoResult(client, "user/addRole", data, L("failed to add a role to user %s"), login)
+}
+
+// RemoveRole removes a role from a user.
+func RemoveRole(client *api.APIClient, login string, role string) error {
+	data := map[string]interface{}{"login": login, "role": role}
+	return api.PostNoResult(client, "user/removeRole", data, L("failed to remove a role from user %s"), login)
+}
+
+// ListAssignedSystemGroups returns the system groups a user can administer.
+func ListAssignedSystemGroups(client *api.APIClient, login string) ([]types.SystemGroup, error) {
+	res, err := api.Get[[]types.SystemGroup](client, "user/listAssignedSystemGroups?login="+url.QueryEscape(login))
+	if err != nil {
+		return nil, utils.Errorf(err, L("failed to list the system groups of user %s"), login)
+	}
+	if !res.Success {
+		return nil, errors.New(res.Message)
+	}
+	return res.Result, nil
+}
+
+// AddAssignedSystemGroups allows a user to administer system groups.
+func AddAssignedSystemGroups(client *api.APIClient, login string, groups []string) error {
+	data := map[string]interface{}{"login": login, "sgNames": groups, "setDefault": false}
+	return api.PostNoResult(client, "user/addAssignedSystemGroups", data,
+		L("failed to add system groups to user %s"), login,
+	)
+}
+
+// RemoveAssignedSystemGroups removes system groups from the ones a user can administer.
+func RemoveAssignedSystemGroups(client *api.APIClient, login string, groups []string) error {
+	data := map[string]interface{}{"login": login, "sgNames": groups, "setDefault": false}
+	return api.PostNoResult(client, "user/removeAssignedSystemGroups", data,
+		L("failed to remove system groups from user %s"), login,
+	)
+}
@@ -0,0 +1,169 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/rs/zerolog/log"
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+	"github.com/uyuni-project/uyuni-tools/shared/utils"
+)
+
+// InitXMLRPC returns an XMLRPCClient object for further API use.
+//
+// Like for Init, connectionDetails must have Server specified with FQDN to the target host
+// and the stored session is used if any.
+// If hub is true, the client targets the Hub XML-RPC API service instead of the server API.
+// The Hub XML-RPC API is reached over HTTPS with the same certificate checks as the server API.
+// The Hub sessions are separate from the server ones, the stored session is not used in that case.
+func InitXMLRPC(conn *ConnectionDetails, hub bool) (*XMLRPCClient, error) {
+	if !hub {
+		getStoredConnectionDetails(conn)
+	}
+
+	httpClient, err := newHTTPClient(conn)
+
+	if conn.Server == "" {
+		return nil, errors.New(L("server URL is not provided"))
+	}
+
+	url := fmt.Sprintf("https://%s%s", conn.Server, rootPathXMLRPC)
+	if hub {
+		url = fmt.Sprintf("https://%s:%d%s", conn.Server, hubXMLRPCPort, rootPathHubXMLRPC)
+	}
+
+	return &XMLRPCClient{
+		URL:     url,
+		Client:  httpClient,
+		Details: conn,
+		Hub:     hub,
+	}, err
+}
+
+// Login to the server using the stored session or provided credentials.
+func (c *XMLRPCClient) Login() error {
+	if c.Details.InSession && !c.Hub {
+		c.SessionKey = c.Details.Cookie
+		if _, err := c.Call("user.listAssignableRoles", c.SessionKey); err == nil {
+			// Session is valid
+			return nil
+		}
+		log.Warn().Msg(L("Cached session is expired."))
+		c.SessionKey = ""
+		if err := RemoveLoginCreds(); err != nil {
+			log.Warn().Err(err).Msg(L("Failed to remove stored credentials!"))
+		}
+	}
+	if err := getLoginCredentials(c.Details); err != nil {
+		return err
+	}
+
+	method := "auth.login"
+	if c.Hub {
+		method = "hub.login"
+	}
+	sessionKey, err := XMLRPCCall[string](c, method, c.Details.User, c.Details.Password)
+	if err != nil {
+		return err
+	}
+	if sessionKey == "" {
+		return errors.New(L("session key not found in login response"))
+	}
+	c.SessionKey = sessionKey
+	return nil
+}
+
+// Logout from the server and remove the locally stored session key.
+func (c *XMLRPCClient) Logout() error {
+	method := "auth.logout"
+	if c.Hub {
+		method = "hub.logout"
+	}
+	if _, err := c.Call(method, c.SessionKey); err != nil {
+		return utils.Errorf(err, L("failed to logout from the server"))
+	}
+	c.SessionKey = ""
+	if c.Hub {
+		return nil
+	}
+	return RemoveLoginCreds()
+}
+
+// Call issues an XML-RPC method call.
+//
+// The session key is not added automatically to the parameters, it needs to be passed as in the API documentation.
+//
+// returns the decoded value. See decodeXMLRPCResponse for the types.
+func (c *XMLRPCClient) Call(method string, params ...interface{}) (interface{}, error) {
+	log.Debug().Msgf("Sending XML-RPC call %s to %s", method, c.URL)
+	data, err := encodeXMLRPCCall(method, params...)
+	if err != nil {
+		log.Error().Err(err).Msg(L("Unable to convert data to XML-RPC"))
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", c.URL, bytes.NewBuffer(data))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "text/xml; charset=utf-8")
+	req.Header.Set("Accept", "text/xml")
+
+	logTraceHeader(&req.Header)
+
+	res, err := c.Client.Do(req)
+	if err != nil {
+		log.Trace().Err(err).Msgf("Request failed")
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	logTraceHeader(&res.Header)
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusBadRequest {
+		if res.StatusCode == http.StatusUnauthorized {
+			return nil, errors.New(L("401: unauthorized"))
+		}
+		return nil, fmt.Errorf("%d: '%s'", res.StatusCode, string(body))
+	}
+	log.Debug().Msgf("Received response with code %d", res.StatusCode)
+
+	return decodeXMLRPCResponse(body)
+}
+
+// XMLRPCCall issues an XML-RPC method call using the client and decodes the result into T.
+//
+// `method` is the XML-RPC method name, like system.listSystems.
+// `params` are the positional parameters of the call, including the session key if needed.
+//
+// returns the decoded result.
+func XMLRPCCall[T interface{}](client *XMLRPCClient, method string, params ...interface{}) (T, error) {
+	var result T
+	value, err := client.Call(method, params...)
+	if err != nil {
+		return result, err
+	}
+
+	// Convert through JSON to decode the result like the JSON API does
+	data, err := json.Marshal(value)
+	if err != nil {
+		return result, err
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return result, utils.Errorf(err, L("failed to decode %s result"), method)
+	}
+	return result, nil
+}
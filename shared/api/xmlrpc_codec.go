@@ -0,0 +1,396 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	. "github.com/uyuni-project/uyuni-tools/shared/l10n"
+)
+
+// xmlrpcDateFormat is the dateTime.iso8601 format used when sending dates.
+const xmlrpcDateFormat = "20060102T15:04:05"
+
+// xmlrpcDateFormats are the dateTime.iso8601 formats accepted when reading dates.
+var xmlrpcDateFormats = []string{
+	xmlrpcDateFormat,
+	"20060102T15:04:05Z07:00",
+	"20060102T15:04:05Z0700",
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04:05Z07:00",
+}
+
+// XMLRPCFault is the error returned by the server when an XML-RPC call fails.
+type XMLRPCFault struct {
+	Code   int
+	String string
+}
+
+func (f *XMLRPCFault) Error() string {
+	return fmt.Sprintf("%d: '%s'", f.Code, f.String)
+}
+
+// encodeXMLRPCCall serializes a method call with its parameters.
+//
+// Supported parameter types are booleans, numbers, strings, time.Time, byte slices,
+// slices, maps with string keys and structs.
+// Structs members are named after the xmlrpc field tag or the field name.
+func encodeXMLRPCCall(method string, params ...interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	buf.WriteString("<methodCall><methodName>")
+	if err := xml.EscapeText(&buf, []byte(method)); err != nil {
+		return nil, err
+	}
+	buf.WriteString("</methodName><params>")
+	for i, param := range params {
+		buf.WriteString("<param>")
+		if err := encodeXMLRPCValue(&buf, reflect.ValueOf(param)); err != nil {
+			return nil, fmt.Errorf(L("failed to encode parameter %[1]d: %[2]s"), i, err)
+		}
+		buf.WriteString("</param>")
+	}
+	buf.WriteString("</params></methodCall>")
+	return buf.Bytes(), nil
+}
+
+func encodeXMLRPCValue(buf *bytes.Buffer, v reflect.Value) error {
+	for v.IsValid() && (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return errors.New(L("nil values are not supported"))
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return errors.New(L("nil values are not supported"))
+	}
+
+	buf.WriteString("<value>")
+	if t, ok := v.Interface().(time.Time); ok {
+		fmt.Fprintf(buf, "<dateTime.iso8601>%s</dateTime.iso8601>", t.Format(xmlrpcDateFormat))
+		buf.WriteString("</value>")
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		value := 0
+		if v.Bool() {
+			value = 1
+		}
+		fmt.Fprintf(buf, "<boolean>%d</boolean>", value)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		writeXMLRPCInt(buf, v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if v.Uint() > math.MaxInt64 {
+			return fmt.Errorf(L("integer %d is too big"), v.Uint())
+		}
+		writeXMLRPCInt(buf, int64(v.Uint()))
+	case reflect.Float32, reflect.Float64:
+		fmt.Fprintf(buf, "<double>%s</double>", strconv.FormatFloat(v.Float(), 'f', -1, 64))
+	case reflect.String:
+		buf.WriteString("<string>")
+		if err := xml.EscapeText(buf, []byte(v.String())); err != nil {
+			return err
+		}
+		buf.WriteString("</string>")
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 && v.Kind() == reflect.Slice {
+			fmt.Fprintf(buf, "<base64>%s</base64>", base64.StdEncoding.EncodeToString(v.Bytes()))
+			break
+		}
+		buf.WriteString("<array><data>")
+		for i := 0; i < v.Len(); i++ {
+			if err := encodeXMLRPCValue(buf, v.Index(i)); err != nil {
+				return err
+			}
+		}
+		buf.WriteString("</data></array>")
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return fmt.Errorf(L("unsupported map key type: %s"), v.Type().Key())
+		}
+		keys := make([]string, 0, v.Len())
+		for _, key := range v.MapKeys() {
+			keys = append(keys, key.String())
+		}
+		sort.Strings(keys)
+		buf.WriteString("<struct>")
+		for _, key := range keys {
+			value := v.MapIndex(reflect.ValueOf(key).Convert(v.Type().Key()))
+			if err := encodeXMLRPCMember(buf, key, value); err != nil {
+				return err
+			}
+		}
+		buf.WriteString("</struct>")
+	case reflect.Struct:
+		buf.WriteString("<struct>")
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			name, omitEmpty := parseXMLRPCTag(field)
+			if name == "-" || omitEmpty && v.Field(i).IsZero() {
+				continue
+			}
+			if err := encodeXMLRPCMember(buf, name, v.Field(i)); err != nil {
+				return err
+			}
+		}
+		buf.WriteString("</struct>")
+	default:
+		return fmt.Errorf(L("unsupported type: %s"), v.Type())
+	}
+	buf.WriteString("</value>")
+	return nil
+}
+
+func writeXMLRPCInt(buf *bytes.Buffer, value int64) {
+	if value > math.MaxInt32 || value < math.MinInt32 {
+		fmt.Fprintf(buf, "<i8>%d</i8>", value)
+	} else {
+		fmt.Fprintf(buf, "<int>%d</int>", value)
+	}
+}
+
+func encodeXMLRPCMember(buf *bytes.Buffer, name string, value reflect.Value) error {
+	buf.WriteString("<member><name>")
+	if err := xml.EscapeText(buf, []byte(name)); err != nil {
+		return err
+	}
+	buf.WriteString("</name>")
+	if err := encodeXMLRPCValue(buf, value); err != nil {
+		return fmt.Errorf(L("member %[1]s: %[2]s"), name, err)
+	}
+	buf.WriteString("</member>")
+	return nil
+}
+
+func parseXMLRPCTag(field reflect.StructField) (name string, omitEmpty bool) {
+	name = field.Name
+	tag, ok := field.Tag.Lookup("xmlrpc")
+	if !ok {
+		return
+	}
+	parts := strings.Split(tag, ",")
+	if parts[0] != "" {
+		name = parts[0]
+	}
+	for _, option := range parts[1:] {
+		if option == "omitempty" {
+			omitEmpty = true
+		}
+	}
+	return
+}
+
+// decodeXMLRPCResponse parses a method response.
+//
+// The values are decoded to int64, bool, string, float64, time.Time, []byte,
+// []interface{} for arrays and map[string]interface{} for structs.
+// Faults are returned as XMLRPCFault errors.
+func decodeXMLRPCResponse(data []byte) (interface{}, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	isFault := false
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New(L("no value in XML-RPC response"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		switch start.Name.Local {
+		case "fault":
+			isFault = true
+		case "value":
+			value, err := decodeXMLRPCValue(decoder)
+			if err != nil {
+				return nil, err
+			}
+			if isFault {
+				return nil, toXMLRPCFault(value)
+			}
+			return value, nil
+		}
+	}
+}
+
+func toXMLRPCFault(value interface{}) error {
+	fault := &XMLRPCFault{}
+	if members, ok := value.(map[string]interface{}); ok {
+		if code, ok := members["faultCode"].(int64); ok {
+			fault.Code = int(code)
+		}
+		fault.String, _ = members["faultString"].(string)
+	}
+	return fault
+}
+
+// decodeXMLRPCValue decodes a value after its opening tag has been read.
+func decodeXMLRPCValue(decoder *xml.Decoder) (interface{}, error) {
+	var text strings.Builder
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+		switch t := token.(type) {
+		case xml.CharData:
+			text.Write(t)
+		case xml.StartElement:
+			value, err := decodeXMLRPCTypedValue(decoder, t)
+			if err != nil {
+				return nil, err
+			}
+			// Consume up to the closing value tag
+			if err := skipToEnd(decoder, "value"); err != nil {
+				return nil, err
+			}
+			return value, nil
+		case xml.EndElement:
+			// A value without type is a string
+			return text.String(), nil
+		}
+	}
+}
+
+func decodeXMLRPCTypedValue(decoder *xml.Decoder, start xml.StartElement) (interface{}, error) {
+	switch start.Name.Local {
+	case "array":
+		return decodeXMLRPCArray(decoder)
+	case "struct":
+		return decodeXMLRPCStruct(decoder)
+	case "nil":
+		return nil, skipToEnd(decoder, "nil")
+	}
+
+	text, err := readText(decoder, start.Name.Local)
+	if err != nil {
+		return nil, err
+	}
+
+	switch start.Name.Local {
+	case "int", "i4", "i8":
+		return strconv.ParseInt(strings.TrimSpace(text), 10, 64)
+	case "boolean":
+		return strings.TrimSpace(text) == "1", nil
+	case "string":
+		return text, nil
+	case "double":
+		return strconv.ParseFloat(strings.TrimSpace(text), 64)
+	case "dateTime.iso8601":
+		for _, format := range xmlrpcDateFormats {
+			if date, err := time.Parse(format, strings.TrimSpace(text)); err == nil {
+				return date, nil
+			}
+		}
+		return nil, fmt.Errorf(L("invalid date: %s"), text)
+	case "base64":
+		return base64.StdEncoding.DecodeString(strings.TrimSpace(text))
+	}
+	return nil, fmt.Errorf(L("unsupported XML-RPC type: %s"), start.Name.Local)
+}
+
+func decodeXMLRPCArray(decoder *xml.Decoder) (interface{}, error) {
+	values := []interface{}{}
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			if t.Name.Local == "value" {
+				value, err := decodeXMLRPCValue(decoder)
+				if err != nil {
+					return nil, err
+				}
+				values = append(values, value)
+			}
+		case xml.EndElement:
+			if t.Name.Local == "array" {
+				return values, nil
+			}
+		}
+	}
+}
+
+func decodeXMLRPCStruct(decoder *xml.Decoder) (interface{}, error) {
+	members := map[string]interface{}{}
+	var name string
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "name":
+				if name, err = readText(decoder, "name"); err != nil {
+					return nil, err
+				}
+			case "value":
+				value, err := decodeXMLRPCValue(decoder)
+				if err != nil {
+					return nil, err
+				}
+				members[name] = value
+			}
+		case xml.EndElement:
+			if t.Name.Local == "struct" {
+				return members, nil
+			}
+		}
+	}
+}
+
+// readText reads the text of an element up to its closing tag.
+func readText(decoder *xml.Decoder, name string) (string, error) {
+	var text strings.Builder
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return "", err
+		}
+		switch t := token.(type) {
+		case xml.CharData:
+			text.Write(t)
+		case xml.EndElement:
+			if t.Name.Local == name {
+				return text.String(), nil
+			}
+		}
+	}
+}
+
+func skipToEnd(decoder *xml.Decoder, name string) error {
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+		if end, ok := token.(xml.EndElement); ok && end.Name.Local == name {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,182 @@
+// SPDX-FileCopyrightText: 2025 SUSE LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/uyuni-project/uyuni-tools/shared/api/mocks"
+	"github.com/uyuni-project/uyuni-tools/shared/testutils"
+)
+
+func xmlrpcResponse(value string) (*http.Response, error) {
+	body := `<?xml version="1.0" encoding="UTF-8"?>
+<methodResponse><params><param>` + value + `</param></params></methodResponse>`
+	return &http.Response{
+		StatusCode: 200,
+		Header:     http.Header{"Content-Type": []string{"text/xml"}},
+		Body:       io.NopCloser(bytes.NewReader([]byte(body))),
+	}, nil
+}
+
+func TestEncodeXMLRPCCall(t *testing.T) {
+	type member struct {
+		Name    string `xmlrpc:"name"`
+		Skipped string `xmlrpc:"-"`
+		Empty   string `xmlrpc:"empty,omitempty"`
+		Active  bool   `xmlrpc:"active"`
+	}
+	date := time.Date(2025, 3, 14, 15, 9, 26, 0, time.UTC)
+
+	data, err := encodeXMLRPCCall("system.schedule",
+		"key & co", 1000010000, int64(10000000000), 1.5, date, []int{1, 2},
+		map[string]interface{}{"b": "x", "a": true}, member{Name: "foo", Skipped: "bar"}, []byte("hi"),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := `<methodCall><methodName>system.schedule</methodName><params>` +
+		`<param><value><string>key &amp; co</string></value></param>` +
+		`<param><value><int>1000010000</int></value></param>` +
+		`<param><value><i8>10000000000</i8></value></param>` +
+		`<param><value><double>1.5</double></value></param>` +
+		`<param><value><dateTime.iso8601>20250314T15:09:26</dateTime.iso8601></value></param>` +
+		`<param><value><array><data>` +
+		`<value><int>1</int></value><value><int>2</int></value>` +
+		`</data></array></value></param>` +
+		`<param><value><struct><member><name>a</name><value><boolean>1</boolean></value></member>` +
+		`<member><name>b</name><value><string>x</string></value></member></struct></value></param>` +
+		`<param><value><struct><member><name>name</name><value><string>foo</string></value></member>` +
+		`<member><name>active</name><value><boolean>0</boolean></value></member></struct></value></param>` +
+		`<param><value><base64>aGk=</base64></value></param>` +
+		`</params></methodCall>`
+	testutils.AssertEquals(t, "Unexpected encoded call", expected, strings.SplitN(string(data), "\n", 2)[1])
+
+	_, err = encodeXMLRPCCall("foo", nil)
+	testutils.AssertTrue(t, "nil values should not be encoded", err != nil)
+}
+
+func TestDecodeXMLRPCResponse(t *testing.T) {
+	data := `<?xml version="1.0"?>
+<methodResponse><params><param><value><array><data>
+  <value><struct>
+    <member><name>id</name><value><i4>1000010000</i4></value></member>
+    <member><name>name</name><value>untyped</value></member>
+    <member><name>last_checkin</name><value><dateTime.iso8601>20250314T15:09:26</dateTime.iso8601></value></member>
+    <member><name>ratio</name><value><double>0.5</double></value></member>
+    <member><name>active</name><value><boolean>1</boolean></value></member>
+    <member><name>data</name><value><base64>aGk=</base64></value></member>
+    <member><name>empty</name><value><nil/></value></member>
+  </struct></value>
+  <value><string>second</string></value>
+</data></array></value></param></params></methodResponse>`
+
+	value, err := decodeXMLRPCResponse([]byte(data))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	values, ok := value.([]interface{})
+	if !ok || len(values) != 2 {
+		t.Fatalf("unexpected decoded value: %v", value)
+	}
+	members := values[0].(map[string]interface{})
+	testutils.AssertEquals[interface{}](t, "Unexpected int", int64(1000010000), members["id"])
+	testutils.AssertEquals[interface{}](t, "Unexpected untyped string", "untyped", members["name"])
+	testutils.AssertEquals[interface{}](t, "Unexpected date",
+		time.Date(2025, 3, 14, 15, 9, 26, 0, time.UTC), members["last_checkin"],
+	)
+	testutils.AssertEquals[interface{}](t, "Unexpected double", 0.5, members["ratio"])
+	testutils.AssertEquals[interface{}](t, "Unexpected boolean", true, members["active"])
+	testutils.AssertEquals[interface{}](t, "Unexpected base64", []byte("hi"), members["data"])
+	testutils.AssertEquals[interface{}](t, "Unexpected nil", nil, members["empty"])
+	testutils.AssertEquals[interface{}](t, "Unexpected string", "second", values[1])
+}
+
+func TestDecodeXMLRPCFault(t *testing.T) {
+	data := `<?xml version="1.0"?>
+<methodResponse><fault><value><struct>
+  <member><name>faultCode</name><value><int>2950</int></value></member>
+  <member><name>faultString</name><value><string>Either the password or username is incorrect.</string></value></member>
+</struct></value></fault></methodResponse>`
+
+	_, err := decodeXMLRPCResponse([]byte(data))
+	var fault *XMLRPCFault
+	if !errors.As(err, &fault) {
+		t.Fatalf("expected a fault, got %v", err)
+	}
+	testutils.AssertEquals(t, "Unexpected fault code", 2950, fault.Code)
+	testutils.AssertEquals(t, "Unexpected fault string", "Either the password or username is incorrect.", fault.String)
+}
+
+func TestXMLRPCLogin(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	client, err := InitXMLRPC(&ConnectionDetails{User: user, Password: password, Server: server}, false)
+	if err != nil {
+		t.Fatalf("failed to initialize client: %s", err)
+	}
+	testutils.AssertEquals(t, "Unexpected URL", "https://mytestserver/rpc/api", client.URL)
+
+	client.Client = &mocks.MockClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			if !strings.Contains(string(body), "<methodName>auth.login</methodName>") {
+				return &http.Response{StatusCode: 404, Body: io.NopCloser(bytes.NewReader(nil))}, nil
+			}
+			return xmlrpcResponse("<value><string>" + cookie + "</string></value>")
+		},
+	}
+
+	if err := client.Login(); err != nil {
+		t.Fatalf("failed to login: %s", err)
+	}
+	testutils.AssertEquals(t, "Unexpected session key", cookie, client.SessionKey)
+
+	if err := StoreXMLRPCLoginCreds(client); err != nil {
+		t.Fatalf("failed to store session: %s", err)
+	}
+	connection := ConnectionDetails{}
+	if err := loadLoginCreds(&connection); err != nil {
+		t.Fatalf("failed to load stored session: %s", err)
+	}
+	testutils.AssertEquals(t, "Unexpected stored session", cookie, connection.Cookie)
+}
+
+func TestXMLRPCHub(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	client, err := InitXMLRPC(&ConnectionDetails{User: user, Password: password, Server: server}, true)
+	if err != nil {
+		t.Fatalf("failed to initialize client: %s", err)
+	}
+	testutils.AssertEquals(t, "Unexpected URL", "https://mytestserver:2830/hub/rpc/api", client.URL)
+
+	client.Client = &mocks.MockClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			if strings.Contains(string(body), "<methodName>hub.login</methodName>") {
+				return xmlrpcResponse("<value><string>hubkey</string></value>")
+			}
+			return xmlrpcResponse("<value><array><data><value><int>1</int></value>" +
+				"<value><int>2</int></value></data></array></value>")
+		},
+	}
+
+	if err := client.Login(); err != nil {
+		t.Fatalf("failed to login: %s", err)
+	}
+	ids, err := XMLRPCCall[[]int](client, "hub.listServerIds", client.SessionKey)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	testutils.AssertEquals(t, "Unexpected server IDs", []int{1, 2}, ids)
+
+	testutils.AssertTrue(t, "Hub sessions should not be stored", StoreXMLRPCLoginCreds(client) != nil)
+}
@@ -8,6 +8,7 @@ import (
 	"bytes"
 	"errors"
 	"os/exec"
+	"strconv"
 	"strings"
 
 	"github.com/rs/zerolog"
@@ -61,6 +62,25 @@ func HelmUpgrade(kubeconfig string, namespace string, install bool,
 	return nil
 }
 
+// HelmRollback runs the helm rollback command to restore a previous revision of a release.
+//
+// If revision is 0, the release is rolled back to its previous revision.
+func HelmRollback(kubeconfig string, namespace string, name string, revision int) error {
+	helmArgs := []string{"rollback", "-n", namespace, name}
+	if revision > 0 {
+		helmArgs = append(helmArgs, strconv.Itoa(revision))
+	}
+	if kubeconfig != "" {
+		helmArgs = append(helmArgs, "--kubeconfig", kubeconfig)
+	}
+	helmArgs = append(helmArgs, "--wait")
+
+	if err := utils.RunCmdStdMapping(zerolog.DebugLevel, "helm", helmArgs...); err != nil {
+		return utils.Errorf(err, L("failed to roll back helm release %[1]s in namespace %[2]s"), name, namespace)
+	}
+	return nil
+}
+
 // HelmUninstall runs the helm uninstall command to remove a deployment.
 func HelmUninstall(namespace string, kubeconfig string, deployment string, dryRun bool) error {
 	if namespace == "" {
@@ -45,6 +45,7 @@ var ImageProxyFlagsTestArgs = []string{
 	"--tftpd-tag", "tftpd-tag",
 	"--tuning-httpd", "path/to/httpd.conf",
 	"--tuning-squid", "path/to/squid.conf",
+	"--disable", "ssh,tftpd",
 }
 
 // AssertProxyImageFlags checks that all image flags are parsed correctly.
@@ -64,4 +65,5 @@ func AssertProxyImageFlags(t *testing.T, flags *utils.ProxyImageFlags) {
 	testutils.AssertEquals(t, "Error parsing --tftpd-tag", "tftpd-tag", flags.Tftpd.Tag)
 	testutils.AssertEquals(t, "Error parsing --tuning-httpd", "path/to/httpd.conf", flags.Tuning.Httpd)
 	testutils.AssertEquals(t, "Error parsing --tuning-squid", "path/to/squid.conf", flags.Tuning.Squid)
+	testutils.AssertEquals(t, "Error parsing --disable", []string{"ssh", "tftpd"}, flags.Disable)
 }
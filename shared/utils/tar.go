@@ -9,6 +9,7 @@ import (
 	"compress/gzip"
 	"errors"
 	"io"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"strings"
@@ -130,3 +131,31 @@ func (t *TarGz) AddFile(filepath string, entrypath string) error {
 	}
 	return nil
 }
+
+// AddDir adds the folder at dirpath and all its content to the archive as entrypath.
+func (t *TarGz) AddDir(dirpath string, entrypath string) error {
+	return filepath.WalkDir(dirpath, func(file string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		relpath, err := filepath.Rel(dirpath, file)
+		if err != nil {
+			return err
+		}
+		name := filepath.ToSlash(filepath.Join(entrypath, relpath))
+		if !entry.IsDir() {
+			return t.AddFile(file, name)
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		header.Name = name + "/"
+		return t.tarWriter.WriteHeader(header)
+	})
+}
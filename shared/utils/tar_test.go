@@ -113,3 +113,31 @@ func TestExtractTarGz(t *testing.T) {
 		}
 	}
 }
+
+func TestAddDirTarGz(t *testing.T) {
+	tmpDir := setup(t)
+
+	tarballPath := path.Join(tmpDir, "test.tar.gz")
+	tarball, err := NewTarGz(tarballPath)
+	if err != nil {
+		t.Fatalf("failed to create tarball: %s", err)
+	}
+	if err := tarball.AddDir(path.Join(tmpDir, dataDir), "data"); err != nil {
+		t.Fatalf("failed to add the data folder to tarball: %s", err)
+	}
+	tarball.Close()
+
+	// The folders are in the archive and can be extracted without creating them first
+	testDir := path.Join(tmpDir, outDir)
+	if err := ExtractTarGz(tarballPath, testDir); err != nil {
+		t.Fatalf("Failed to extract tar.gz: %s", err)
+	}
+
+	for name, content := range filesData {
+		if out, err := os.ReadFile(path.Join(testDir, "data", name)); err != nil {
+			t.Errorf("failed to read %s: %s", name, err)
+		} else if string(out) != content {
+			t.Errorf("expected %s content %s, but got %s", name, content, string(out))
+		}
+	}
+}